@@ -238,74 +238,1577 @@ var _ interface {
 	ErrorName() string
 } = VclusterValidationError{}
 
+// Validate checks the field values on GetRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRequestMultiError, or
+// nil if none found.
+func (m *GetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterName
+
+	if len(errors) > 0 {
+		return GetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRequest.ValidateAll() if the designated constraints aren't met.
+type GetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRequestMultiError) AllErrors() []error { return m }
+
+// GetRequestValidationError is the validation error returned by
+// GetRequest.Validate if the designated constraints aren't met.
+type GetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRequestValidationError) ErrorName() string { return "GetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRequestValidationError{}
+
+// Validate checks the field values on GetReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetReplyMultiError, or nil
+// if none found.
+func (m *GetReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for ApiServer
+
+	// no validation rules for ClusterKind
+
+	// no validation rules for ClusterType
+
+	// no validation rules for Usage
+
+	// no validation rules for HostCluster
+
+	if len(errors) > 0 {
+		return GetReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReplyMultiError is an error wrapping multiple validation errors returned
+// by GetReply.ValidateAll() if the designated constraints aren't met.
+type GetReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReplyMultiError) AllErrors() []error { return m }
+
+// GetReplyValidationError is the validation error returned by
+// GetReply.Validate if the designated constraints aren't met.
+type GetReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReplyValidationError) ErrorName() string { return "GetReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReplyValidationError{}
+
+// Validate checks the field values on ListsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListsRequestMultiError, or
+// nil if none found.
+func (m *ListsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListsRequest.ValidateAll() if the designated constraints aren't met.
+type ListsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListsRequestMultiError) AllErrors() []error { return m }
+
+// ListsRequestValidationError is the validation error returned by
+// ListsRequest.Validate if the designated constraints aren't met.
+type ListsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListsRequestValidationError) ErrorName() string { return "ListsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListsRequestValidationError{}
+
+// Validate checks the field values on ListsReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListsReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListsReplyMultiError, or
+// nil if none found.
+func (m *ListsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListsReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListsReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListsReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListsReply.ValidateAll() if the designated constraints aren't met.
+type ListsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListsReplyMultiError) AllErrors() []error { return m }
+
+// ListsReplyValidationError is the validation error returned by
+// ListsReply.Validate if the designated constraints aren't met.
+type ListsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListsReplyValidationError) ErrorName() string { return "ListsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListsReplyValidationError{}
+
 // Validate checks the field values on SaveRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *SaveRequest) Validate() error {
+func (m *SaveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SaveRequestMultiError, or
+// nil if none found.
+func (m *SaveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterName
+
+	// no validation rules for InsecureSkipCheck
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SaveRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SaveRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SaveRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SkipPreflight
+
+	if len(errors) > 0 {
+		return SaveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveRequestMultiError is an error wrapping multiple validation errors
+// returned by SaveRequest.ValidateAll() if the designated constraints aren't met.
+type SaveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveRequestMultiError) AllErrors() []error { return m }
+
+// SaveRequestValidationError is the validation error returned by
+// SaveRequest.Validate if the designated constraints aren't met.
+type SaveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveRequestValidationError) ErrorName() string { return "SaveRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SaveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveRequestValidationError{}
+
+// Validate checks the field values on SaveReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SaveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SaveReplyMultiError, or nil
+// if none found.
+func (m *SaveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	// no validation rules for CommitSha
+
+	// no validation rules for Branch
+
+	// no validation rules for CommitUrl
+
+	// no validation rules for AutoMerged
+
+	if len(errors) > 0 {
+		return SaveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveReplyMultiError is an error wrapping multiple validation errors returned
+// by SaveReply.ValidateAll() if the designated constraints aren't met.
+type SaveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveReplyMultiError) AllErrors() []error { return m }
+
+// SaveReplyValidationError is the validation error returned by
+// SaveReply.Validate if the designated constraints aren't met.
+type SaveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveReplyValidationError) ErrorName() string { return "SaveReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SaveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveReplyValidationError{}
+
+// Validate checks the field values on ValidateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ValidateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateRequestMultiError, or nil if none found.
+func (m *ValidateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterName
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ValidateRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ValidateRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ValidateRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ValidateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateRequestMultiError is an error wrapping multiple validation errors
+// returned by ValidateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ValidateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateRequestMultiError) AllErrors() []error { return m }
+
+// ValidateRequestValidationError is the validation error returned by
+// ValidateRequest.Validate if the designated constraints aren't met.
+type ValidateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateRequestValidationError) ErrorName() string { return "ValidateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ValidateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateRequestValidationError{}
+
+// Validate checks the field values on PreflightCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PreflightCheck) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreflightCheck with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PreflightCheckMultiError,
+// or nil if none found.
+func (m *PreflightCheck) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreflightCheck) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for Passed
+
+	// no validation rules for Msg
+
+	if len(errors) > 0 {
+		return PreflightCheckMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreflightCheckMultiError is an error wrapping multiple validation errors
+// returned by PreflightCheck.ValidateAll() if the designated constraints
+// aren't met.
+type PreflightCheckMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreflightCheckMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreflightCheckMultiError) AllErrors() []error { return m }
+
+// PreflightCheckValidationError is the validation error returned by
+// PreflightCheck.Validate if the designated constraints aren't met.
+type PreflightCheckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreflightCheckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreflightCheckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreflightCheckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreflightCheckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreflightCheckValidationError) ErrorName() string { return "PreflightCheckValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PreflightCheckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreflightCheck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreflightCheckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreflightCheckValidationError{}
+
+// Validate checks the field values on ValidateReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ValidateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ValidateReplyMultiError, or
+// nil if none found.
+func (m *ValidateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Passed
+
+	for idx, item := range m.GetChecks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateReplyValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateReplyValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateReplyValidationError{
+					field:  fmt.Sprintf("Checks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateReplyMultiError is an error wrapping multiple validation errors
+// returned by ValidateReply.ValidateAll() if the designated constraints
+// aren't met.
+type ValidateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateReplyMultiError) AllErrors() []error { return m }
+
+// ValidateReplyValidationError is the validation error returned by
+// ValidateReply.Validate if the designated constraints aren't met.
+type ValidateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateReplyValidationError) ErrorName() string { return "ValidateReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ValidateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateReplyValidationError{}
+
+// Validate checks the field values on DeleteRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteRequestMultiError, or
+// nil if none found.
+func (m *DeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductName
+
+	// no validation rules for ClusterName
+
+	// no validation rules for InsecureSkipCheck
+
+	if len(errors) > 0 {
+		return DeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRequestValidationError is the validation error returned by
+// DeleteRequest.Validate if the designated constraints aren't met.
+type DeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRequestValidationError) ErrorName() string { return "DeleteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRequestValidationError{}
+
+// Validate checks the field values on DeleteReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteReplyMultiError, or
+// nil if none found.
+func (m *DeleteReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	// no validation rules for CommitSha
+
+	// no validation rules for Branch
+
+	// no validation rules for CommitUrl
+
+	// no validation rules for AutoMerged
+
+	if len(errors) > 0 {
+		return DeleteReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteReply.ValidateAll() if the designated constraints aren't met.
+type DeleteReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReplyMultiError) AllErrors() []error { return m }
+
+// DeleteReplyValidationError is the validation error returned by
+// DeleteReply.Validate if the designated constraints aren't met.
+type DeleteReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReplyValidationError) ErrorName() string { return "DeleteReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReplyValidationError{}
+
+// Validate checks the field values on UpdateKubeconfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateKubeconfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateKubeconfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateKubeconfigRequestMultiError, or nil if none found.
+func (m *UpdateKubeconfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateKubeconfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterName
+
+	if utf8.RuneCountInString(m.GetKubeconfig()) < 1 {
+		err := UpdateKubeconfigRequestValidationError{
+			field:  "Kubeconfig",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateKubeconfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateKubeconfigRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateKubeconfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateKubeconfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateKubeconfigRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateKubeconfigRequestMultiError) AllErrors() []error { return m }
+
+// UpdateKubeconfigRequestValidationError is the validation error returned by
+// UpdateKubeconfigRequest.Validate if the designated constraints aren't met.
+type UpdateKubeconfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateKubeconfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateKubeconfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateKubeconfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateKubeconfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateKubeconfigRequestValidationError) ErrorName() string {
+	return "UpdateKubeconfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateKubeconfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateKubeconfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateKubeconfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateKubeconfigRequestValidationError{}
+
+// Validate checks the field values on Certificate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Certificate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SaveRequest with the rules defined in
+// ValidateAll checks the field values on Certificate with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SaveRequestMultiError, or
+// result is a list of violation errors wrapped in CertificateMultiError, or
 // nil if none found.
-func (m *SaveRequest) ValidateAll() error {
+func (m *Certificate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SaveRequest) validate(all bool) error {
+func (m *Certificate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ClusterName
+	// no validation rules for Source
 
-	// no validation rules for InsecureSkipCheck
+	// no validation rules for Subject
 
-	if all {
-		switch v := interface{}(m.GetBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SaveRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for NotAfter
+
+	if len(errors) > 0 {
+		return CertificateMultiError(errors)
+	}
+
+	return nil
+}
+
+// CertificateMultiError is an error wrapping multiple validation errors
+// returned by Certificate.ValidateAll() if the designated constraints aren't met.
+type CertificateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CertificateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CertificateMultiError) AllErrors() []error { return m }
+
+// CertificateValidationError is the validation error returned by
+// Certificate.Validate if the designated constraints aren't met.
+type CertificateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CertificateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CertificateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CertificateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CertificateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CertificateValidationError) ErrorName() string { return "CertificateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CertificateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCertificate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CertificateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CertificateValidationError{}
+
+// Validate checks the field values on UpdateKubeconfigReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateKubeconfigReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateKubeconfigReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateKubeconfigReplyMultiError, or nil if none found.
+func (m *UpdateKubeconfigReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateKubeconfigReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	for idx, item := range m.GetCertificates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateKubeconfigReplyValidationError{
+						field:  fmt.Sprintf("Certificates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateKubeconfigReplyValidationError{
+						field:  fmt.Sprintf("Certificates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SaveRequestValidationError{
-					field:  "Body",
+				return UpdateKubeconfigReplyValidationError{
+					field:  fmt.Sprintf("Certificates[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SaveRequestValidationError{
-				field:  "Body",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for ExpiresAt
+
 	if len(errors) > 0 {
-		return SaveRequestMultiError(errors)
+		return UpdateKubeconfigReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SaveRequestMultiError is an error wrapping multiple validation errors
-// returned by SaveRequest.ValidateAll() if the designated constraints aren't met.
-type SaveRequestMultiError []error
+// UpdateKubeconfigReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateKubeconfigReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateKubeconfigReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SaveRequestMultiError) Error() string {
+func (m UpdateKubeconfigReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -314,11 +1817,11 @@ func (m SaveRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SaveRequestMultiError) AllErrors() []error { return m }
+func (m UpdateKubeconfigReplyMultiError) AllErrors() []error { return m }
 
-// SaveRequestValidationError is the validation error returned by
-// SaveRequest.Validate if the designated constraints aren't met.
-type SaveRequestValidationError struct {
+// UpdateKubeconfigReplyValidationError is the validation error returned by
+// UpdateKubeconfigReply.Validate if the designated constraints aren't met.
+type UpdateKubeconfigReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -326,22 +1829,24 @@ type SaveRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SaveRequestValidationError) Field() string { return e.field }
+func (e UpdateKubeconfigReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SaveRequestValidationError) Reason() string { return e.reason }
+func (e UpdateKubeconfigReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SaveRequestValidationError) Cause() error { return e.cause }
+func (e UpdateKubeconfigReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SaveRequestValidationError) Key() bool { return e.key }
+func (e UpdateKubeconfigReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SaveRequestValidationError) ErrorName() string { return "SaveRequestValidationError" }
+func (e UpdateKubeconfigReplyValidationError) ErrorName() string {
+	return "UpdateKubeconfigReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SaveRequestValidationError) Error() string {
+func (e UpdateKubeconfigReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -353,14 +1858,14 @@ func (e SaveRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSaveRequest.%s: %s%s",
+		"invalid %sUpdateKubeconfigReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SaveRequestValidationError{}
+var _ error = UpdateKubeconfigReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -368,45 +1873,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SaveRequestValidationError{}
+} = UpdateKubeconfigReplyValidationError{}
 
-// Validate checks the field values on SaveReply with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SaveReply) Validate() error {
+// Validate checks the field values on ListExpiringRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListExpiringRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SaveReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SaveReplyMultiError, or nil
-// if none found.
-func (m *SaveReply) ValidateAll() error {
+// ValidateAll checks the field values on ListExpiringRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListExpiringRequestMultiError, or nil if none found.
+func (m *ListExpiringRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SaveReply) validate(all bool) error {
+func (m *ListExpiringRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	if m.GetDays() < 0 {
+		err := ListExpiringRequestValidationError{
+			field:  "Days",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return SaveReplyMultiError(errors)
+		return ListExpiringRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SaveReplyMultiError is an error wrapping multiple validation errors returned
-// by SaveReply.ValidateAll() if the designated constraints aren't met.
-type SaveReplyMultiError []error
+// ListExpiringRequestMultiError is an error wrapping multiple validation
+// errors returned by ListExpiringRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListExpiringRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SaveReplyMultiError) Error() string {
+func (m ListExpiringRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -415,11 +1930,11 @@ func (m SaveReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SaveReplyMultiError) AllErrors() []error { return m }
+func (m ListExpiringRequestMultiError) AllErrors() []error { return m }
 
-// SaveReplyValidationError is the validation error returned by
-// SaveReply.Validate if the designated constraints aren't met.
-type SaveReplyValidationError struct {
+// ListExpiringRequestValidationError is the validation error returned by
+// ListExpiringRequest.Validate if the designated constraints aren't met.
+type ListExpiringRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -427,22 +1942,24 @@ type SaveReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SaveReplyValidationError) Field() string { return e.field }
+func (e ListExpiringRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SaveReplyValidationError) Reason() string { return e.reason }
+func (e ListExpiringRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SaveReplyValidationError) Cause() error { return e.cause }
+func (e ListExpiringRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SaveReplyValidationError) Key() bool { return e.key }
+func (e ListExpiringRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SaveReplyValidationError) ErrorName() string { return "SaveReplyValidationError" }
+func (e ListExpiringRequestValidationError) ErrorName() string {
+	return "ListExpiringRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SaveReplyValidationError) Error() string {
+func (e ListExpiringRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -454,14 +1971,14 @@ func (e SaveReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSaveReply.%s: %s%s",
+		"invalid %sListExpiringRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SaveReplyValidationError{}
+var _ error = ListExpiringRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -469,50 +1986,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SaveReplyValidationError{}
+} = ListExpiringRequestValidationError{}
 
-// Validate checks the field values on DeleteRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DeleteRequest) Validate() error {
+// Validate checks the field values on ExpiringCluster with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExpiringCluster) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteRequestMultiError, or
-// nil if none found.
-func (m *DeleteRequest) ValidateAll() error {
+// ValidateAll checks the field values on ExpiringCluster with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExpiringClusterMultiError, or nil if none found.
+func (m *ExpiringCluster) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRequest) validate(all bool) error {
+func (m *ExpiringCluster) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProductName
+	// no validation rules for Name
+
+	// no validation rules for ExpiresAt
+
+	for idx, item := range m.GetCertificates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpiringClusterValidationError{
+						field:  fmt.Sprintf("Certificates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpiringClusterValidationError{
+						field:  fmt.Sprintf("Certificates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExpiringClusterValidationError{
+					field:  fmt.Sprintf("Certificates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for ClusterName
+	}
 
-	// no validation rules for InsecureSkipCheck
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return DeleteRequestMultiError(errors)
+		return ExpiringClusterMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteRequest.ValidateAll() if the designated constraints
+// ExpiringClusterMultiError is an error wrapping multiple validation errors
+// returned by ExpiringCluster.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteRequestMultiError []error
+type ExpiringClusterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRequestMultiError) Error() string {
+func (m ExpiringClusterMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -521,11 +2072,11 @@ func (m DeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRequestMultiError) AllErrors() []error { return m }
+func (m ExpiringClusterMultiError) AllErrors() []error { return m }
 
-// DeleteRequestValidationError is the validation error returned by
-// DeleteRequest.Validate if the designated constraints aren't met.
-type DeleteRequestValidationError struct {
+// ExpiringClusterValidationError is the validation error returned by
+// ExpiringCluster.Validate if the designated constraints aren't met.
+type ExpiringClusterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -533,22 +2084,22 @@ type DeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRequestValidationError) Field() string { return e.field }
+func (e ExpiringClusterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRequestValidationError) Reason() string { return e.reason }
+func (e ExpiringClusterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRequestValidationError) Cause() error { return e.cause }
+func (e ExpiringClusterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRequestValidationError) Key() bool { return e.key }
+func (e ExpiringClusterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRequestValidationError) ErrorName() string { return "DeleteRequestValidationError" }
+func (e ExpiringClusterValidationError) ErrorName() string { return "ExpiringClusterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteRequestValidationError) Error() string {
+func (e ExpiringClusterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -560,14 +2111,14 @@ func (e DeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRequest.%s: %s%s",
+		"invalid %sExpiringCluster.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRequestValidationError{}
+var _ error = ExpiringClusterValidationError{}
 
 var _ interface {
 	Field() string
@@ -575,45 +2126,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRequestValidationError{}
+} = ExpiringClusterValidationError{}
 
-// Validate checks the field values on DeleteReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DeleteReply) Validate() error {
+// Validate checks the field values on ListExpiringReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListExpiringReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteReplyMultiError, or
-// nil if none found.
-func (m *DeleteReply) ValidateAll() error {
+// ValidateAll checks the field values on ListExpiringReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListExpiringReplyMultiError, or nil if none found.
+func (m *ListExpiringReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReply) validate(all bool) error {
+func (m *ListExpiringReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListExpiringReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListExpiringReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExpiringReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteReplyMultiError(errors)
+		return ListExpiringReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReplyMultiError is an error wrapping multiple validation errors
-// returned by DeleteReply.ValidateAll() if the designated constraints aren't met.
-type DeleteReplyMultiError []error
+// ListExpiringReplyMultiError is an error wrapping multiple validation errors
+// returned by ListExpiringReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListExpiringReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReplyMultiError) Error() string {
+func (m ListExpiringReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -622,11 +2206,11 @@ func (m DeleteReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReplyMultiError) AllErrors() []error { return m }
+func (m ListExpiringReplyMultiError) AllErrors() []error { return m }
 
-// DeleteReplyValidationError is the validation error returned by
-// DeleteReply.Validate if the designated constraints aren't met.
-type DeleteReplyValidationError struct {
+// ListExpiringReplyValidationError is the validation error returned by
+// ListExpiringReply.Validate if the designated constraints aren't met.
+type ListExpiringReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -634,22 +2218,24 @@ type DeleteReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReplyValidationError) Field() string { return e.field }
+func (e ListExpiringReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReplyValidationError) Reason() string { return e.reason }
+func (e ListExpiringReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReplyValidationError) Cause() error { return e.cause }
+func (e ListExpiringReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReplyValidationError) Key() bool { return e.key }
+func (e ListExpiringReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReplyValidationError) ErrorName() string { return "DeleteReplyValidationError" }
+func (e ListExpiringReplyValidationError) ErrorName() string {
+	return "ListExpiringReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteReplyValidationError) Error() string {
+func (e ListExpiringReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -661,14 +2247,14 @@ func (e DeleteReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReply.%s: %s%s",
+		"invalid %sListExpiringReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReplyValidationError{}
+var _ error = ListExpiringReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -676,7 +2262,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReplyValidationError{}
+} = ListExpiringReplyValidationError{}
 
 // Validate checks the field values on SaveRequest_Body with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -22,8 +22,14 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ClusterClient interface {
+	GetCluster(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetReply, error)
+	ListClusters(ctx context.Context, in *ListsRequest, opts ...grpc.CallOption) (*ListsReply, error)
+	// ValidateCluster is declared before SaveCluster, so that its route is matched before the route of SaveCluster.
+	ValidateCluster(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateReply, error)
 	SaveCluster(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveReply, error)
 	DeleteCluster(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error)
+	UpdateKubeconfig(ctx context.Context, in *UpdateKubeconfigRequest, opts ...grpc.CallOption) (*UpdateKubeconfigReply, error)
+	ListExpiringClusters(ctx context.Context, in *ListExpiringRequest, opts ...grpc.CallOption) (*ListExpiringReply, error)
 }
 
 type clusterClient struct {
@@ -34,6 +40,33 @@ func NewClusterClient(cc grpc.ClientConnInterface) ClusterClient {
 	return &clusterClient{cc}
 }
 
+func (c *clusterClient) GetCluster(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetReply, error) {
+	out := new(GetReply)
+	err := c.cc.Invoke(ctx, "/api.cluster.v1.Cluster/GetCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) ListClusters(ctx context.Context, in *ListsRequest, opts ...grpc.CallOption) (*ListsReply, error) {
+	out := new(ListsReply)
+	err := c.cc.Invoke(ctx, "/api.cluster.v1.Cluster/ListClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) ValidateCluster(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateReply, error) {
+	out := new(ValidateReply)
+	err := c.cc.Invoke(ctx, "/api.cluster.v1.Cluster/ValidateCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterClient) SaveCluster(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveReply, error) {
 	out := new(SaveReply)
 	err := c.cc.Invoke(ctx, "/api.cluster.v1.Cluster/SaveCluster", in, out, opts...)
@@ -52,12 +85,36 @@ func (c *clusterClient) DeleteCluster(ctx context.Context, in *DeleteRequest, op
 	return out, nil
 }
 
+func (c *clusterClient) UpdateKubeconfig(ctx context.Context, in *UpdateKubeconfigRequest, opts ...grpc.CallOption) (*UpdateKubeconfigReply, error) {
+	out := new(UpdateKubeconfigReply)
+	err := c.cc.Invoke(ctx, "/api.cluster.v1.Cluster/UpdateKubeconfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) ListExpiringClusters(ctx context.Context, in *ListExpiringRequest, opts ...grpc.CallOption) (*ListExpiringReply, error) {
+	out := new(ListExpiringReply)
+	err := c.cc.Invoke(ctx, "/api.cluster.v1.Cluster/ListExpiringClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServer is the server API for Cluster service.
 // All implementations must embed UnimplementedClusterServer
 // for forward compatibility
 type ClusterServer interface {
+	GetCluster(context.Context, *GetRequest) (*GetReply, error)
+	ListClusters(context.Context, *ListsRequest) (*ListsReply, error)
+	// ValidateCluster is declared before SaveCluster, so that its route is matched before the route of SaveCluster.
+	ValidateCluster(context.Context, *ValidateRequest) (*ValidateReply, error)
 	SaveCluster(context.Context, *SaveRequest) (*SaveReply, error)
 	DeleteCluster(context.Context, *DeleteRequest) (*DeleteReply, error)
+	UpdateKubeconfig(context.Context, *UpdateKubeconfigRequest) (*UpdateKubeconfigReply, error)
+	ListExpiringClusters(context.Context, *ListExpiringRequest) (*ListExpiringReply, error)
 	mustEmbedUnimplementedClusterServer()
 }
 
@@ -65,12 +122,27 @@ type ClusterServer interface {
 type UnimplementedClusterServer struct {
 }
 
+func (UnimplementedClusterServer) GetCluster(context.Context, *GetRequest) (*GetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCluster not implemented")
+}
+func (UnimplementedClusterServer) ListClusters(context.Context, *ListsRequest) (*ListsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
+}
+func (UnimplementedClusterServer) ValidateCluster(context.Context, *ValidateRequest) (*ValidateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCluster not implemented")
+}
 func (UnimplementedClusterServer) SaveCluster(context.Context, *SaveRequest) (*SaveReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveCluster not implemented")
 }
 func (UnimplementedClusterServer) DeleteCluster(context.Context, *DeleteRequest) (*DeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
 }
+func (UnimplementedClusterServer) UpdateKubeconfig(context.Context, *UpdateKubeconfigRequest) (*UpdateKubeconfigReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKubeconfig not implemented")
+}
+func (UnimplementedClusterServer) ListExpiringClusters(context.Context, *ListExpiringRequest) (*ListExpiringReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringClusters not implemented")
+}
 func (UnimplementedClusterServer) mustEmbedUnimplementedClusterServer() {}
 
 // UnsafeClusterServer may be embedded to opt out of forward compatibility for this service.
@@ -84,6 +156,60 @@ func RegisterClusterServer(s grpc.ServiceRegistrar, srv ClusterServer) {
 	s.RegisterService(&Cluster_ServiceDesc, srv)
 }
 
+func _Cluster_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).GetCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cluster.v1.Cluster/GetCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).GetCluster(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ListClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cluster.v1.Cluster/ListClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ListClusters(ctx, req.(*ListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_ValidateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ValidateCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cluster.v1.Cluster/ValidateCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ValidateCluster(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cluster_SaveCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SaveRequest)
 	if err := dec(in); err != nil {
@@ -120,6 +246,42 @@ func _Cluster_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_UpdateKubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKubeconfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).UpdateKubeconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cluster.v1.Cluster/UpdateKubeconfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).UpdateKubeconfig(ctx, req.(*UpdateKubeconfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_ListExpiringClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ListExpiringClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cluster.v1.Cluster/ListExpiringClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ListExpiringClusters(ctx, req.(*ListExpiringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cluster_ServiceDesc is the grpc.ServiceDesc for Cluster service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -127,6 +289,18 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "api.cluster.v1.Cluster",
 	HandlerType: (*ClusterServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCluster",
+			Handler:    _Cluster_GetCluster_Handler,
+		},
+		{
+			MethodName: "ListClusters",
+			Handler:    _Cluster_ListClusters_Handler,
+		},
+		{
+			MethodName: "ValidateCluster",
+			Handler:    _Cluster_ValidateCluster_Handler,
+		},
 		{
 			MethodName: "SaveCluster",
 			Handler:    _Cluster_SaveCluster_Handler,
@@ -135,6 +309,14 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCluster",
 			Handler:    _Cluster_DeleteCluster_Handler,
 		},
+		{
+			MethodName: "UpdateKubeconfig",
+			Handler:    _Cluster_UpdateKubeconfig_Handler,
+		},
+		{
+			MethodName: "ListExpiringClusters",
+			Handler:    _Cluster_ListExpiringClusters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cluster/v1/cluster.proto",
@@ -20,17 +20,99 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationClusterDeleteCluster = "/api.cluster.v1.Cluster/DeleteCluster"
+const OperationClusterGetCluster = "/api.cluster.v1.Cluster/GetCluster"
+const OperationClusterListClusters = "/api.cluster.v1.Cluster/ListClusters"
+const OperationClusterListExpiringClusters = "/api.cluster.v1.Cluster/ListExpiringClusters"
 const OperationClusterSaveCluster = "/api.cluster.v1.Cluster/SaveCluster"
+const OperationClusterUpdateKubeconfig = "/api.cluster.v1.Cluster/UpdateKubeconfig"
+const OperationClusterValidateCluster = "/api.cluster.v1.Cluster/ValidateCluster"
 
 type ClusterHTTPServer interface {
 	DeleteCluster(context.Context, *DeleteRequest) (*DeleteReply, error)
+	GetCluster(context.Context, *GetRequest) (*GetReply, error)
+	ListClusters(context.Context, *ListsRequest) (*ListsReply, error)
+	ListExpiringClusters(context.Context, *ListExpiringRequest) (*ListExpiringReply, error)
 	SaveCluster(context.Context, *SaveRequest) (*SaveReply, error)
+	UpdateKubeconfig(context.Context, *UpdateKubeconfigRequest) (*UpdateKubeconfigReply, error)
+	// ValidateCluster ValidateCluster is declared before SaveCluster, so that its route is matched before the route of SaveCluster.
+	ValidateCluster(context.Context, *ValidateRequest) (*ValidateReply, error)
 }
 
 func RegisterClusterHTTPServer(s *http.Server, srv ClusterHTTPServer) {
 	r := s.Route("/")
+	r.GET("/api/v1/clusters/{clusterName}", _Cluster_GetCluster0_HTTP_Handler(srv))
+	r.GET("/api/v1/clusters", _Cluster_ListClusters0_HTTP_Handler(srv))
+	r.POST("/api/v1/clusters/{clusterName}:validate", _Cluster_ValidateCluster0_HTTP_Handler(srv))
 	r.POST("/api/v1/clusters/{clusterName}", _Cluster_SaveCluster0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/clusters/{clusterName}", _Cluster_DeleteCluster0_HTTP_Handler(srv))
+	r.POST("/api/v1/clusters/{clusterName}/kubeconfig", _Cluster_UpdateKubeconfig0_HTTP_Handler(srv))
+	r.GET("/api/v1/clusters:expiring", _Cluster_ListExpiringClusters0_HTTP_Handler(srv))
+}
+
+func _Cluster_GetCluster0_HTTP_Handler(srv ClusterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterGetCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCluster(ctx, req.(*GetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Cluster_ListClusters0_HTTP_Handler(srv ClusterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterListClusters)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListClusters(ctx, req.(*ListsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Cluster_ValidateCluster0_HTTP_Handler(srv ClusterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ValidateRequest
+		if err := ctx.Bind(&in.Body); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterValidateCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ValidateCluster(ctx, req.(*ValidateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ValidateReply)
+		return ctx.Result(200, reply)
+	}
 }
 
 func _Cluster_SaveCluster0_HTTP_Handler(srv ClusterHTTPServer) func(ctx http.Context) error {
@@ -80,9 +162,55 @@ func _Cluster_DeleteCluster0_HTTP_Handler(srv ClusterHTTPServer) func(ctx http.C
 	}
 }
 
+func _Cluster_UpdateKubeconfig0_HTTP_Handler(srv ClusterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateKubeconfigRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterUpdateKubeconfig)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateKubeconfig(ctx, req.(*UpdateKubeconfigRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateKubeconfigReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Cluster_ListExpiringClusters0_HTTP_Handler(srv ClusterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListExpiringRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterListExpiringClusters)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListExpiringClusters(ctx, req.(*ListExpiringRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListExpiringReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ClusterHTTPClient interface {
 	DeleteCluster(ctx context.Context, req *DeleteRequest, opts ...http.CallOption) (rsp *DeleteReply, err error)
+	GetCluster(ctx context.Context, req *GetRequest, opts ...http.CallOption) (rsp *GetReply, err error)
+	ListClusters(ctx context.Context, req *ListsRequest, opts ...http.CallOption) (rsp *ListsReply, err error)
+	ListExpiringClusters(ctx context.Context, req *ListExpiringRequest, opts ...http.CallOption) (rsp *ListExpiringReply, err error)
 	SaveCluster(ctx context.Context, req *SaveRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
+	UpdateKubeconfig(ctx context.Context, req *UpdateKubeconfigRequest, opts ...http.CallOption) (rsp *UpdateKubeconfigReply, err error)
+	ValidateCluster(ctx context.Context, req *ValidateRequest, opts ...http.CallOption) (rsp *ValidateReply, err error)
 }
 
 type ClusterHTTPClientImpl struct {
@@ -106,6 +234,45 @@ func (c *ClusterHTTPClientImpl) DeleteCluster(ctx context.Context, in *DeleteReq
 	return &out, err
 }
 
+func (c *ClusterHTTPClientImpl) GetCluster(ctx context.Context, in *GetRequest, opts ...http.CallOption) (*GetReply, error) {
+	var out GetReply
+	pattern := "/api/v1/clusters/{clusterName}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterGetCluster))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ClusterHTTPClientImpl) ListClusters(ctx context.Context, in *ListsRequest, opts ...http.CallOption) (*ListsReply, error) {
+	var out ListsReply
+	pattern := "/api/v1/clusters"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterListClusters))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ClusterHTTPClientImpl) ListExpiringClusters(ctx context.Context, in *ListExpiringRequest, opts ...http.CallOption) (*ListExpiringReply, error) {
+	var out ListExpiringReply
+	pattern := "/api/v1/clusters:expiring"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterListExpiringClusters))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ClusterHTTPClientImpl) SaveCluster(ctx context.Context, in *SaveRequest, opts ...http.CallOption) (*SaveReply, error) {
 	var out SaveReply
 	pattern := "/api/v1/clusters/{clusterName}"
@@ -118,3 +285,29 @@ func (c *ClusterHTTPClientImpl) SaveCluster(ctx context.Context, in *SaveRequest
 	}
 	return &out, err
 }
+
+func (c *ClusterHTTPClientImpl) UpdateKubeconfig(ctx context.Context, in *UpdateKubeconfigRequest, opts ...http.CallOption) (*UpdateKubeconfigReply, error) {
+	var out UpdateKubeconfigReply
+	pattern := "/api/v1/clusters/{clusterName}/kubeconfig"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterUpdateKubeconfig))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ClusterHTTPClientImpl) ValidateCluster(ctx context.Context, in *ValidateRequest, opts ...http.CallOption) (*ValidateReply, error) {
+	var out ValidateReply
+	pattern := "/api/v1/clusters/{clusterName}:validate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterValidateCluster))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.Body, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -59,6 +59,10 @@ func (m *ListsRequest) validate(all bool) error {
 
 	// no validation rules for ProductName
 
+	// no validation rules for Revision
+
+	// no validation rules for WithStatus
+
 	if len(errors) > 0 {
 		return ListsRequestMultiError(errors)
 	}
@@ -1119,6 +1123,10 @@ func (m *GetRequest) validate(all bool) error {
 
 	// no validation rules for CoderepoName
 
+	// no validation rules for Revision
+
+	// no validation rules for WithStatus
+
 	if len(errors) > 0 {
 		return GetRequestMultiError(errors)
 	}
@@ -1196,109 +1204,53 @@ var _ interface {
 	ErrorName() string
 } = GetRequestValidationError{}
 
-// Validate checks the field values on GetReply with the rules defined in the
+// Validate checks the field values on Condition with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GetReply) Validate() error {
+func (m *Condition) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReply with the rules defined in
+// ValidateAll checks the field values on Condition with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetReplyMultiError, or nil
+// result is a list of violation errors wrapped in ConditionMultiError, or nil
 // if none found.
-func (m *GetReply) ValidateAll() error {
+func (m *Condition) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReply) validate(all bool) error {
+func (m *Condition) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Product
-
-	// no validation rules for Name
+	// no validation rules for Type
 
-	// no validation rules for Project
+	// no validation rules for Status
 
-	if all {
-		switch v := interface{}(m.GetWebhook()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetReplyValidationError{
-					field:  "Webhook",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetReplyValidationError{
-					field:  "Webhook",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetReplyValidationError{
-				field:  "Webhook",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ObservedGeneration
 
-	// no validation rules for DeploymentRuntime
+	// no validation rules for LastTransitionTime
 
-	// no validation rules for PipelineRuntime
+	// no validation rules for Reason
 
-	if all {
-		switch v := interface{}(m.GetGit()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetReplyValidationError{
-					field:  "Git",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetReplyValidationError{
-					field:  "Git",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetGit()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetReplyValidationError{
-				field:  "Git",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return GetReplyMultiError(errors)
+		return ConditionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReplyMultiError is an error wrapping multiple validation errors returned
-// by GetReply.ValidateAll() if the designated constraints aren't met.
-type GetReplyMultiError []error
+// ConditionMultiError is an error wrapping multiple validation errors returned
+// by Condition.ValidateAll() if the designated constraints aren't met.
+type ConditionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReplyMultiError) Error() string {
+func (m ConditionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1307,11 +1259,11 @@ func (m GetReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReplyMultiError) AllErrors() []error { return m }
+func (m ConditionMultiError) AllErrors() []error { return m }
 
-// GetReplyValidationError is the validation error returned by
-// GetReply.Validate if the designated constraints aren't met.
-type GetReplyValidationError struct {
+// ConditionValidationError is the validation error returned by
+// Condition.Validate if the designated constraints aren't met.
+type ConditionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1319,22 +1271,22 @@ type GetReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReplyValidationError) Field() string { return e.field }
+func (e ConditionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReplyValidationError) Reason() string { return e.reason }
+func (e ConditionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReplyValidationError) Cause() error { return e.cause }
+func (e ConditionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReplyValidationError) Key() bool { return e.key }
+func (e ConditionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReplyValidationError) ErrorName() string { return "GetReplyValidationError" }
+func (e ConditionValidationError) ErrorName() string { return "ConditionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetReplyValidationError) Error() string {
+func (e ConditionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1346,14 +1298,14 @@ func (e GetReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReply.%s: %s%s",
+		"invalid %sCondition.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReplyValidationError{}
+var _ error = ConditionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1361,47 +1313,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReplyValidationError{}
+} = ConditionValidationError{}
 
-// Validate checks the field values on ListsReply with the rules defined in the
+// Validate checks the field values on Status with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ListsReply) Validate() error {
+func (m *Status) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListsReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ListsReplyMultiError, or
-// nil if none found.
-func (m *ListsReply) ValidateAll() error {
+// ValidateAll checks the field values on Status with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in StatusMultiError, or nil if none found.
+func (m *Status) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListsReply) validate(all bool) error {
+func (m *Status) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
+	// no validation rules for Synced
+
+	// no validation rules for Generation
+
+	// no validation rules for ObservedGeneration
+
+	for idx, item := range m.GetConditions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListsReplyValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, StatusValidationError{
+						field:  fmt.Sprintf("Conditions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListsReplyValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, StatusValidationError{
+						field:  fmt.Sprintf("Conditions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1409,8 +1366,8 @@ func (m *ListsReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListsReplyValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				return StatusValidationError{
+					field:  fmt.Sprintf("Conditions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1419,19 +1376,21 @@ func (m *ListsReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for LastError
+
 	if len(errors) > 0 {
-		return ListsReplyMultiError(errors)
+		return StatusMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListsReplyMultiError is an error wrapping multiple validation errors
-// returned by ListsReply.ValidateAll() if the designated constraints aren't met.
-type ListsReplyMultiError []error
+// StatusMultiError is an error wrapping multiple validation errors returned by
+// Status.ValidateAll() if the designated constraints aren't met.
+type StatusMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListsReplyMultiError) Error() string {
+func (m StatusMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1440,11 +1399,11 @@ func (m ListsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListsReplyMultiError) AllErrors() []error { return m }
+func (m StatusMultiError) AllErrors() []error { return m }
 
-// ListsReplyValidationError is the validation error returned by
-// ListsReply.Validate if the designated constraints aren't met.
-type ListsReplyValidationError struct {
+// StatusValidationError is the validation error returned by Status.Validate if
+// the designated constraints aren't met.
+type StatusValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1452,22 +1411,22 @@ type ListsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListsReplyValidationError) Field() string { return e.field }
+func (e StatusValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListsReplyValidationError) Reason() string { return e.reason }
+func (e StatusValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListsReplyValidationError) Cause() error { return e.cause }
+func (e StatusValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListsReplyValidationError) Key() bool { return e.key }
+func (e StatusValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListsReplyValidationError) ErrorName() string { return "ListsReplyValidationError" }
+func (e StatusValidationError) ErrorName() string { return "StatusValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListsReplyValidationError) Error() string {
+func (e StatusValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1479,14 +1438,14 @@ func (e ListsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListsReply.%s: %s%s",
+		"invalid %sStatus.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListsReplyValidationError{}
+var _ error = StatusValidationError{}
 
 var _ interface {
 	Field() string
@@ -1494,59 +1453,123 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListsReplyValidationError{}
+} = StatusValidationError{}
 
-// Validate checks the field values on SaveRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on GetReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *SaveRequest) Validate() error {
+func (m *GetReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SaveRequest with the rules defined in
+// ValidateAll checks the field values on GetReply with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SaveRequestMultiError, or
-// nil if none found.
-func (m *SaveRequest) ValidateAll() error {
+// result is a list of violation errors wrapped in GetReplyMultiError, or nil
+// if none found.
+func (m *GetReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SaveRequest) validate(all bool) error {
+func (m *GetReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProductName
+	// no validation rules for Product
 
-	// no validation rules for CoderepoName
+	// no validation rules for Name
 
-	// no validation rules for InsecureSkipCheck
+	// no validation rules for Project
 
 	if all {
-		switch v := interface{}(m.GetBody()).(type) {
+		switch v := interface{}(m.GetWebhook()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SaveRequestValidationError{
-					field:  "Body",
+				errors = append(errors, GetReplyValidationError{
+					field:  "Webhook",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SaveRequestValidationError{
-					field:  "Body",
+				errors = append(errors, GetReplyValidationError{
+					field:  "Webhook",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SaveRequestValidationError{
-				field:  "Body",
+			return GetReplyValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DeploymentRuntime
+
+	// no validation rules for PipelineRuntime
+
+	if all {
+		switch v := interface{}(m.GetGit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReplyValidationError{
+					field:  "Git",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReplyValidationError{
+					field:  "Git",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReplyValidationError{
+				field:  "Git",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResourceVersion
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReplyValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReplyValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReplyValidationError{
+				field:  "Status",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1554,18 +1577,18 @@ func (m *SaveRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SaveRequestMultiError(errors)
+		return GetReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SaveRequestMultiError is an error wrapping multiple validation errors
-// returned by SaveRequest.ValidateAll() if the designated constraints aren't met.
-type SaveRequestMultiError []error
+// GetReplyMultiError is an error wrapping multiple validation errors returned
+// by GetReply.ValidateAll() if the designated constraints aren't met.
+type GetReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SaveRequestMultiError) Error() string {
+func (m GetReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1574,11 +1597,11 @@ func (m SaveRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SaveRequestMultiError) AllErrors() []error { return m }
+func (m GetReplyMultiError) AllErrors() []error { return m }
 
-// SaveRequestValidationError is the validation error returned by
-// SaveRequest.Validate if the designated constraints aren't met.
-type SaveRequestValidationError struct {
+// GetReplyValidationError is the validation error returned by
+// GetReply.Validate if the designated constraints aren't met.
+type GetReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1586,22 +1609,22 @@ type SaveRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SaveRequestValidationError) Field() string { return e.field }
+func (e GetReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SaveRequestValidationError) Reason() string { return e.reason }
+func (e GetReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SaveRequestValidationError) Cause() error { return e.cause }
+func (e GetReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SaveRequestValidationError) Key() bool { return e.key }
+func (e GetReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SaveRequestValidationError) ErrorName() string { return "SaveRequestValidationError" }
+func (e GetReplyValidationError) ErrorName() string { return "GetReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SaveRequestValidationError) Error() string {
+func (e GetReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1613,14 +1636,14 @@ func (e SaveRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSaveRequest.%s: %s%s",
+		"invalid %sGetReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SaveRequestValidationError{}
+var _ error = GetReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1628,45 +1651,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SaveRequestValidationError{}
+} = GetReplyValidationError{}
 
-// Validate checks the field values on SaveReply with the rules defined in the
+// Validate checks the field values on Violation with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *SaveReply) Validate() error {
+func (m *Violation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SaveReply with the rules defined in
+// ValidateAll checks the field values on Violation with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SaveReplyMultiError, or nil
+// result is a list of violation errors wrapped in ViolationMultiError, or nil
 // if none found.
-func (m *SaveReply) ValidateAll() error {
+func (m *Violation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SaveReply) validate(all bool) error {
+func (m *Violation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	// no validation rules for Path
+
+	// no validation rules for Rule
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return SaveReplyMultiError(errors)
+		return ViolationMultiError(errors)
 	}
 
 	return nil
 }
 
-// SaveReplyMultiError is an error wrapping multiple validation errors returned
-// by SaveReply.ValidateAll() if the designated constraints aren't met.
-type SaveReplyMultiError []error
+// ViolationMultiError is an error wrapping multiple validation errors returned
+// by Violation.ValidateAll() if the designated constraints aren't met.
+type ViolationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SaveReplyMultiError) Error() string {
+func (m ViolationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1675,11 +1702,11 @@ func (m SaveReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SaveReplyMultiError) AllErrors() []error { return m }
+func (m ViolationMultiError) AllErrors() []error { return m }
 
-// SaveReplyValidationError is the validation error returned by
-// SaveReply.Validate if the designated constraints aren't met.
-type SaveReplyValidationError struct {
+// ViolationValidationError is the validation error returned by
+// Violation.Validate if the designated constraints aren't met.
+type ViolationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1687,22 +1714,22 @@ type SaveReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SaveReplyValidationError) Field() string { return e.field }
+func (e ViolationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SaveReplyValidationError) Reason() string { return e.reason }
+func (e ViolationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SaveReplyValidationError) Cause() error { return e.cause }
+func (e ViolationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SaveReplyValidationError) Key() bool { return e.key }
+func (e ViolationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SaveReplyValidationError) ErrorName() string { return "SaveReplyValidationError" }
+func (e ViolationValidationError) ErrorName() string { return "ViolationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SaveReplyValidationError) Error() string {
+func (e ViolationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1714,14 +1741,14 @@ func (e SaveReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSaveReply.%s: %s%s",
+		"invalid %sViolation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SaveReplyValidationError{}
+var _ error = ViolationValidationError{}
 
 var _ interface {
 	Field() string
@@ -1729,50 +1756,111 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SaveReplyValidationError{}
+} = ViolationValidationError{}
 
-// Validate checks the field values on DeleteRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ListsReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DeleteRequest) Validate() error {
+func (m *ListsReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteRequestMultiError, or
+// ValidateAll checks the field values on ListsReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListsReplyMultiError, or
 // nil if none found.
-func (m *DeleteRequest) ValidateAll() error {
+func (m *ListsReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRequest) validate(all bool) error {
+func (m *ListsReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProductName
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
 
-	// no validation rules for CoderepoName
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListsReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListsReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListsReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for InsecureSkipCheck
+	}
+
+	for idx, item := range m.GetViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListsReplyValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListsReplyValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListsReplyValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteRequestMultiError(errors)
+		return ListsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteRequestMultiError []error
+// ListsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListsReply.ValidateAll() if the designated constraints aren't met.
+type ListsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRequestMultiError) Error() string {
+func (m ListsReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1781,11 +1869,11 @@ func (m DeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRequestMultiError) AllErrors() []error { return m }
+func (m ListsReplyMultiError) AllErrors() []error { return m }
 
-// DeleteRequestValidationError is the validation error returned by
-// DeleteRequest.Validate if the designated constraints aren't met.
-type DeleteRequestValidationError struct {
+// ListsReplyValidationError is the validation error returned by
+// ListsReply.Validate if the designated constraints aren't met.
+type ListsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1793,22 +1881,22 @@ type DeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRequestValidationError) Field() string { return e.field }
+func (e ListsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRequestValidationError) Reason() string { return e.reason }
+func (e ListsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRequestValidationError) Cause() error { return e.cause }
+func (e ListsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRequestValidationError) Key() bool { return e.key }
+func (e ListsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRequestValidationError) ErrorName() string { return "DeleteRequestValidationError" }
+func (e ListsReplyValidationError) ErrorName() string { return "ListsReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteRequestValidationError) Error() string {
+func (e ListsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1820,14 +1908,14 @@ func (e DeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRequest.%s: %s%s",
+		"invalid %sListsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRequestValidationError{}
+var _ error = ListsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1835,45 +1923,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRequestValidationError{}
+} = ListsReplyValidationError{}
 
-// Validate checks the field values on DeleteReply with the rules defined in
+// Validate checks the field values on SaveRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DeleteReply) Validate() error {
+func (m *SaveRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReply with the rules defined in
+// ValidateAll checks the field values on SaveRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteReplyMultiError, or
+// result is a list of violation errors wrapped in SaveRequestMultiError, or
 // nil if none found.
-func (m *DeleteReply) ValidateAll() error {
+func (m *SaveRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReply) validate(all bool) error {
+func (m *SaveRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	// no validation rules for ProductName
+
+	// no validation rules for CoderepoName
+
+	// no validation rules for InsecureSkipCheck
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SaveRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SaveRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SaveRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResourceVersion
+
+	// no validation rules for DryRun
+
+	// no validation rules for Proposal
+
+	// no validation rules for CommitMessage
 
 	if len(errors) > 0 {
-		return DeleteReplyMultiError(errors)
+		return SaveRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReplyMultiError is an error wrapping multiple validation errors
-// returned by DeleteReply.ValidateAll() if the designated constraints aren't met.
-type DeleteReplyMultiError []error
+// SaveRequestMultiError is an error wrapping multiple validation errors
+// returned by SaveRequest.ValidateAll() if the designated constraints aren't met.
+type SaveRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReplyMultiError) Error() string {
+func (m SaveRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1882,11 +2011,11 @@ func (m DeleteReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReplyMultiError) AllErrors() []error { return m }
+func (m SaveRequestMultiError) AllErrors() []error { return m }
 
-// DeleteReplyValidationError is the validation error returned by
-// DeleteReply.Validate if the designated constraints aren't met.
-type DeleteReplyValidationError struct {
+// SaveRequestValidationError is the validation error returned by
+// SaveRequest.Validate if the designated constraints aren't met.
+type SaveRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1894,22 +2023,22 @@ type DeleteReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReplyValidationError) Field() string { return e.field }
+func (e SaveRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReplyValidationError) Reason() string { return e.reason }
+func (e SaveRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReplyValidationError) Cause() error { return e.cause }
+func (e SaveRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReplyValidationError) Key() bool { return e.key }
+func (e SaveRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReplyValidationError) ErrorName() string { return "DeleteReplyValidationError" }
+func (e SaveRequestValidationError) ErrorName() string { return "SaveRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteReplyValidationError) Error() string {
+func (e SaveRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1921,14 +2050,14 @@ func (e DeleteReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReply.%s: %s%s",
+		"invalid %sSaveRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReplyValidationError{}
+var _ error = SaveRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1936,7 +2065,1380 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReplyValidationError{}
+} = SaveRequestValidationError{}
+
+// Validate checks the field values on SaveReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SaveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SaveReplyMultiError, or nil
+// if none found.
+func (m *SaveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	// no validation rules for DryRun
+
+	// no validation rules for Yaml
+
+	// no validation rules for Diff
+
+	// no validation rules for ProposalId
+
+	// no validation rules for ProposalUrl
+
+	// no validation rules for CommitSha
+
+	// no validation rules for Branch
+
+	// no validation rules for CommitUrl
+
+	// no validation rules for AutoMerged
+
+	if len(errors) > 0 {
+		return SaveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveReplyMultiError is an error wrapping multiple validation errors returned
+// by SaveReply.ValidateAll() if the designated constraints aren't met.
+type SaveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveReplyMultiError) AllErrors() []error { return m }
+
+// SaveReplyValidationError is the validation error returned by
+// SaveReply.Validate if the designated constraints aren't met.
+type SaveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveReplyValidationError) ErrorName() string { return "SaveReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SaveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveReplyValidationError{}
+
+// Validate checks the field values on DeleteRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteRequestMultiError, or
+// nil if none found.
+func (m *DeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductName
+
+	// no validation rules for CoderepoName
+
+	// no validation rules for InsecureSkipCheck
+
+	// no validation rules for ResourceVersion
+
+	// no validation rules for DryRun
+
+	// no validation rules for Proposal
+
+	// no validation rules for CommitMessage
+
+	if len(errors) > 0 {
+		return DeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRequestValidationError is the validation error returned by
+// DeleteRequest.Validate if the designated constraints aren't met.
+type DeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRequestValidationError) ErrorName() string { return "DeleteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRequestValidationError{}
+
+// Validate checks the field values on DeleteReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteReplyMultiError, or
+// nil if none found.
+func (m *DeleteReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	// no validation rules for DryRun
+
+	// no validation rules for Diff
+
+	// no validation rules for ProposalId
+
+	// no validation rules for ProposalUrl
+
+	// no validation rules for CommitSha
+
+	// no validation rules for Branch
+
+	// no validation rules for CommitUrl
+
+	// no validation rules for AutoMerged
+
+	if len(errors) > 0 {
+		return DeleteReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteReply.ValidateAll() if the designated constraints aren't met.
+type DeleteReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReplyMultiError) AllErrors() []error { return m }
+
+// DeleteReplyValidationError is the validation error returned by
+// DeleteReply.Validate if the designated constraints aren't met.
+type DeleteReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReplyValidationError) ErrorName() string { return "DeleteReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReplyValidationError{}
+
+// Validate checks the field values on HistoryRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistoryRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HistoryRequestMultiError,
+// or nil if none found.
+func (m *HistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductName
+
+	// no validation rules for CoderepoName
+
+	if len(errors) > 0 {
+		return HistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistoryRequestMultiError is an error wrapping multiple validation errors
+// returned by HistoryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistoryRequestMultiError) AllErrors() []error { return m }
+
+// HistoryRequestValidationError is the validation error returned by
+// HistoryRequest.Validate if the designated constraints aren't met.
+type HistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistoryRequestValidationError) ErrorName() string { return "HistoryRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistoryRequestValidationError{}
+
+// Validate checks the field values on Commit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Commit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Commit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CommitMultiError, or nil if none found.
+func (m *Commit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Commit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sha
+
+	// no validation rules for Author
+
+	// no validation rules for AuthorEmail
+
+	// no validation rules for Date
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return CommitMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitMultiError is an error wrapping multiple validation errors returned by
+// Commit.ValidateAll() if the designated constraints aren't met.
+type CommitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitMultiError) AllErrors() []error { return m }
+
+// CommitValidationError is the validation error returned by Commit.Validate if
+// the designated constraints aren't met.
+type CommitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitValidationError) ErrorName() string { return "CommitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitValidationError{}
+
+// Validate checks the field values on HistoryReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistoryReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HistoryReplyMultiError, or
+// nil if none found.
+func (m *HistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HistoryReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HistoryReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HistoryReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return HistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistoryReplyMultiError is an error wrapping multiple validation errors
+// returned by HistoryReply.ValidateAll() if the designated constraints aren't met.
+type HistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistoryReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistoryReplyMultiError) AllErrors() []error { return m }
+
+// HistoryReplyValidationError is the validation error returned by
+// HistoryReply.Validate if the designated constraints aren't met.
+type HistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistoryReplyValidationError) ErrorName() string { return "HistoryReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistoryReplyValidationError{}
+
+// Validate checks the field values on RollbackRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RollbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackRequestMultiError, or nil if none found.
+func (m *RollbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductName
+
+	// no validation rules for CoderepoName
+
+	// no validation rules for Revision
+
+	// no validation rules for InsecureSkipCheck
+
+	// no validation rules for ResourceVersion
+
+	// no validation rules for DryRun
+
+	// no validation rules for Proposal
+
+	// no validation rules for CommitMessage
+
+	if len(errors) > 0 {
+		return RollbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackRequestMultiError is an error wrapping multiple validation errors
+// returned by RollbackRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RollbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackRequestMultiError) AllErrors() []error { return m }
+
+// RollbackRequestValidationError is the validation error returned by
+// RollbackRequest.Validate if the designated constraints aren't met.
+type RollbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackRequestValidationError) ErrorName() string { return "RollbackRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RollbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackRequestValidationError{}
+
+// Validate checks the field values on RotateDeployKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateDeployKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateDeployKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateDeployKeyRequestMultiError, or nil if none found.
+func (m *RotateDeployKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateDeployKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductName
+
+	// no validation rules for CoderepoName
+
+	if len(errors) > 0 {
+		return RotateDeployKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateDeployKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RotateDeployKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RotateDeployKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateDeployKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateDeployKeyRequestMultiError) AllErrors() []error { return m }
+
+// RotateDeployKeyRequestValidationError is the validation error returned by
+// RotateDeployKeyRequest.Validate if the designated constraints aren't met.
+type RotateDeployKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateDeployKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateDeployKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateDeployKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateDeployKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateDeployKeyRequestValidationError) ErrorName() string {
+	return "RotateDeployKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateDeployKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateDeployKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateDeployKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateDeployKeyRequestValidationError{}
+
+// Validate checks the field values on RotateProductDeployKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateProductDeployKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateProductDeployKeysRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RotateProductDeployKeysRequestMultiError, or nil if none found.
+func (m *RotateProductDeployKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateProductDeployKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductName
+
+	if len(errors) > 0 {
+		return RotateProductDeployKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateProductDeployKeysRequestMultiError is an error wrapping multiple
+// validation errors returned by RotateProductDeployKeysRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RotateProductDeployKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateProductDeployKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateProductDeployKeysRequestMultiError) AllErrors() []error { return m }
+
+// RotateProductDeployKeysRequestValidationError is the validation error
+// returned by RotateProductDeployKeysRequest.Validate if the designated
+// constraints aren't met.
+type RotateProductDeployKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateProductDeployKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateProductDeployKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateProductDeployKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateProductDeployKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateProductDeployKeysRequestValidationError) ErrorName() string {
+	return "RotateProductDeployKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateProductDeployKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateProductDeployKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateProductDeployKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateProductDeployKeysRequestValidationError{}
+
+// Validate checks the field values on RotateTenantDeployKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateTenantDeployKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateTenantDeployKeysRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RotateTenantDeployKeysRequestMultiError, or nil if none found.
+func (m *RotateTenantDeployKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateTenantDeployKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RotateTenantDeployKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateTenantDeployKeysRequestMultiError is an error wrapping multiple
+// validation errors returned by RotateTenantDeployKeysRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RotateTenantDeployKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateTenantDeployKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateTenantDeployKeysRequestMultiError) AllErrors() []error { return m }
+
+// RotateTenantDeployKeysRequestValidationError is the validation error
+// returned by RotateTenantDeployKeysRequest.Validate if the designated
+// constraints aren't met.
+type RotateTenantDeployKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateTenantDeployKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateTenantDeployKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateTenantDeployKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateTenantDeployKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateTenantDeployKeysRequestValidationError) ErrorName() string {
+	return "RotateTenantDeployKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateTenantDeployKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateTenantDeployKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateTenantDeployKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateTenantDeployKeysRequestValidationError{}
+
+// Validate checks the field values on DeployKeyRotation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeployKeyRotation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeployKeyRotation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeployKeyRotationMultiError, or nil if none found.
+func (m *DeployKeyRotation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeployKeyRotation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Product
+
+	// no validation rules for Coderepo
+
+	// no validation rules for DeployKeyId
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return DeployKeyRotationMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeployKeyRotationMultiError is an error wrapping multiple validation errors
+// returned by DeployKeyRotation.ValidateAll() if the designated constraints
+// aren't met.
+type DeployKeyRotationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeployKeyRotationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeployKeyRotationMultiError) AllErrors() []error { return m }
+
+// DeployKeyRotationValidationError is the validation error returned by
+// DeployKeyRotation.Validate if the designated constraints aren't met.
+type DeployKeyRotationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeployKeyRotationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeployKeyRotationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeployKeyRotationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeployKeyRotationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeployKeyRotationValidationError) ErrorName() string {
+	return "DeployKeyRotationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeployKeyRotationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeployKeyRotation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeployKeyRotationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeployKeyRotationValidationError{}
+
+// Validate checks the field values on RotateDeployKeysReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateDeployKeysReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateDeployKeysReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateDeployKeysReplyMultiError, or nil if none found.
+func (m *RotateDeployKeysReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateDeployKeysReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RotateDeployKeysReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RotateDeployKeysReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RotateDeployKeysReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return RotateDeployKeysReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateDeployKeysReplyMultiError is an error wrapping multiple validation
+// errors returned by RotateDeployKeysReply.ValidateAll() if the designated
+// constraints aren't met.
+type RotateDeployKeysReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateDeployKeysReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateDeployKeysReplyMultiError) AllErrors() []error { return m }
+
+// RotateDeployKeysReplyValidationError is the validation error returned by
+// RotateDeployKeysReply.Validate if the designated constraints aren't met.
+type RotateDeployKeysReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateDeployKeysReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateDeployKeysReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateDeployKeysReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateDeployKeysReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateDeployKeysReplyValidationError) ErrorName() string {
+	return "RotateDeployKeysReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateDeployKeysReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateDeployKeysReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateDeployKeysReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateDeployKeysReplyValidationError{}
 
 // Validate checks the field values on SaveRequest_Body with the rules defined
 // in the proto definition for this message. If any rules are violated, the
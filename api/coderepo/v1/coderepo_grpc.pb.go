@@ -26,6 +26,11 @@ type CodeRepoClient interface {
 	ListCodeRepos(ctx context.Context, in *ListsRequest, opts ...grpc.CallOption) (*ListsReply, error)
 	SaveCodeRepo(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveReply, error)
 	DeleteCodeRepo(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error)
+	GetCodeRepoHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error)
+	RollbackCodeRepo(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*SaveReply, error)
+	RotateDeployKey(ctx context.Context, in *RotateDeployKeyRequest, opts ...grpc.CallOption) (*RotateDeployKeysReply, error)
+	RotateProductDeployKeys(ctx context.Context, in *RotateProductDeployKeysRequest, opts ...grpc.CallOption) (*RotateDeployKeysReply, error)
+	RotateTenantDeployKeys(ctx context.Context, in *RotateTenantDeployKeysRequest, opts ...grpc.CallOption) (*RotateDeployKeysReply, error)
 }
 
 type codeRepoClient struct {
@@ -72,6 +77,51 @@ func (c *codeRepoClient) DeleteCodeRepo(ctx context.Context, in *DeleteRequest,
 	return out, nil
 }
 
+func (c *codeRepoClient) GetCodeRepoHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error) {
+	out := new(HistoryReply)
+	err := c.cc.Invoke(ctx, "/api.coderepo.v1.CodeRepo/GetCodeRepoHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeRepoClient) RollbackCodeRepo(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*SaveReply, error) {
+	out := new(SaveReply)
+	err := c.cc.Invoke(ctx, "/api.coderepo.v1.CodeRepo/RollbackCodeRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeRepoClient) RotateDeployKey(ctx context.Context, in *RotateDeployKeyRequest, opts ...grpc.CallOption) (*RotateDeployKeysReply, error) {
+	out := new(RotateDeployKeysReply)
+	err := c.cc.Invoke(ctx, "/api.coderepo.v1.CodeRepo/RotateDeployKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeRepoClient) RotateProductDeployKeys(ctx context.Context, in *RotateProductDeployKeysRequest, opts ...grpc.CallOption) (*RotateDeployKeysReply, error) {
+	out := new(RotateDeployKeysReply)
+	err := c.cc.Invoke(ctx, "/api.coderepo.v1.CodeRepo/RotateProductDeployKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeRepoClient) RotateTenantDeployKeys(ctx context.Context, in *RotateTenantDeployKeysRequest, opts ...grpc.CallOption) (*RotateDeployKeysReply, error) {
+	out := new(RotateDeployKeysReply)
+	err := c.cc.Invoke(ctx, "/api.coderepo.v1.CodeRepo/RotateTenantDeployKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CodeRepoServer is the server API for CodeRepo service.
 // All implementations must embed UnimplementedCodeRepoServer
 // for forward compatibility
@@ -80,6 +130,11 @@ type CodeRepoServer interface {
 	ListCodeRepos(context.Context, *ListsRequest) (*ListsReply, error)
 	SaveCodeRepo(context.Context, *SaveRequest) (*SaveReply, error)
 	DeleteCodeRepo(context.Context, *DeleteRequest) (*DeleteReply, error)
+	GetCodeRepoHistory(context.Context, *HistoryRequest) (*HistoryReply, error)
+	RollbackCodeRepo(context.Context, *RollbackRequest) (*SaveReply, error)
+	RotateDeployKey(context.Context, *RotateDeployKeyRequest) (*RotateDeployKeysReply, error)
+	RotateProductDeployKeys(context.Context, *RotateProductDeployKeysRequest) (*RotateDeployKeysReply, error)
+	RotateTenantDeployKeys(context.Context, *RotateTenantDeployKeysRequest) (*RotateDeployKeysReply, error)
 	mustEmbedUnimplementedCodeRepoServer()
 }
 
@@ -99,6 +154,21 @@ func (UnimplementedCodeRepoServer) SaveCodeRepo(context.Context, *SaveRequest) (
 func (UnimplementedCodeRepoServer) DeleteCodeRepo(context.Context, *DeleteRequest) (*DeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCodeRepo not implemented")
 }
+func (UnimplementedCodeRepoServer) GetCodeRepoHistory(context.Context, *HistoryRequest) (*HistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCodeRepoHistory not implemented")
+}
+func (UnimplementedCodeRepoServer) RollbackCodeRepo(context.Context, *RollbackRequest) (*SaveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackCodeRepo not implemented")
+}
+func (UnimplementedCodeRepoServer) RotateDeployKey(context.Context, *RotateDeployKeyRequest) (*RotateDeployKeysReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDeployKey not implemented")
+}
+func (UnimplementedCodeRepoServer) RotateProductDeployKeys(context.Context, *RotateProductDeployKeysRequest) (*RotateDeployKeysReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateProductDeployKeys not implemented")
+}
+func (UnimplementedCodeRepoServer) RotateTenantDeployKeys(context.Context, *RotateTenantDeployKeysRequest) (*RotateDeployKeysReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateTenantDeployKeys not implemented")
+}
 func (UnimplementedCodeRepoServer) mustEmbedUnimplementedCodeRepoServer() {}
 
 // UnsafeCodeRepoServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +254,96 @@ func _CodeRepo_DeleteCodeRepo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CodeRepo_GetCodeRepoHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeRepoServer).GetCodeRepoHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.coderepo.v1.CodeRepo/GetCodeRepoHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeRepoServer).GetCodeRepoHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeRepo_RollbackCodeRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeRepoServer).RollbackCodeRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.coderepo.v1.CodeRepo/RollbackCodeRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeRepoServer).RollbackCodeRepo(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeRepo_RotateDeployKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateDeployKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeRepoServer).RotateDeployKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.coderepo.v1.CodeRepo/RotateDeployKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeRepoServer).RotateDeployKey(ctx, req.(*RotateDeployKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeRepo_RotateProductDeployKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateProductDeployKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeRepoServer).RotateProductDeployKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.coderepo.v1.CodeRepo/RotateProductDeployKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeRepoServer).RotateProductDeployKeys(ctx, req.(*RotateProductDeployKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeRepo_RotateTenantDeployKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTenantDeployKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeRepoServer).RotateTenantDeployKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.coderepo.v1.CodeRepo/RotateTenantDeployKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeRepoServer).RotateTenantDeployKeys(ctx, req.(*RotateTenantDeployKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CodeRepo_ServiceDesc is the grpc.ServiceDesc for CodeRepo service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +367,26 @@ var CodeRepo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCodeRepo",
 			Handler:    _CodeRepo_DeleteCodeRepo_Handler,
 		},
+		{
+			MethodName: "GetCodeRepoHistory",
+			Handler:    _CodeRepo_GetCodeRepoHistory_Handler,
+		},
+		{
+			MethodName: "RollbackCodeRepo",
+			Handler:    _CodeRepo_RollbackCodeRepo_Handler,
+		},
+		{
+			MethodName: "RotateDeployKey",
+			Handler:    _CodeRepo_RotateDeployKey_Handler,
+		},
+		{
+			MethodName: "RotateProductDeployKeys",
+			Handler:    _CodeRepo_RotateProductDeployKeys_Handler,
+		},
+		{
+			MethodName: "RotateTenantDeployKeys",
+			Handler:    _CodeRepo_RotateTenantDeployKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coderepo/v1/coderepo.proto",
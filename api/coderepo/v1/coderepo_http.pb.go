@@ -21,13 +21,23 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationCodeRepoDeleteCodeRepo = "/api.coderepo.v1.CodeRepo/DeleteCodeRepo"
 const OperationCodeRepoGetCodeRepo = "/api.coderepo.v1.CodeRepo/GetCodeRepo"
+const OperationCodeRepoGetCodeRepoHistory = "/api.coderepo.v1.CodeRepo/GetCodeRepoHistory"
 const OperationCodeRepoListCodeRepos = "/api.coderepo.v1.CodeRepo/ListCodeRepos"
+const OperationCodeRepoRollbackCodeRepo = "/api.coderepo.v1.CodeRepo/RollbackCodeRepo"
+const OperationCodeRepoRotateDeployKey = "/api.coderepo.v1.CodeRepo/RotateDeployKey"
+const OperationCodeRepoRotateProductDeployKeys = "/api.coderepo.v1.CodeRepo/RotateProductDeployKeys"
+const OperationCodeRepoRotateTenantDeployKeys = "/api.coderepo.v1.CodeRepo/RotateTenantDeployKeys"
 const OperationCodeRepoSaveCodeRepo = "/api.coderepo.v1.CodeRepo/SaveCodeRepo"
 
 type CodeRepoHTTPServer interface {
 	DeleteCodeRepo(context.Context, *DeleteRequest) (*DeleteReply, error)
 	GetCodeRepo(context.Context, *GetRequest) (*GetReply, error)
+	GetCodeRepoHistory(context.Context, *HistoryRequest) (*HistoryReply, error)
 	ListCodeRepos(context.Context, *ListsRequest) (*ListsReply, error)
+	RollbackCodeRepo(context.Context, *RollbackRequest) (*SaveReply, error)
+	RotateDeployKey(context.Context, *RotateDeployKeyRequest) (*RotateDeployKeysReply, error)
+	RotateProductDeployKeys(context.Context, *RotateProductDeployKeysRequest) (*RotateDeployKeysReply, error)
+	RotateTenantDeployKeys(context.Context, *RotateTenantDeployKeysRequest) (*RotateDeployKeysReply, error)
 	SaveCodeRepo(context.Context, *SaveRequest) (*SaveReply, error)
 }
 
@@ -37,6 +47,11 @@ func RegisterCodeRepoHTTPServer(s *http.Server, srv CodeRepoHTTPServer) {
 	r.GET("/api/v1/products/{productName}/coderepos", _CodeRepo_ListCodeRepos0_HTTP_Handler(srv))
 	r.POST("/api/v1/products/{productName}/coderepos/{coderepoName}", _CodeRepo_SaveCodeRepo0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/products/{productName}/coderepos/{coderepoName}", _CodeRepo_DeleteCodeRepo0_HTTP_Handler(srv))
+	r.GET("/api/v1/products/{productName}/coderepos/{coderepoName}/history", _CodeRepo_GetCodeRepoHistory0_HTTP_Handler(srv))
+	r.POST("/api/v1/products/{productName}/coderepos/{coderepoName}/history/{revision}:rollback", _CodeRepo_RollbackCodeRepo0_HTTP_Handler(srv))
+	r.POST("/api/v1/products/{productName}/coderepos/{coderepoName}/deploykey:rotate", _CodeRepo_RotateDeployKey0_HTTP_Handler(srv))
+	r.POST("/api/v1/products/{productName}/deploykeys:rotate", _CodeRepo_RotateProductDeployKeys0_HTTP_Handler(srv))
+	r.POST("/api/v1/deploykeys:rotate", _CodeRepo_RotateTenantDeployKeys0_HTTP_Handler(srv))
 }
 
 func _CodeRepo_GetCodeRepo0_HTTP_Handler(srv CodeRepoHTTPServer) func(ctx http.Context) error {
@@ -130,10 +145,122 @@ func _CodeRepo_DeleteCodeRepo0_HTTP_Handler(srv CodeRepoHTTPServer) func(ctx htt
 	}
 }
 
+func _CodeRepo_GetCodeRepoHistory0_HTTP_Handler(srv CodeRepoHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeRepoGetCodeRepoHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCodeRepoHistory(ctx, req.(*HistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeRepo_RollbackCodeRepo0_HTTP_Handler(srv CodeRepoHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeRepoRollbackCodeRepo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackCodeRepo(ctx, req.(*RollbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SaveReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeRepo_RotateDeployKey0_HTTP_Handler(srv CodeRepoHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RotateDeployKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeRepoRotateDeployKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RotateDeployKey(ctx, req.(*RotateDeployKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RotateDeployKeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeRepo_RotateProductDeployKeys0_HTTP_Handler(srv CodeRepoHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RotateProductDeployKeysRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeRepoRotateProductDeployKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RotateProductDeployKeys(ctx, req.(*RotateProductDeployKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RotateDeployKeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeRepo_RotateTenantDeployKeys0_HTTP_Handler(srv CodeRepoHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RotateTenantDeployKeysRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeRepoRotateTenantDeployKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RotateTenantDeployKeys(ctx, req.(*RotateTenantDeployKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RotateDeployKeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type CodeRepoHTTPClient interface {
 	DeleteCodeRepo(ctx context.Context, req *DeleteRequest, opts ...http.CallOption) (rsp *DeleteReply, err error)
 	GetCodeRepo(ctx context.Context, req *GetRequest, opts ...http.CallOption) (rsp *GetReply, err error)
+	GetCodeRepoHistory(ctx context.Context, req *HistoryRequest, opts ...http.CallOption) (rsp *HistoryReply, err error)
 	ListCodeRepos(ctx context.Context, req *ListsRequest, opts ...http.CallOption) (rsp *ListsReply, err error)
+	RollbackCodeRepo(ctx context.Context, req *RollbackRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
+	RotateDeployKey(ctx context.Context, req *RotateDeployKeyRequest, opts ...http.CallOption) (rsp *RotateDeployKeysReply, err error)
+	RotateProductDeployKeys(ctx context.Context, req *RotateProductDeployKeysRequest, opts ...http.CallOption) (rsp *RotateDeployKeysReply, err error)
+	RotateTenantDeployKeys(ctx context.Context, req *RotateTenantDeployKeysRequest, opts ...http.CallOption) (rsp *RotateDeployKeysReply, err error)
 	SaveCodeRepo(ctx context.Context, req *SaveRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
 }
 
@@ -171,6 +298,19 @@ func (c *CodeRepoHTTPClientImpl) GetCodeRepo(ctx context.Context, in *GetRequest
 	return &out, err
 }
 
+func (c *CodeRepoHTTPClientImpl) GetCodeRepoHistory(ctx context.Context, in *HistoryRequest, opts ...http.CallOption) (*HistoryReply, error) {
+	var out HistoryReply
+	pattern := "/api/v1/products/{productName}/coderepos/{coderepoName}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeRepoGetCodeRepoHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *CodeRepoHTTPClientImpl) ListCodeRepos(ctx context.Context, in *ListsRequest, opts ...http.CallOption) (*ListsReply, error) {
 	var out ListsReply
 	pattern := "/api/v1/products/{productName}/coderepos"
@@ -184,6 +324,58 @@ func (c *CodeRepoHTTPClientImpl) ListCodeRepos(ctx context.Context, in *ListsReq
 	return &out, err
 }
 
+func (c *CodeRepoHTTPClientImpl) RollbackCodeRepo(ctx context.Context, in *RollbackRequest, opts ...http.CallOption) (*SaveReply, error) {
+	var out SaveReply
+	pattern := "/api/v1/products/{productName}/coderepos/{coderepoName}/history/{revision}:rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCodeRepoRollbackCodeRepo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CodeRepoHTTPClientImpl) RotateDeployKey(ctx context.Context, in *RotateDeployKeyRequest, opts ...http.CallOption) (*RotateDeployKeysReply, error) {
+	var out RotateDeployKeysReply
+	pattern := "/api/v1/products/{productName}/coderepos/{coderepoName}/deploykey:rotate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCodeRepoRotateDeployKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CodeRepoHTTPClientImpl) RotateProductDeployKeys(ctx context.Context, in *RotateProductDeployKeysRequest, opts ...http.CallOption) (*RotateDeployKeysReply, error) {
+	var out RotateDeployKeysReply
+	pattern := "/api/v1/products/{productName}/deploykeys:rotate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCodeRepoRotateProductDeployKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CodeRepoHTTPClientImpl) RotateTenantDeployKeys(ctx context.Context, in *RotateTenantDeployKeysRequest, opts ...http.CallOption) (*RotateDeployKeysReply, error) {
+	var out RotateDeployKeysReply
+	pattern := "/api/v1/deploykeys:rotate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCodeRepoRotateTenantDeployKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *CodeRepoHTTPClientImpl) SaveCodeRepo(ctx context.Context, in *SaveRequest, opts ...http.CallOption) (*SaveReply, error) {
 	var out SaveReply
 	pattern := "/api/v1/products/{productName}/coderepos/{coderepoName}"
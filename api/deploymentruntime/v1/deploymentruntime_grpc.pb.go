@@ -26,6 +26,8 @@ type DeploymentruntimeClient interface {
 	ListDeploymentRuntimes(ctx context.Context, in *ListsRequest, opts ...grpc.CallOption) (*ListsReply, error)
 	SaveDeploymentRuntime(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveReply, error)
 	DeleteDeploymentRuntime(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error)
+	GetDeploymentRuntimeHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error)
+	RollbackDeploymentRuntime(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*SaveReply, error)
 }
 
 type deploymentruntimeClient struct {
@@ -72,6 +74,24 @@ func (c *deploymentruntimeClient) DeleteDeploymentRuntime(ctx context.Context, i
 	return out, nil
 }
 
+func (c *deploymentruntimeClient) GetDeploymentRuntimeHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error) {
+	out := new(HistoryReply)
+	err := c.cc.Invoke(ctx, "/api.deploymentruntime.v1.Deploymentruntime/GetDeploymentRuntimeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentruntimeClient) RollbackDeploymentRuntime(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*SaveReply, error) {
+	out := new(SaveReply)
+	err := c.cc.Invoke(ctx, "/api.deploymentruntime.v1.Deploymentruntime/RollbackDeploymentRuntime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeploymentruntimeServer is the server API for Deploymentruntime service.
 // All implementations must embed UnimplementedDeploymentruntimeServer
 // for forward compatibility
@@ -80,6 +100,8 @@ type DeploymentruntimeServer interface {
 	ListDeploymentRuntimes(context.Context, *ListsRequest) (*ListsReply, error)
 	SaveDeploymentRuntime(context.Context, *SaveRequest) (*SaveReply, error)
 	DeleteDeploymentRuntime(context.Context, *DeleteRequest) (*DeleteReply, error)
+	GetDeploymentRuntimeHistory(context.Context, *HistoryRequest) (*HistoryReply, error)
+	RollbackDeploymentRuntime(context.Context, *RollbackRequest) (*SaveReply, error)
 	mustEmbedUnimplementedDeploymentruntimeServer()
 }
 
@@ -99,6 +121,12 @@ func (UnimplementedDeploymentruntimeServer) SaveDeploymentRuntime(context.Contex
 func (UnimplementedDeploymentruntimeServer) DeleteDeploymentRuntime(context.Context, *DeleteRequest) (*DeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeploymentRuntime not implemented")
 }
+func (UnimplementedDeploymentruntimeServer) GetDeploymentRuntimeHistory(context.Context, *HistoryRequest) (*HistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentRuntimeHistory not implemented")
+}
+func (UnimplementedDeploymentruntimeServer) RollbackDeploymentRuntime(context.Context, *RollbackRequest) (*SaveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackDeploymentRuntime not implemented")
+}
 func (UnimplementedDeploymentruntimeServer) mustEmbedUnimplementedDeploymentruntimeServer() {}
 
 // UnsafeDeploymentruntimeServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +212,42 @@ func _Deploymentruntime_DeleteDeploymentRuntime_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Deploymentruntime_GetDeploymentRuntimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentruntimeServer).GetDeploymentRuntimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.deploymentruntime.v1.Deploymentruntime/GetDeploymentRuntimeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentruntimeServer).GetDeploymentRuntimeHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deploymentruntime_RollbackDeploymentRuntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentruntimeServer).RollbackDeploymentRuntime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.deploymentruntime.v1.Deploymentruntime/RollbackDeploymentRuntime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentruntimeServer).RollbackDeploymentRuntime(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Deploymentruntime_ServiceDesc is the grpc.ServiceDesc for Deploymentruntime service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +271,14 @@ var Deploymentruntime_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDeploymentRuntime",
 			Handler:    _Deploymentruntime_DeleteDeploymentRuntime_Handler,
 		},
+		{
+			MethodName: "GetDeploymentRuntimeHistory",
+			Handler:    _Deploymentruntime_GetDeploymentRuntimeHistory_Handler,
+		},
+		{
+			MethodName: "RollbackDeploymentRuntime",
+			Handler:    _Deploymentruntime_RollbackDeploymentRuntime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deploymentruntime/v1/deploymentruntime.proto",
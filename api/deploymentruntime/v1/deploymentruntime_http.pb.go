@@ -21,13 +21,17 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationDeploymentruntimeDeleteDeploymentRuntime = "/api.deploymentruntime.v1.Deploymentruntime/DeleteDeploymentRuntime"
 const OperationDeploymentruntimeGetDeploymentRuntime = "/api.deploymentruntime.v1.Deploymentruntime/GetDeploymentRuntime"
+const OperationDeploymentruntimeGetDeploymentRuntimeHistory = "/api.deploymentruntime.v1.Deploymentruntime/GetDeploymentRuntimeHistory"
 const OperationDeploymentruntimeListDeploymentRuntimes = "/api.deploymentruntime.v1.Deploymentruntime/ListDeploymentRuntimes"
+const OperationDeploymentruntimeRollbackDeploymentRuntime = "/api.deploymentruntime.v1.Deploymentruntime/RollbackDeploymentRuntime"
 const OperationDeploymentruntimeSaveDeploymentRuntime = "/api.deploymentruntime.v1.Deploymentruntime/SaveDeploymentRuntime"
 
 type DeploymentruntimeHTTPServer interface {
 	DeleteDeploymentRuntime(context.Context, *DeleteRequest) (*DeleteReply, error)
 	GetDeploymentRuntime(context.Context, *GetRequest) (*GetReply, error)
+	GetDeploymentRuntimeHistory(context.Context, *HistoryRequest) (*HistoryReply, error)
 	ListDeploymentRuntimes(context.Context, *ListsRequest) (*ListsReply, error)
+	RollbackDeploymentRuntime(context.Context, *RollbackRequest) (*SaveReply, error)
 	SaveDeploymentRuntime(context.Context, *SaveRequest) (*SaveReply, error)
 }
 
@@ -37,6 +41,8 @@ func RegisterDeploymentruntimeHTTPServer(s *http.Server, srv DeploymentruntimeHT
 	r.GET("/api/v1/products/{productName}/deploymentruntimes", _Deploymentruntime_ListDeploymentRuntimes0_HTTP_Handler(srv))
 	r.POST("/api/v1/products/{productName}/deploymentruntimes/{deploymentruntimeName}", _Deploymentruntime_SaveDeploymentRuntime0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/products/{productName}/deploymentruntimes/{deploymentruntimeName}", _Deploymentruntime_DeleteDeploymentRuntime0_HTTP_Handler(srv))
+	r.GET("/api/v1/products/{productName}/deploymentruntimes/{deploymentruntimeName}/history", _Deploymentruntime_GetDeploymentRuntimeHistory0_HTTP_Handler(srv))
+	r.POST("/api/v1/products/{productName}/deploymentruntimes/{deploymentruntimeName}/history/{revision}:rollback", _Deploymentruntime_RollbackDeploymentRuntime0_HTTP_Handler(srv))
 }
 
 func _Deploymentruntime_GetDeploymentRuntime0_HTTP_Handler(srv DeploymentruntimeHTTPServer) func(ctx http.Context) error {
@@ -130,10 +136,56 @@ func _Deploymentruntime_DeleteDeploymentRuntime0_HTTP_Handler(srv Deploymentrunt
 	}
 }
 
+func _Deploymentruntime_GetDeploymentRuntimeHistory0_HTTP_Handler(srv DeploymentruntimeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDeploymentruntimeGetDeploymentRuntimeHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDeploymentRuntimeHistory(ctx, req.(*HistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Deploymentruntime_RollbackDeploymentRuntime0_HTTP_Handler(srv DeploymentruntimeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDeploymentruntimeRollbackDeploymentRuntime)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackDeploymentRuntime(ctx, req.(*RollbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SaveReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type DeploymentruntimeHTTPClient interface {
 	DeleteDeploymentRuntime(ctx context.Context, req *DeleteRequest, opts ...http.CallOption) (rsp *DeleteReply, err error)
 	GetDeploymentRuntime(ctx context.Context, req *GetRequest, opts ...http.CallOption) (rsp *GetReply, err error)
+	GetDeploymentRuntimeHistory(ctx context.Context, req *HistoryRequest, opts ...http.CallOption) (rsp *HistoryReply, err error)
 	ListDeploymentRuntimes(ctx context.Context, req *ListsRequest, opts ...http.CallOption) (rsp *ListsReply, err error)
+	RollbackDeploymentRuntime(ctx context.Context, req *RollbackRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
 	SaveDeploymentRuntime(ctx context.Context, req *SaveRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
 }
 
@@ -171,6 +223,19 @@ func (c *DeploymentruntimeHTTPClientImpl) GetDeploymentRuntime(ctx context.Conte
 	return &out, err
 }
 
+func (c *DeploymentruntimeHTTPClientImpl) GetDeploymentRuntimeHistory(ctx context.Context, in *HistoryRequest, opts ...http.CallOption) (*HistoryReply, error) {
+	var out HistoryReply
+	pattern := "/api/v1/products/{productName}/deploymentruntimes/{deploymentruntimeName}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDeploymentruntimeGetDeploymentRuntimeHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *DeploymentruntimeHTTPClientImpl) ListDeploymentRuntimes(ctx context.Context, in *ListsRequest, opts ...http.CallOption) (*ListsReply, error) {
 	var out ListsReply
 	pattern := "/api/v1/products/{productName}/deploymentruntimes"
@@ -184,6 +249,19 @@ func (c *DeploymentruntimeHTTPClientImpl) ListDeploymentRuntimes(ctx context.Con
 	return &out, err
 }
 
+func (c *DeploymentruntimeHTTPClientImpl) RollbackDeploymentRuntime(ctx context.Context, in *RollbackRequest, opts ...http.CallOption) (*SaveReply, error) {
+	var out SaveReply
+	pattern := "/api/v1/products/{productName}/deploymentruntimes/{deploymentruntimeName}/history/{revision}:rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDeploymentruntimeRollbackDeploymentRuntime))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *DeploymentruntimeHTTPClientImpl) SaveDeploymentRuntime(ctx context.Context, in *SaveRequest, opts ...http.CallOption) (*SaveReply, error) {
 	var out SaveReply
 	pattern := "/api/v1/products/{productName}/deploymentruntimes/{deploymentruntimeName}"
@@ -26,6 +26,8 @@ type EnvironmentClient interface {
 	ListEnvironments(ctx context.Context, in *ListsRequest, opts ...grpc.CallOption) (*ListsReply, error)
 	SaveEnvironment(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveReply, error)
 	DeleteEnvironment(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error)
+	GetEnvironmentHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error)
+	RollbackEnvironment(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*SaveReply, error)
 }
 
 type environmentClient struct {
@@ -72,6 +74,24 @@ func (c *environmentClient) DeleteEnvironment(ctx context.Context, in *DeleteReq
 	return out, nil
 }
 
+func (c *environmentClient) GetEnvironmentHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error) {
+	out := new(HistoryReply)
+	err := c.cc.Invoke(ctx, "/api.environment.v1.Environment/GetEnvironmentHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *environmentClient) RollbackEnvironment(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*SaveReply, error) {
+	out := new(SaveReply)
+	err := c.cc.Invoke(ctx, "/api.environment.v1.Environment/RollbackEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnvironmentServer is the server API for Environment service.
 // All implementations must embed UnimplementedEnvironmentServer
 // for forward compatibility
@@ -80,6 +100,8 @@ type EnvironmentServer interface {
 	ListEnvironments(context.Context, *ListsRequest) (*ListsReply, error)
 	SaveEnvironment(context.Context, *SaveRequest) (*SaveReply, error)
 	DeleteEnvironment(context.Context, *DeleteRequest) (*DeleteReply, error)
+	GetEnvironmentHistory(context.Context, *HistoryRequest) (*HistoryReply, error)
+	RollbackEnvironment(context.Context, *RollbackRequest) (*SaveReply, error)
 	mustEmbedUnimplementedEnvironmentServer()
 }
 
@@ -99,6 +121,12 @@ func (UnimplementedEnvironmentServer) SaveEnvironment(context.Context, *SaveRequ
 func (UnimplementedEnvironmentServer) DeleteEnvironment(context.Context, *DeleteRequest) (*DeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironment not implemented")
 }
+func (UnimplementedEnvironmentServer) GetEnvironmentHistory(context.Context, *HistoryRequest) (*HistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironmentHistory not implemented")
+}
+func (UnimplementedEnvironmentServer) RollbackEnvironment(context.Context, *RollbackRequest) (*SaveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackEnvironment not implemented")
+}
 func (UnimplementedEnvironmentServer) mustEmbedUnimplementedEnvironmentServer() {}
 
 // UnsafeEnvironmentServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +212,42 @@ func _Environment_DeleteEnvironment_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Environment_GetEnvironmentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvironmentServer).GetEnvironmentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.environment.v1.Environment/GetEnvironmentHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvironmentServer).GetEnvironmentHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Environment_RollbackEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvironmentServer).RollbackEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.environment.v1.Environment/RollbackEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvironmentServer).RollbackEnvironment(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Environment_ServiceDesc is the grpc.ServiceDesc for Environment service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +271,14 @@ var Environment_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEnvironment",
 			Handler:    _Environment_DeleteEnvironment_Handler,
 		},
+		{
+			MethodName: "GetEnvironmentHistory",
+			Handler:    _Environment_GetEnvironmentHistory_Handler,
+		},
+		{
+			MethodName: "RollbackEnvironment",
+			Handler:    _Environment_RollbackEnvironment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "environment/v1/environment.proto",
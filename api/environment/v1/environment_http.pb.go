@@ -21,13 +21,17 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationEnvironmentDeleteEnvironment = "/api.environment.v1.Environment/DeleteEnvironment"
 const OperationEnvironmentGetEnvironment = "/api.environment.v1.Environment/GetEnvironment"
+const OperationEnvironmentGetEnvironmentHistory = "/api.environment.v1.Environment/GetEnvironmentHistory"
 const OperationEnvironmentListEnvironments = "/api.environment.v1.Environment/ListEnvironments"
+const OperationEnvironmentRollbackEnvironment = "/api.environment.v1.Environment/RollbackEnvironment"
 const OperationEnvironmentSaveEnvironment = "/api.environment.v1.Environment/SaveEnvironment"
 
 type EnvironmentHTTPServer interface {
 	DeleteEnvironment(context.Context, *DeleteRequest) (*DeleteReply, error)
 	GetEnvironment(context.Context, *GetRequest) (*GetReply, error)
+	GetEnvironmentHistory(context.Context, *HistoryRequest) (*HistoryReply, error)
 	ListEnvironments(context.Context, *ListsRequest) (*ListsReply, error)
+	RollbackEnvironment(context.Context, *RollbackRequest) (*SaveReply, error)
 	SaveEnvironment(context.Context, *SaveRequest) (*SaveReply, error)
 }
 
@@ -37,6 +41,8 @@ func RegisterEnvironmentHTTPServer(s *http.Server, srv EnvironmentHTTPServer) {
 	r.GET("/api/v1/products/{productName}/environments", _Environment_ListEnvironments0_HTTP_Handler(srv))
 	r.POST("/api/v1/products/{productName}/environments/{environmentName}", _Environment_SaveEnvironment0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/products/{productName}/environments/{environmentName}", _Environment_DeleteEnvironment0_HTTP_Handler(srv))
+	r.GET("/api/v1/products/{productName}/environments/{environmentName}/history", _Environment_GetEnvironmentHistory0_HTTP_Handler(srv))
+	r.POST("/api/v1/products/{productName}/environments/{environmentName}/history/{revision}:rollback", _Environment_RollbackEnvironment0_HTTP_Handler(srv))
 }
 
 func _Environment_GetEnvironment0_HTTP_Handler(srv EnvironmentHTTPServer) func(ctx http.Context) error {
@@ -130,10 +136,56 @@ func _Environment_DeleteEnvironment0_HTTP_Handler(srv EnvironmentHTTPServer) fun
 	}
 }
 
+func _Environment_GetEnvironmentHistory0_HTTP_Handler(srv EnvironmentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEnvironmentGetEnvironmentHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetEnvironmentHistory(ctx, req.(*HistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Environment_RollbackEnvironment0_HTTP_Handler(srv EnvironmentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEnvironmentRollbackEnvironment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackEnvironment(ctx, req.(*RollbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SaveReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type EnvironmentHTTPClient interface {
 	DeleteEnvironment(ctx context.Context, req *DeleteRequest, opts ...http.CallOption) (rsp *DeleteReply, err error)
 	GetEnvironment(ctx context.Context, req *GetRequest, opts ...http.CallOption) (rsp *GetReply, err error)
+	GetEnvironmentHistory(ctx context.Context, req *HistoryRequest, opts ...http.CallOption) (rsp *HistoryReply, err error)
 	ListEnvironments(ctx context.Context, req *ListsRequest, opts ...http.CallOption) (rsp *ListsReply, err error)
+	RollbackEnvironment(ctx context.Context, req *RollbackRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
 	SaveEnvironment(ctx context.Context, req *SaveRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
 }
 
@@ -171,6 +223,19 @@ func (c *EnvironmentHTTPClientImpl) GetEnvironment(ctx context.Context, in *GetR
 	return &out, err
 }
 
+func (c *EnvironmentHTTPClientImpl) GetEnvironmentHistory(ctx context.Context, in *HistoryRequest, opts ...http.CallOption) (*HistoryReply, error) {
+	var out HistoryReply
+	pattern := "/api/v1/products/{productName}/environments/{environmentName}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEnvironmentGetEnvironmentHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *EnvironmentHTTPClientImpl) ListEnvironments(ctx context.Context, in *ListsRequest, opts ...http.CallOption) (*ListsReply, error) {
 	var out ListsReply
 	pattern := "/api/v1/products/{productName}/environments"
@@ -184,6 +249,19 @@ func (c *EnvironmentHTTPClientImpl) ListEnvironments(ctx context.Context, in *Li
 	return &out, err
 }
 
+func (c *EnvironmentHTTPClientImpl) RollbackEnvironment(ctx context.Context, in *RollbackRequest, opts ...http.CallOption) (*SaveReply, error) {
+	var out SaveReply
+	pattern := "/api/v1/products/{productName}/environments/{environmentName}/history/{revision}:rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEnvironmentRollbackEnvironment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *EnvironmentHTTPClientImpl) SaveEnvironment(ctx context.Context, in *SaveRequest, opts ...http.CallOption) (*SaveReply, error) {
 	var out SaveReply
 	pattern := "/api/v1/products/{productName}/environments/{environmentName}"
@@ -1335,6 +1335,10 @@ func (m *SaveProductRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Template
+
+	// no validation rules for RepoNames
+
 	if len(errors) > 0 {
 		return SaveProductRequestMultiError(errors)
 	}
@@ -1439,6 +1443,14 @@ func (m *SaveProductReply) validate(all bool) error {
 
 	// no validation rules for Msg
 
+	// no validation rules for CommitSha
+
+	// no validation rules for Branch
+
+	// no validation rules for CommitUrl
+
+	// no validation rules for AutoMerged
+
 	if len(errors) > 0 {
 		return SaveProductReplyMultiError(errors)
 	}
@@ -1541,6 +1553,12 @@ func (m *DeleteProductRequest) validate(all bool) error {
 
 	// no validation rules for ProductName
 
+	// no validation rules for Cascade
+
+	// no validation rules for Confirm
+
+	// no validation rules for Fingerprint
+
 	if len(errors) > 0 {
 		return DeleteProductRequestMultiError(errors)
 	}
@@ -1621,44 +1639,284 @@ var _ interface {
 	ErrorName() string
 } = DeleteProductRequestValidationError{}
 
-// Validate checks the field values on DeleteProductReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProductReply) Validate() error {
+// Validate checks the field values on ResourceRef with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ResourceRef) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProductReply with the rules
+// ValidateAll checks the field values on ResourceRef with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResourceRefMultiError, or
+// nil if none found.
+func (m *ResourceRef) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return ResourceRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceRefMultiError is an error wrapping multiple validation errors
+// returned by ResourceRef.ValidateAll() if the designated constraints aren't met.
+type ResourceRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceRefMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceRefMultiError) AllErrors() []error { return m }
+
+// ResourceRefValidationError is the validation error returned by
+// ResourceRef.Validate if the designated constraints aren't met.
+type ResourceRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceRefValidationError) ErrorName() string { return "ResourceRefValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceRefValidationError{}
+
+// Validate checks the field values on DeletionPlan with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeletionPlan) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletionPlan with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeletionPlanMultiError, or
+// nil if none found.
+func (m *DeletionPlan) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletionPlan) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeletionPlanValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeletionPlanValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeletionPlanValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Fingerprint
+
+	if len(errors) > 0 {
+		return DeletionPlanMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletionPlanMultiError is an error wrapping multiple validation errors
+// returned by DeletionPlan.ValidateAll() if the designated constraints aren't met.
+type DeletionPlanMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletionPlanMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletionPlanMultiError) AllErrors() []error { return m }
+
+// DeletionPlanValidationError is the validation error returned by
+// DeletionPlan.Validate if the designated constraints aren't met.
+type DeletionPlanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletionPlanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletionPlanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletionPlanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletionPlanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletionPlanValidationError) ErrorName() string { return "DeletionPlanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeletionPlanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletionPlan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletionPlanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletionPlanValidationError{}
+
+// Validate checks the field values on DeletionFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeletionFailure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletionFailure with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteProductReplyMultiError, or nil if none found.
-func (m *DeleteProductReply) ValidateAll() error {
+// DeletionFailureMultiError, or nil if none found.
+func (m *DeletionFailure) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProductReply) validate(all bool) error {
+func (m *DeletionFailure) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	// no validation rules for Target
+
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return DeleteProductReplyMultiError(errors)
+		return DeletionFailureMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProductReplyMultiError is an error wrapping multiple validation errors
-// returned by DeleteProductReply.ValidateAll() if the designated constraints
+// DeletionFailureMultiError is an error wrapping multiple validation errors
+// returned by DeletionFailure.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteProductReplyMultiError []error
+type DeletionFailureMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProductReplyMultiError) Error() string {
+func (m DeletionFailureMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1667,11 +1925,11 @@ func (m DeleteProductReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProductReplyMultiError) AllErrors() []error { return m }
+func (m DeletionFailureMultiError) AllErrors() []error { return m }
 
-// DeleteProductReplyValidationError is the validation error returned by
-// DeleteProductReply.Validate if the designated constraints aren't met.
-type DeleteProductReplyValidationError struct {
+// DeletionFailureValidationError is the validation error returned by
+// DeletionFailure.Validate if the designated constraints aren't met.
+type DeletionFailureValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1679,24 +1937,22 @@ type DeleteProductReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProductReplyValidationError) Field() string { return e.field }
+func (e DeletionFailureValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProductReplyValidationError) Reason() string { return e.reason }
+func (e DeletionFailureValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProductReplyValidationError) Cause() error { return e.cause }
+func (e DeletionFailureValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProductReplyValidationError) Key() bool { return e.key }
+func (e DeletionFailureValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProductReplyValidationError) ErrorName() string {
-	return "DeleteProductReplyValidationError"
-}
+func (e DeletionFailureValidationError) ErrorName() string { return "DeletionFailureValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteProductReplyValidationError) Error() string {
+func (e DeletionFailureValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1708,14 +1964,14 @@ func (e DeleteProductReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProductReply.%s: %s%s",
+		"invalid %sDeletionFailure.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProductReplyValidationError{}
+var _ error = DeletionFailureValidationError{}
 
 var _ interface {
 	Field() string
@@ -1723,4 +1979,606 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProductReplyValidationError{}
+} = DeletionFailureValidationError{}
+
+// Validate checks the field values on DeleteProductReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProductReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProductReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProductReplyMultiError, or nil if none found.
+func (m *DeleteProductReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProductReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	if all {
+		switch v := interface{}(m.GetPlan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteProductReplyValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteProductReplyValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteProductReplyValidationError{
+				field:  "Plan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeleteProductReplyValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeleteProductReplyValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeleteProductReplyValidationError{
+					field:  fmt.Sprintf("Failures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteProductReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProductReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteProductReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteProductReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProductReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProductReplyMultiError) AllErrors() []error { return m }
+
+// DeleteProductReplyValidationError is the validation error returned by
+// DeleteProductReply.Validate if the designated constraints aren't met.
+type DeleteProductReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProductReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProductReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProductReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProductReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProductReplyValidationError) ErrorName() string {
+	return "DeleteProductReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProductReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProductReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProductReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProductReplyValidationError{}
+
+// Validate checks the field values on GetDependenciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDependenciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDependenciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDependenciesRequestMultiError, or nil if none found.
+func (m *GetDependenciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDependenciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductName
+
+	// no validation rules for Kind
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetDependenciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDependenciesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDependenciesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDependenciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDependenciesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDependenciesRequestMultiError) AllErrors() []error { return m }
+
+// GetDependenciesRequestValidationError is the validation error returned by
+// GetDependenciesRequest.Validate if the designated constraints aren't met.
+type GetDependenciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDependenciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDependenciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDependenciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDependenciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDependenciesRequestValidationError) ErrorName() string {
+	return "GetDependenciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDependenciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDependenciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDependenciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDependenciesRequestValidationError{}
+
+// Validate checks the field values on Dependency with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Dependency) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Dependency with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DependencyMultiError, or
+// nil if none found.
+func (m *Dependency) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Dependency) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DependencyValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DependencyValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DependencyValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DependencyValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DependencyValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DependencyValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DependencyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DependencyMultiError is an error wrapping multiple validation errors
+// returned by Dependency.ValidateAll() if the designated constraints aren't met.
+type DependencyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DependencyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DependencyMultiError) AllErrors() []error { return m }
+
+// DependencyValidationError is the validation error returned by
+// Dependency.Validate if the designated constraints aren't met.
+type DependencyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DependencyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DependencyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DependencyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DependencyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DependencyValidationError) ErrorName() string { return "DependencyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DependencyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDependency.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DependencyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DependencyValidationError{}
+
+// Validate checks the field values on GetDependenciesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDependenciesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDependenciesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDependenciesReplyMultiError, or nil if none found.
+func (m *GetDependenciesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDependenciesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDependenciesReplyValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDependenciesReplyValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDependenciesReplyValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDependencies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDependenciesReplyValidationError{
+						field:  fmt.Sprintf("Dependencies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDependenciesReplyValidationError{
+						field:  fmt.Sprintf("Dependencies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDependenciesReplyValidationError{
+					field:  fmt.Sprintf("Dependencies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDependenciesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDependenciesReplyMultiError is an error wrapping multiple validation
+// errors returned by GetDependenciesReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetDependenciesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDependenciesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDependenciesReplyMultiError) AllErrors() []error { return m }
+
+// GetDependenciesReplyValidationError is the validation error returned by
+// GetDependenciesReply.Validate if the designated constraints aren't met.
+type GetDependenciesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDependenciesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDependenciesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDependenciesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDependenciesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDependenciesReplyValidationError) ErrorName() string {
+	return "GetDependenciesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDependenciesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDependenciesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDependenciesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDependenciesReplyValidationError{}
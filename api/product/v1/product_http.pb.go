@@ -20,12 +20,14 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationProductDeleteProduct = "/api.product.v1.Product/DeleteProduct"
+const OperationProductGetDependencies = "/api.product.v1.Product/GetDependencies"
 const OperationProductGetProduct = "/api.product.v1.Product/GetProduct"
 const OperationProductListProducts = "/api.product.v1.Product/ListProducts"
 const OperationProductSaveProduct = "/api.product.v1.Product/SaveProduct"
 
 type ProductHTTPServer interface {
 	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductReply, error)
+	GetDependencies(context.Context, *GetDependenciesRequest) (*GetDependenciesReply, error)
 	GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error)
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error)
 	SaveProduct(context.Context, *SaveProductRequest) (*SaveProductReply, error)
@@ -37,6 +39,7 @@ func RegisterProductHTTPServer(s *http.Server, srv ProductHTTPServer) {
 	r.GET("/api/v1/products", _Product_ListProducts0_HTTP_Handler(srv))
 	r.POST("/api/v1/products/{productName}", _Product_SaveProduct0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/products/{productName}", _Product_DeleteProduct0_HTTP_Handler(srv))
+	r.GET("/api/v1/products/{productName}/dependencies", _Product_GetDependencies0_HTTP_Handler(srv))
 }
 
 func _Product_GetProduct0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
@@ -124,8 +127,31 @@ func _Product_DeleteProduct0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.C
 	}
 }
 
+func _Product_GetDependencies0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetDependenciesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductGetDependencies)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDependencies(ctx, req.(*GetDependenciesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetDependenciesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ProductHTTPClient interface {
 	DeleteProduct(ctx context.Context, req *DeleteProductRequest, opts ...http.CallOption) (rsp *DeleteProductReply, err error)
+	GetDependencies(ctx context.Context, req *GetDependenciesRequest, opts ...http.CallOption) (rsp *GetDependenciesReply, err error)
 	GetProduct(ctx context.Context, req *GetProductRequest, opts ...http.CallOption) (rsp *GetProductReply, err error)
 	ListProducts(ctx context.Context, req *ListProductsRequest, opts ...http.CallOption) (rsp *ListProductsReply, err error)
 	SaveProduct(ctx context.Context, req *SaveProductRequest, opts ...http.CallOption) (rsp *SaveProductReply, err error)
@@ -152,6 +178,19 @@ func (c *ProductHTTPClientImpl) DeleteProduct(ctx context.Context, in *DeletePro
 	return &out, err
 }
 
+func (c *ProductHTTPClientImpl) GetDependencies(ctx context.Context, in *GetDependenciesRequest, opts ...http.CallOption) (*GetDependenciesReply, error) {
+	var out GetDependenciesReply
+	pattern := "/api/v1/products/{productName}/dependencies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProductGetDependencies))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ProductHTTPClientImpl) GetProduct(ctx context.Context, in *GetProductRequest, opts ...http.CallOption) (*GetProductReply, error) {
 	var out GetProductReply
 	pattern := "/api/v1/products/{productName}"
@@ -61,6 +61,8 @@ func (m *GetRequest) validate(all bool) error {
 
 	// no validation rules for ProjectName
 
+	// no validation rules for Revision
+
 	if len(errors) > 0 {
 		return GetRequestMultiError(errors)
 	}
@@ -166,6 +168,8 @@ func (m *GetReply) validate(all bool) error {
 
 	// no validation rules for Language
 
+	// no validation rules for ResourceVersion
+
 	if len(errors) > 0 {
 		return GetReplyMultiError(errors)
 	}
@@ -267,6 +271,8 @@ func (m *ListsRequest) validate(all bool) error {
 
 	// no validation rules for ProductName
 
+	// no validation rules for Revision
+
 	if len(errors) > 0 {
 		return ListsRequestMultiError(errors)
 	}
@@ -344,6 +350,111 @@ var _ interface {
 	ErrorName() string
 } = ListsRequestValidationError{}
 
+// Validate checks the field values on Violation with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Violation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Violation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ViolationMultiError, or nil
+// if none found.
+func (m *Violation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Violation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for Rule
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ViolationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ViolationMultiError is an error wrapping multiple validation errors returned
+// by Violation.ValidateAll() if the designated constraints aren't met.
+type ViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ViolationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ViolationMultiError) AllErrors() []error { return m }
+
+// ViolationValidationError is the validation error returned by
+// Violation.Validate if the designated constraints aren't met.
+type ViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ViolationValidationError) ErrorName() string { return "ViolationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sViolation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ViolationValidationError{}
+
 // Validate checks the field values on ListsReply with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -400,6 +511,40 @@ func (m *ListsReply) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListsReplyValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListsReplyValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListsReplyValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ListsReplyMultiError(errors)
 	}
@@ -534,6 +679,14 @@ func (m *SaveRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ResourceVersion
+
+	// no validation rules for DryRun
+
+	// no validation rules for Proposal
+
+	// no validation rules for CommitMessage
+
 	if len(errors) > 0 {
 		return SaveRequestMultiError(errors)
 	}
@@ -635,6 +788,24 @@ func (m *SaveReply) validate(all bool) error {
 
 	// no validation rules for Msg
 
+	// no validation rules for DryRun
+
+	// no validation rules for Yaml
+
+	// no validation rules for Diff
+
+	// no validation rules for ProposalId
+
+	// no validation rules for ProposalUrl
+
+	// no validation rules for CommitSha
+
+	// no validation rules for Branch
+
+	// no validation rules for CommitUrl
+
+	// no validation rules for AutoMerged
+
 	if len(errors) > 0 {
 		return SaveReplyMultiError(errors)
 	}
@@ -740,6 +911,14 @@ func (m *DeleteRequest) validate(all bool) error {
 
 	// no validation rules for InsecureSkipCheck
 
+	// no validation rules for ResourceVersion
+
+	// no validation rules for DryRun
+
+	// no validation rules for Proposal
+
+	// no validation rules for CommitMessage
+
 	if len(errors) > 0 {
 		return DeleteRequestMultiError(errors)
 	}
@@ -842,6 +1021,22 @@ func (m *DeleteReply) validate(all bool) error {
 
 	// no validation rules for Msg
 
+	// no validation rules for DryRun
+
+	// no validation rules for Diff
+
+	// no validation rules for ProposalId
+
+	// no validation rules for ProposalUrl
+
+	// no validation rules for CommitSha
+
+	// no validation rules for Branch
+
+	// no validation rules for CommitUrl
+
+	// no validation rules for AutoMerged
+
 	if len(errors) > 0 {
 		return DeleteReplyMultiError(errors)
 	}
@@ -919,6 +1114,467 @@ var _ interface {
 	ErrorName() string
 } = DeleteReplyValidationError{}
 
+// Validate checks the field values on HistoryRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistoryRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HistoryRequestMultiError,
+// or nil if none found.
+func (m *HistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductName
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return HistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistoryRequestMultiError is an error wrapping multiple validation errors
+// returned by HistoryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistoryRequestMultiError) AllErrors() []error { return m }
+
+// HistoryRequestValidationError is the validation error returned by
+// HistoryRequest.Validate if the designated constraints aren't met.
+type HistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistoryRequestValidationError) ErrorName() string { return "HistoryRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistoryRequestValidationError{}
+
+// Validate checks the field values on Commit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Commit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Commit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CommitMultiError, or nil if none found.
+func (m *Commit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Commit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sha
+
+	// no validation rules for Author
+
+	// no validation rules for AuthorEmail
+
+	// no validation rules for Date
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return CommitMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitMultiError is an error wrapping multiple validation errors returned by
+// Commit.ValidateAll() if the designated constraints aren't met.
+type CommitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitMultiError) AllErrors() []error { return m }
+
+// CommitValidationError is the validation error returned by Commit.Validate if
+// the designated constraints aren't met.
+type CommitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitValidationError) ErrorName() string { return "CommitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitValidationError{}
+
+// Validate checks the field values on HistoryReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistoryReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HistoryReplyMultiError, or
+// nil if none found.
+func (m *HistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HistoryReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HistoryReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HistoryReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return HistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistoryReplyMultiError is an error wrapping multiple validation errors
+// returned by HistoryReply.ValidateAll() if the designated constraints aren't met.
+type HistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistoryReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistoryReplyMultiError) AllErrors() []error { return m }
+
+// HistoryReplyValidationError is the validation error returned by
+// HistoryReply.Validate if the designated constraints aren't met.
+type HistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistoryReplyValidationError) ErrorName() string { return "HistoryReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistoryReplyValidationError{}
+
+// Validate checks the field values on RollbackRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RollbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackRequestMultiError, or nil if none found.
+func (m *RollbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductName
+
+	// no validation rules for ProjectName
+
+	// no validation rules for Revision
+
+	// no validation rules for InsecureSkipCheck
+
+	// no validation rules for ResourceVersion
+
+	// no validation rules for DryRun
+
+	// no validation rules for Proposal
+
+	// no validation rules for CommitMessage
+
+	if len(errors) > 0 {
+		return RollbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackRequestMultiError is an error wrapping multiple validation errors
+// returned by RollbackRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RollbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackRequestMultiError) AllErrors() []error { return m }
+
+// RollbackRequestValidationError is the validation error returned by
+// RollbackRequest.Validate if the designated constraints aren't met.
+type RollbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackRequestValidationError) ErrorName() string { return "RollbackRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RollbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackRequestValidationError{}
+
 // Validate checks the field values on SaveRequest_Body with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
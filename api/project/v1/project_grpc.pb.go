@@ -26,6 +26,8 @@ type ProjectClient interface {
 	ListProjects(ctx context.Context, in *ListsRequest, opts ...grpc.CallOption) (*ListsReply, error)
 	SaveProject(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveReply, error)
 	DeleteProject(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error)
+	GetProjectHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error)
+	RollbackProject(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*SaveReply, error)
 }
 
 type projectClient struct {
@@ -72,6 +74,24 @@ func (c *projectClient) DeleteProject(ctx context.Context, in *DeleteRequest, op
 	return out, nil
 }
 
+func (c *projectClient) GetProjectHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error) {
+	out := new(HistoryReply)
+	err := c.cc.Invoke(ctx, "/api.project.v1.Project/GetProjectHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectClient) RollbackProject(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*SaveReply, error) {
+	out := new(SaveReply)
+	err := c.cc.Invoke(ctx, "/api.project.v1.Project/RollbackProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServer is the server API for Project service.
 // All implementations must embed UnimplementedProjectServer
 // for forward compatibility
@@ -80,6 +100,8 @@ type ProjectServer interface {
 	ListProjects(context.Context, *ListsRequest) (*ListsReply, error)
 	SaveProject(context.Context, *SaveRequest) (*SaveReply, error)
 	DeleteProject(context.Context, *DeleteRequest) (*DeleteReply, error)
+	GetProjectHistory(context.Context, *HistoryRequest) (*HistoryReply, error)
+	RollbackProject(context.Context, *RollbackRequest) (*SaveReply, error)
 	mustEmbedUnimplementedProjectServer()
 }
 
@@ -99,6 +121,12 @@ func (UnimplementedProjectServer) SaveProject(context.Context, *SaveRequest) (*S
 func (UnimplementedProjectServer) DeleteProject(context.Context, *DeleteRequest) (*DeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
+func (UnimplementedProjectServer) GetProjectHistory(context.Context, *HistoryRequest) (*HistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectHistory not implemented")
+}
+func (UnimplementedProjectServer) RollbackProject(context.Context, *RollbackRequest) (*SaveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackProject not implemented")
+}
 func (UnimplementedProjectServer) mustEmbedUnimplementedProjectServer() {}
 
 // UnsafeProjectServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +212,42 @@ func _Project_DeleteProject_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Project_GetProjectHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServer).GetProjectHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.project.v1.Project/GetProjectHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServer).GetProjectHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Project_RollbackProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServer).RollbackProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.project.v1.Project/RollbackProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServer).RollbackProject(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Project_ServiceDesc is the grpc.ServiceDesc for Project service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +271,14 @@ var Project_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _Project_DeleteProject_Handler,
 		},
+		{
+			MethodName: "GetProjectHistory",
+			Handler:    _Project_GetProjectHistory_Handler,
+		},
+		{
+			MethodName: "RollbackProject",
+			Handler:    _Project_RollbackProject_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "project/v1/project.proto",
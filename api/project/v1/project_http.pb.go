@@ -21,13 +21,17 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationProjectDeleteProject = "/api.project.v1.Project/DeleteProject"
 const OperationProjectGetProject = "/api.project.v1.Project/GetProject"
+const OperationProjectGetProjectHistory = "/api.project.v1.Project/GetProjectHistory"
 const OperationProjectListProjects = "/api.project.v1.Project/ListProjects"
+const OperationProjectRollbackProject = "/api.project.v1.Project/RollbackProject"
 const OperationProjectSaveProject = "/api.project.v1.Project/SaveProject"
 
 type ProjectHTTPServer interface {
 	DeleteProject(context.Context, *DeleteRequest) (*DeleteReply, error)
 	GetProject(context.Context, *GetRequest) (*GetReply, error)
+	GetProjectHistory(context.Context, *HistoryRequest) (*HistoryReply, error)
 	ListProjects(context.Context, *ListsRequest) (*ListsReply, error)
+	RollbackProject(context.Context, *RollbackRequest) (*SaveReply, error)
 	SaveProject(context.Context, *SaveRequest) (*SaveReply, error)
 }
 
@@ -37,6 +41,8 @@ func RegisterProjectHTTPServer(s *http.Server, srv ProjectHTTPServer) {
 	r.GET("/api/v1/products/{productName}/projects", _Project_ListProjects0_HTTP_Handler(srv))
 	r.POST("/api/v1/products/{productName}/projects/{projectName}", _Project_SaveProject0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/products/{productName}/projects/{projectName}", _Project_DeleteProject0_HTTP_Handler(srv))
+	r.GET("/api/v1/products/{productName}/projects/{projectName}/history", _Project_GetProjectHistory0_HTTP_Handler(srv))
+	r.POST("/api/v1/products/{productName}/projects/{projectName}/history/{revision}:rollback", _Project_RollbackProject0_HTTP_Handler(srv))
 }
 
 func _Project_GetProject0_HTTP_Handler(srv ProjectHTTPServer) func(ctx http.Context) error {
@@ -130,10 +136,56 @@ func _Project_DeleteProject0_HTTP_Handler(srv ProjectHTTPServer) func(ctx http.C
 	}
 }
 
+func _Project_GetProjectHistory0_HTTP_Handler(srv ProjectHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProjectGetProjectHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetProjectHistory(ctx, req.(*HistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Project_RollbackProject0_HTTP_Handler(srv ProjectHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProjectRollbackProject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackProject(ctx, req.(*RollbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SaveReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ProjectHTTPClient interface {
 	DeleteProject(ctx context.Context, req *DeleteRequest, opts ...http.CallOption) (rsp *DeleteReply, err error)
 	GetProject(ctx context.Context, req *GetRequest, opts ...http.CallOption) (rsp *GetReply, err error)
+	GetProjectHistory(ctx context.Context, req *HistoryRequest, opts ...http.CallOption) (rsp *HistoryReply, err error)
 	ListProjects(ctx context.Context, req *ListsRequest, opts ...http.CallOption) (rsp *ListsReply, err error)
+	RollbackProject(ctx context.Context, req *RollbackRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
 	SaveProject(ctx context.Context, req *SaveRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
 }
 
@@ -171,6 +223,19 @@ func (c *ProjectHTTPClientImpl) GetProject(ctx context.Context, in *GetRequest,
 	return &out, err
 }
 
+func (c *ProjectHTTPClientImpl) GetProjectHistory(ctx context.Context, in *HistoryRequest, opts ...http.CallOption) (*HistoryReply, error) {
+	var out HistoryReply
+	pattern := "/api/v1/products/{productName}/projects/{projectName}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProjectGetProjectHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ProjectHTTPClientImpl) ListProjects(ctx context.Context, in *ListsRequest, opts ...http.CallOption) (*ListsReply, error) {
 	var out ListsReply
 	pattern := "/api/v1/products/{productName}/projects"
@@ -184,6 +249,19 @@ func (c *ProjectHTTPClientImpl) ListProjects(ctx context.Context, in *ListsReque
 	return &out, err
 }
 
+func (c *ProjectHTTPClientImpl) RollbackProject(ctx context.Context, in *RollbackRequest, opts ...http.CallOption) (*SaveReply, error) {
+	var out SaveReply
+	pattern := "/api/v1/products/{productName}/projects/{projectName}/history/{revision}:rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProjectRollbackProject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ProjectHTTPClientImpl) SaveProject(ctx context.Context, in *SaveRequest, opts ...http.CallOption) (*SaveReply, error) {
 	var out SaveReply
 	pattern := "/api/v1/products/{productName}/projects/{projectName}"
@@ -26,6 +26,8 @@ type ProjectPipelineRuntimeClient interface {
 	ListProjectPipelineRuntimes(ctx context.Context, in *ListsRequest, opts ...grpc.CallOption) (*ListsReply, error)
 	SaveProjectPipelineRuntime(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveReply, error)
 	DeleteProjectPipelineRuntime(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error)
+	GetProjectPipelineRuntimeHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error)
+	RollbackProjectPipelineRuntime(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*SaveReply, error)
 }
 
 type projectPipelineRuntimeClient struct {
@@ -72,6 +74,24 @@ func (c *projectPipelineRuntimeClient) DeleteProjectPipelineRuntime(ctx context.
 	return out, nil
 }
 
+func (c *projectPipelineRuntimeClient) GetProjectPipelineRuntimeHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error) {
+	out := new(HistoryReply)
+	err := c.cc.Invoke(ctx, "/api.projectpipelineruntime.v1.ProjectPipelineRuntime/GetProjectPipelineRuntimeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectPipelineRuntimeClient) RollbackProjectPipelineRuntime(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*SaveReply, error) {
+	out := new(SaveReply)
+	err := c.cc.Invoke(ctx, "/api.projectpipelineruntime.v1.ProjectPipelineRuntime/RollbackProjectPipelineRuntime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectPipelineRuntimeServer is the server API for ProjectPipelineRuntime service.
 // All implementations must embed UnimplementedProjectPipelineRuntimeServer
 // for forward compatibility
@@ -80,6 +100,8 @@ type ProjectPipelineRuntimeServer interface {
 	ListProjectPipelineRuntimes(context.Context, *ListsRequest) (*ListsReply, error)
 	SaveProjectPipelineRuntime(context.Context, *SaveRequest) (*SaveReply, error)
 	DeleteProjectPipelineRuntime(context.Context, *DeleteRequest) (*DeleteReply, error)
+	GetProjectPipelineRuntimeHistory(context.Context, *HistoryRequest) (*HistoryReply, error)
+	RollbackProjectPipelineRuntime(context.Context, *RollbackRequest) (*SaveReply, error)
 	mustEmbedUnimplementedProjectPipelineRuntimeServer()
 }
 
@@ -99,6 +121,12 @@ func (UnimplementedProjectPipelineRuntimeServer) SaveProjectPipelineRuntime(cont
 func (UnimplementedProjectPipelineRuntimeServer) DeleteProjectPipelineRuntime(context.Context, *DeleteRequest) (*DeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectPipelineRuntime not implemented")
 }
+func (UnimplementedProjectPipelineRuntimeServer) GetProjectPipelineRuntimeHistory(context.Context, *HistoryRequest) (*HistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectPipelineRuntimeHistory not implemented")
+}
+func (UnimplementedProjectPipelineRuntimeServer) RollbackProjectPipelineRuntime(context.Context, *RollbackRequest) (*SaveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackProjectPipelineRuntime not implemented")
+}
 func (UnimplementedProjectPipelineRuntimeServer) mustEmbedUnimplementedProjectPipelineRuntimeServer() {
 }
 
@@ -185,6 +213,42 @@ func _ProjectPipelineRuntime_DeleteProjectPipelineRuntime_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectPipelineRuntime_GetProjectPipelineRuntimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectPipelineRuntimeServer).GetProjectPipelineRuntimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.projectpipelineruntime.v1.ProjectPipelineRuntime/GetProjectPipelineRuntimeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectPipelineRuntimeServer).GetProjectPipelineRuntimeHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectPipelineRuntime_RollbackProjectPipelineRuntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectPipelineRuntimeServer).RollbackProjectPipelineRuntime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.projectpipelineruntime.v1.ProjectPipelineRuntime/RollbackProjectPipelineRuntime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectPipelineRuntimeServer).RollbackProjectPipelineRuntime(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectPipelineRuntime_ServiceDesc is the grpc.ServiceDesc for ProjectPipelineRuntime service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -208,6 +272,14 @@ var ProjectPipelineRuntime_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProjectPipelineRuntime",
 			Handler:    _ProjectPipelineRuntime_DeleteProjectPipelineRuntime_Handler,
 		},
+		{
+			MethodName: "GetProjectPipelineRuntimeHistory",
+			Handler:    _ProjectPipelineRuntime_GetProjectPipelineRuntimeHistory_Handler,
+		},
+		{
+			MethodName: "RollbackProjectPipelineRuntime",
+			Handler:    _ProjectPipelineRuntime_RollbackProjectPipelineRuntime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "projectpipelineruntime/v1/projectpipelineruntime.proto",
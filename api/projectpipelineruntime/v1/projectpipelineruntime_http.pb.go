@@ -21,13 +21,17 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationProjectPipelineRuntimeDeleteProjectPipelineRuntime = "/api.projectpipelineruntime.v1.ProjectPipelineRuntime/DeleteProjectPipelineRuntime"
 const OperationProjectPipelineRuntimeGetProjectPipelineRuntime = "/api.projectpipelineruntime.v1.ProjectPipelineRuntime/GetProjectPipelineRuntime"
+const OperationProjectPipelineRuntimeGetProjectPipelineRuntimeHistory = "/api.projectpipelineruntime.v1.ProjectPipelineRuntime/GetProjectPipelineRuntimeHistory"
 const OperationProjectPipelineRuntimeListProjectPipelineRuntimes = "/api.projectpipelineruntime.v1.ProjectPipelineRuntime/ListProjectPipelineRuntimes"
+const OperationProjectPipelineRuntimeRollbackProjectPipelineRuntime = "/api.projectpipelineruntime.v1.ProjectPipelineRuntime/RollbackProjectPipelineRuntime"
 const OperationProjectPipelineRuntimeSaveProjectPipelineRuntime = "/api.projectpipelineruntime.v1.ProjectPipelineRuntime/SaveProjectPipelineRuntime"
 
 type ProjectPipelineRuntimeHTTPServer interface {
 	DeleteProjectPipelineRuntime(context.Context, *DeleteRequest) (*DeleteReply, error)
 	GetProjectPipelineRuntime(context.Context, *GetRequest) (*GetReply, error)
+	GetProjectPipelineRuntimeHistory(context.Context, *HistoryRequest) (*HistoryReply, error)
 	ListProjectPipelineRuntimes(context.Context, *ListsRequest) (*ListsReply, error)
+	RollbackProjectPipelineRuntime(context.Context, *RollbackRequest) (*SaveReply, error)
 	SaveProjectPipelineRuntime(context.Context, *SaveRequest) (*SaveReply, error)
 }
 
@@ -37,6 +41,8 @@ func RegisterProjectPipelineRuntimeHTTPServer(s *http.Server, srv ProjectPipelin
 	r.GET("/api/v1/products/{productName}/projectpipelineruntimes", _ProjectPipelineRuntime_ListProjectPipelineRuntimes0_HTTP_Handler(srv))
 	r.POST("/api/v1/products/{productName}/projectpipelineruntimes/{projectPipelineRuntimeName}", _ProjectPipelineRuntime_SaveProjectPipelineRuntime0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/products/{productName}/projectpipelineruntimes/{projectPipelineRuntimeName}", _ProjectPipelineRuntime_DeleteProjectPipelineRuntime0_HTTP_Handler(srv))
+	r.GET("/api/v1/products/{productName}/projectpipelineruntimes/{projectPipelineRuntimeName}/history", _ProjectPipelineRuntime_GetProjectPipelineRuntimeHistory0_HTTP_Handler(srv))
+	r.POST("/api/v1/products/{productName}/projectpipelineruntimes/{projectPipelineRuntimeName}/history/{revision}:rollback", _ProjectPipelineRuntime_RollbackProjectPipelineRuntime0_HTTP_Handler(srv))
 }
 
 func _ProjectPipelineRuntime_GetProjectPipelineRuntime0_HTTP_Handler(srv ProjectPipelineRuntimeHTTPServer) func(ctx http.Context) error {
@@ -130,10 +136,56 @@ func _ProjectPipelineRuntime_DeleteProjectPipelineRuntime0_HTTP_Handler(srv Proj
 	}
 }
 
+func _ProjectPipelineRuntime_GetProjectPipelineRuntimeHistory0_HTTP_Handler(srv ProjectPipelineRuntimeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProjectPipelineRuntimeGetProjectPipelineRuntimeHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetProjectPipelineRuntimeHistory(ctx, req.(*HistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProjectPipelineRuntime_RollbackProjectPipelineRuntime0_HTTP_Handler(srv ProjectPipelineRuntimeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProjectPipelineRuntimeRollbackProjectPipelineRuntime)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackProjectPipelineRuntime(ctx, req.(*RollbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SaveReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ProjectPipelineRuntimeHTTPClient interface {
 	DeleteProjectPipelineRuntime(ctx context.Context, req *DeleteRequest, opts ...http.CallOption) (rsp *DeleteReply, err error)
 	GetProjectPipelineRuntime(ctx context.Context, req *GetRequest, opts ...http.CallOption) (rsp *GetReply, err error)
+	GetProjectPipelineRuntimeHistory(ctx context.Context, req *HistoryRequest, opts ...http.CallOption) (rsp *HistoryReply, err error)
 	ListProjectPipelineRuntimes(ctx context.Context, req *ListsRequest, opts ...http.CallOption) (rsp *ListsReply, err error)
+	RollbackProjectPipelineRuntime(ctx context.Context, req *RollbackRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
 	SaveProjectPipelineRuntime(ctx context.Context, req *SaveRequest, opts ...http.CallOption) (rsp *SaveReply, err error)
 }
 
@@ -171,6 +223,19 @@ func (c *ProjectPipelineRuntimeHTTPClientImpl) GetProjectPipelineRuntime(ctx con
 	return &out, err
 }
 
+func (c *ProjectPipelineRuntimeHTTPClientImpl) GetProjectPipelineRuntimeHistory(ctx context.Context, in *HistoryRequest, opts ...http.CallOption) (*HistoryReply, error) {
+	var out HistoryReply
+	pattern := "/api/v1/products/{productName}/projectpipelineruntimes/{projectPipelineRuntimeName}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProjectPipelineRuntimeGetProjectPipelineRuntimeHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ProjectPipelineRuntimeHTTPClientImpl) ListProjectPipelineRuntimes(ctx context.Context, in *ListsRequest, opts ...http.CallOption) (*ListsReply, error) {
 	var out ListsReply
 	pattern := "/api/v1/products/{productName}/projectpipelineruntimes"
@@ -184,6 +249,19 @@ func (c *ProjectPipelineRuntimeHTTPClientImpl) ListProjectPipelineRuntimes(ctx c
 	return &out, err
 }
 
+func (c *ProjectPipelineRuntimeHTTPClientImpl) RollbackProjectPipelineRuntime(ctx context.Context, in *RollbackRequest, opts ...http.CallOption) (*SaveReply, error) {
+	var out SaveReply
+	pattern := "/api/v1/products/{productName}/projectpipelineruntimes/{projectPipelineRuntimeName}/history/{revision}:rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProjectPipelineRuntimeRollbackProjectPipelineRuntime))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ProjectPipelineRuntimeHTTPClientImpl) SaveProjectPipelineRuntime(ctx context.Context, in *SaveRequest, opts ...http.CallOption) (*SaveReply, error) {
 	var out SaveReply
 	pattern := "/api/v1/products/{productName}/projectpipelineruntimes/{projectPipelineRuntimeName}"
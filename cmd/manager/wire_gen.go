@@ -32,30 +32,41 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, no
 	if err != nil {
 		return nil, nil, err
 	}
-	gitRepo, err := data.NewGitRepo(config)
+	gitRepo, err := data.NewGitRepo(config, confData, client2)
 	if err != nil {
 		return nil, nil, err
 	}
-	resourcesUsecase := biz.NewResourcesUsecase(logger, codeRepo, secretrepo, gitRepo, nodesTree, config)
+	proposalPolicy := service.NewProposalPolicy(confData)
+	resourcesUsecase := biz.NewResourcesUsecase(logger, codeRepo, secretrepo, gitRepo, nodesTree, config, proposalPolicy)
 	codeRepoUsecase := biz.NewCodeRepoUsecase(logger, codeRepo, secretrepo, nodesTree, config, resourcesUsecase, client2)
-	productUsecase := biz.NewProductUsecase(logger, codeRepo, secretrepo, gitRepo, config, resourcesUsecase, codeRepoUsecase)
-	productService := service.NewProductService(productUsecase, config)
-	grpcServer := server.NewGRPCServer(confServer, productService, logger)
 	projectPipelineRuntimeUsecase := biz.NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodesTree, resourcesUsecase)
 	projectPipelineRuntimeService := service.NewProjectPipelineRuntimeService(projectPipelineRuntimeUsecase)
 	deploymentRuntimeUsecase := biz.NewDeploymentRuntimeUsecase(logger, codeRepo, nodesTree, resourcesUsecase)
-	deploymentruntimeService := service.NewDeploymentruntimeService(deploymentRuntimeUsecase)
-	codeRepoService := service.NewCodeRepoService(codeRepoUsecase, config)
+	statusUsecase := biz.NewStatusUsecase(logger, codeRepo, client2)
+	deploymentruntimeService := service.NewDeploymentruntimeService(deploymentRuntimeUsecase, statusUsecase)
+	codeRepoService := service.NewCodeRepoService(codeRepoUsecase, statusUsecase, config)
 	projectUsecase := biz.NewProjectUsecase(logger, codeRepo, secretrepo, nodesTree, config, resourcesUsecase)
 	projectService := service.NewProjectService(projectUsecase)
 	environmentUsecase := biz.NewEnviromentUsecase(logger, config, codeRepo, nodesTree, resourcesUsecase)
-	environmentService := service.NewEnvironmentService(environmentUsecase)
+	environmentService := service.NewEnvironmentService(environmentUsecase, statusUsecase)
 	dexRepo := data.NewDexRepo(client2)
 	clusterUsecase := biz.NewClusterUsecase(logger, codeRepo, secretrepo, resourcesUsecase, config, client2, clusteroperator, dexRepo)
-	clusterService := service.NewClusterService(clusterUsecase, config)
-	serviceProductGroup := server.NewServiceGroup(projectPipelineRuntimeService, deploymentruntimeService, codeRepoService, productService, projectService, environmentService, clusterService)
+	clusterService := service.NewClusterService(clusterUsecase, config, confData)
+	artifactRepoUsecase := biz.NewArtifactRepoUsecase(logger, codeRepo, nodesTree, config, resourcesUsecase)
+	artifactRepoService := service.NewArtifactRepoService(artifactRepoUsecase)
+	proposalUsecase := biz.NewProposalUsecase(logger, codeRepo, resourcesUsecase)
+	proposalService := service.NewProposalService(proposalUsecase)
+	applyUsecase := biz.NewApplyUsecase(logger, codeRepo, resourcesUsecase, projectUsecase, codeRepoUsecase, environmentUsecase, deploymentRuntimeUsecase, projectPipelineRuntimeUsecase, artifactRepoUsecase)
+	applyService := service.NewApplyService(applyUsecase, config)
+	productUsecase := biz.NewProductUsecase(logger, codeRepo, secretrepo, gitRepo, config, resourcesUsecase, codeRepoUsecase, applyUsecase)
+	productService := service.NewProductService(productUsecase, config, confData)
+	watchUsecase, cleanup := biz.NewWatchUsecase(logger, gitRepo, nodesTree, resourcesUsecase, config)
+	watchService := service.NewWatchService(watchUsecase, confData)
+	serviceProductGroup := server.NewServiceGroup(projectPipelineRuntimeService, deploymentruntimeService, codeRepoService, productService, projectService, environmentService, clusterService, artifactRepoService, proposalService, applyService, watchService)
+	grpcServer := server.NewGRPCServer(confServer, serviceProductGroup, logger)
 	httpServer := server.NewHTTPServer(confServer, serviceProductGroup)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup()
 	}, nil
 }
@@ -10,6 +10,7 @@ require (
 	github.com/go-kratos/kratos/v2 v2.5.4
 	github.com/go-kratos/swagger-api v1.0.1
 	github.com/golang/mock v1.6.0
+	github.com/google/go-github/v41 v41.0.0
 	github.com/google/wire v0.5.0
 	github.com/xanzy/go-gitlab v0.68.0
 	google.golang.org/genproto v0.0.0-20221227171554-f9683d7f8bef
@@ -31,6 +32,7 @@ require (
 	github.com/nautes-labs/vault-proxy v0.2.0
 	github.com/onsi/ginkgo/v2 v2.4.0
 	github.com/onsi/gomega v1.22.1
+	github.com/prometheus/client_golang v1.11.1
 	k8s.io/api v0.24.8
 	k8s.io/apimachinery v0.24.8
 	k8s.io/client-go v0.23.3
@@ -97,7 +99,6 @@ require (
 	github.com/google/btree v1.0.1 // indirect
 	github.com/google/go-cmp v0.5.9 // indirect
 	github.com/google/go-containerregistry v0.7.0 // indirect
-	github.com/google/go-github/v41 v41.0.0 // indirect
 	github.com/google/go-querystring v1.1.0 // indirect
 	github.com/google/gofuzz v1.2.0 // indirect
 	github.com/google/shlex v0.0.0-20191202100458-e7afc7fbc510 // indirect
@@ -215,7 +216,6 @@ require (
 	github.com/golang/protobuf v1.5.3 // indirect
 	github.com/hashicorp/go-hclog v1.2.0 // indirect
 	github.com/kr/pretty v0.3.0 // indirect
-	github.com/prometheus/client_golang v1.11.1 // indirect
 	go.uber.org/multierr v1.8.0 // indirect
 	go.uber.org/zap v1.21.0 // indirect
 	k8s.io/kops v1.22.6
@@ -0,0 +1,235 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+)
+
+// ApplyResource is a resource of any kind to apply.
+type ApplyResource struct {
+	Kind string
+	// Name is the name of the resource in the request, for code repos it is the name of the repository.
+	Name string
+	// Data is the resource data of the kind, e.g. *EnviromentData for environments.
+	Data interface{}
+	// GitOptions is the repository of code repos, it is ignored by other kinds.
+	GitOptions *GitCodeRepoOptions
+
+	project           *Project
+	repositoryCreated bool
+}
+
+// resourceApplier converts the data of a resource to apply before it is inserted into the nodes tree.
+type resourceApplier interface {
+	nodestree.NodesOperator
+	prepareApply(ctx context.Context, group *Group, options *BizOptions, resource *ApplyResource) (string, error)
+}
+
+// ApplyUsecase applies resources of mixed kinds to a product in a single commit.
+type ApplyUsecase struct {
+	log                           *log.Helper
+	codeRepo                      CodeRepo
+	resourcesUsecase              *ResourcesUsecase
+	projectUsecase                *ProjectUsecase
+	codeRepoUsecase               *CodeRepoUsecase
+	environmentUsecase            *EnvironmentUsecase
+	deploymentRuntimeUsecase      *DeploymentRuntimeUsecase
+	projectPipelineRuntimeUsecase *ProjectPipelineRuntimeUsecase
+	artifactRepoUsecase           *ArtifactRepoUsecase
+	appliers                      map[string]resourceApplier
+}
+
+func NewApplyUsecase(logger log.Logger, codeRepo CodeRepo, resourcesUsecase *ResourcesUsecase, projectUsecase *ProjectUsecase, codeRepoUsecase *CodeRepoUsecase, environmentUsecase *EnvironmentUsecase, deploymentRuntimeUsecase *DeploymentRuntimeUsecase, projectPipelineRuntimeUsecase *ProjectPipelineRuntimeUsecase, artifactRepoUsecase *ArtifactRepoUsecase) *ApplyUsecase {
+	return &ApplyUsecase{
+		log:                           log.NewHelper(log.With(logger)),
+		codeRepo:                      codeRepo,
+		resourcesUsecase:              resourcesUsecase,
+		projectUsecase:                projectUsecase,
+		codeRepoUsecase:               codeRepoUsecase,
+		environmentUsecase:            environmentUsecase,
+		deploymentRuntimeUsecase:      deploymentRuntimeUsecase,
+		projectPipelineRuntimeUsecase: projectPipelineRuntimeUsecase,
+		artifactRepoUsecase:           artifactRepoUsecase,
+		appliers: map[string]resourceApplier{
+			nodestree.Project:                projectUsecase,
+			nodestree.CodeRepo:               codeRepoUsecase,
+			nodestree.Enviroment:             environmentUsecase,
+			nodestree.DeploymentRuntime:      deploymentRuntimeUsecase,
+			nodestree.ProjectPipelineRuntime: projectPipelineRuntimeUsecase,
+			nodestree.ArtifactRepo:           artifactRepoUsecase,
+		},
+	}
+}
+
+// Apply creates or updates all the resources in one commit, nothing is committed if any of them is invalid.
+// Code repos are applied first so that the other resources can refer to them,
+// the repositories created for them are deleted again when the apply fails.
+// In proposal mode the repositories are created before the proposal is merged,
+// they are kept when the proposal is closed without merging and have to be deleted by hand.
+// The deploy keys of the repositories are saved after the push, a failure is returned as a warning of the result.
+func (a *ApplyUsecase) Apply(ctx context.Context, options *BizOptions, resources []*ApplyResource) (*ChangeResult, error) {
+	err := checkApplyResources(resources, a.appliers)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].Kind == nodestree.CodeRepo && resources[j].Kind != nodestree.CodeRepo
+	})
+
+	group, err := a.codeRepo.GetGroup(ctx, options.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.apply(ctx, group, options, resources)
+	if err != nil {
+		a.deleteCreatedRepositories(ctx, resources)
+		return nil, err
+	}
+
+	if options.DryRun {
+		return result, nil
+	}
+
+	for _, resource := range resources {
+		if resource.project == nil {
+			continue
+		}
+
+		pid := fmt.Sprintf("%s/%s", group.Path, resource.Name)
+		err = a.codeRepoUsecase.SaveDeployKey(ctx, pid, resource.project)
+		if err != nil {
+			a.log.Errorf("failed to save the deploy key of code repo %s, err: %v", resource.Name, err)
+			result.Warnings = append(result.Warnings, fmt.Sprintf("failed to save the deploy key of code repo %s, save the code repo again to retry, err: %v", resource.Name, err))
+		}
+	}
+
+	return result, nil
+}
+
+func (a *ApplyUsecase) apply(ctx context.Context, group *Group, options *BizOptions, resources []*ApplyResource) (*ChangeResult, error) {
+	nodes := make([]*applyNode, 0, len(resources))
+	for _, resource := range resources {
+		applier := a.appliers[resource.Kind]
+		name, err := applier.prepareApply(ctx, group, options, resource)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, &applyNode{
+			kind:     resource.Kind,
+			name:     name,
+			data:     resource.Data,
+			operator: applier,
+		})
+	}
+
+	return a.resourcesUsecase.Apply(ctx, options, nodes)
+}
+
+func (a *ApplyUsecase) deleteCreatedRepositories(ctx context.Context, resources []*ApplyResource) {
+	for _, resource := range resources {
+		if !resource.repositoryCreated || resource.project == nil {
+			continue
+		}
+
+		err := a.codeRepo.DeleteCodeRepo(ctx, int(resource.project.Id))
+		if err != nil {
+			a.log.Errorf("failed to delete repository %s created by the failed apply, err: %v", resource.Name, err)
+		}
+	}
+}
+
+// ProductResources are the resources of a product, the product and code repos they refer to are named instead of identified by ID.
+type ProductResources struct {
+	Projects                []*resourcev1alpha1.Project
+	CodeRepos               []*CodeRepoAndProject
+	ArtifactRepos           []*resourcev1alpha1.ArtifactRepo
+	Environments            []*resourcev1alpha1.Environment
+	DeploymentRuntimes      []*resourcev1alpha1.DeploymentRuntime
+	ProjectPipelineRuntimes []*resourcev1alpha1.ProjectPipelineRuntime
+}
+
+// ExportProduct lists all the resources of the product, in the order they can be applied again.
+// Every kind is read from the same checkout of the product, so the resources are consistent with each other.
+func (a *ApplyUsecase) ExportProduct(ctx context.Context, productName string) (*ProductResources, error) {
+	nodes, err := a.resourcesUsecase.List(ctx, productName, a.projectUsecase)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := &ProductResources{}
+
+	resources.Projects, err = a.projectUsecase.projectsFromNodes(ctx, *nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	resources.CodeRepos, err = a.codeRepoUsecase.codeReposFromNodes(ctx, productName, *nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	resources.ArtifactRepos, err = a.artifactRepoUsecase.artifactReposFromNodes(ctx, *nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	resources.Environments, err = a.environmentUsecase.environmentsFromNodes(ctx, *nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	resources.DeploymentRuntimes, err = a.deploymentRuntimeUsecase.deploymentRuntimesFromNodes(ctx, *nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	resources.ProjectPipelineRuntimes, err = a.projectPipelineRuntimeUsecase.projectPipelineRuntimesFromNodes(ctx, *nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return resources, nil
+}
+
+func checkApplyResources(resources []*ApplyResource, appliers map[string]resourceApplier) error {
+	if len(resources) == 0 {
+		return ErrorInvalidApply.WithCause(fmt.Errorf("no resources to apply"))
+	}
+
+	applied := make(map[string]bool, len(resources))
+	for _, resource := range resources {
+		if _, ok := appliers[resource.Kind]; !ok {
+			return ErrorInvalidApply.WithCause(fmt.Errorf("the kind %s of resource %s is not supported", resource.Kind, resource.Name))
+		}
+
+		key := fmt.Sprintf("%s/%s", resource.Kind, resource.Name)
+		if applied[key] {
+			return ErrorInvalidApply.WithCause(fmt.Errorf("the resource %s %s is applied more than once", resource.Kind, resource.Name))
+		}
+		applied[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,300 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nautes-labs/api-server/pkg/kubernetes"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Apply resources", func() {
+	var (
+		codeRepo     *MockCodeRepo
+		secretRepo   *MockSecretrepo
+		k8sClient    *kubernetes.MockClient
+		gitRepo      *MockGitRepo
+		nodesTree    *nodestree.MockNodesTree
+		envName      = "env1"
+		envNode      = createEnvironmentNode(createEnvironmentResource(envName))
+		nodes        = createContainEnvironmentNodes(envNode)
+		artifactRepo = "artifact-repo1"
+	)
+
+	newResources := func() []*ApplyResource {
+		return []*ApplyResource{
+			{
+				Kind: nodestree.Enviroment,
+				Name: envName,
+				Data: &EnviromentData{
+					Name: envName,
+					Spec: resourcev1alpha1.EnvironmentSpec{
+						Cluster: "test-cluster",
+						EnvType: envType,
+					},
+				},
+			},
+			{
+				Kind: nodestree.ArtifactRepo,
+				Name: artifactRepo,
+				Data: &ArtifactRepoData{
+					Name: artifactRepo,
+					Spec: resourcev1alpha1.ArtifactRepoSpec{
+						ArtifactRepoProvider: "harbor",
+						RepoType:             "package",
+						PackageType:          "maven",
+					},
+				},
+			},
+		}
+	}
+
+	newApplyUsecase := func() *ApplyUsecase {
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodesTree, nautesConfigs, nil)
+		return NewApplyUsecase(logger, codeRepo, resourcesUsecase,
+			NewProjectUsecase(logger, codeRepo, nil, nodesTree, nautesConfigs, resourcesUsecase),
+			NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodesTree, nautesConfigs, resourcesUsecase, k8sClient),
+			NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodesTree, resourcesUsecase),
+			NewDeploymentRuntimeUsecase(logger, codeRepo, nodesTree, resourcesUsecase),
+			NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodesTree, resourcesUsecase),
+			NewArtifactRepoUsecase(logger, codeRepo, nodesTree, nautesConfigs, resourcesUsecase),
+		)
+	}
+
+	expectLoadNodes := func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil)
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil)
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(nodes, nil)
+		nodesTree.EXPECT().GetNode(gomock.Any(), nodestree.Enviroment, envName).Return(envNode)
+		nodesTree.EXPECT().GetNode(gomock.Any(), nodestree.ArtifactRepo, artifactRepo).Return(nil)
+		nodesTree.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&nodes, nil).Times(2)
+	}
+
+	BeforeEach(func() {
+		codeRepo = NewMockCodeRepo(ctl)
+		secretRepo = NewMockSecretrepo(ctl)
+		k8sClient = kubernetes.NewMockClient(ctl)
+		gitRepo = NewMockGitRepo(ctl)
+		nodesTree = nodestree.NewMockNodesTree(ctl)
+		nodesTree.EXPECT().AppendOperators(gomock.Any()).AnyTimes()
+	})
+
+	It("checks the resources once and pushes them in one commit", func() {
+		expectLoadNodes()
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(nil).Times(1)
+
+		var message string
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath), gomock.Any()).DoAndReturn(func(ctx context.Context, path, msg string) error {
+			message = msg
+			return nil
+		}).Times(1)
+		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
+		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil)
+
+		options := &BizOptions{ProductName: defaultGroupName, CommitMessage: "bootstrap"}
+		result, err := newApplyUsecase().Apply(context.Background(), options, newResources())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Commit).Should(Equal(headCommit))
+		Expect(result.CommitURL).Should(Equal(defautlProject.WebUrl + "/-/commit/" + headCommit))
+		Expect(message).Should(HavePrefix(fmt.Sprintf("api: apply 2 resources of product %s\n\n", defaultGroupName)))
+		Expect(message).Should(ContainSubstring(fmt.Sprintf(" Environment %s\n", envName)))
+		Expect(message).Should(ContainSubstring(fmt.Sprintf("- create ArtifactRepo %s", artifactRepo)))
+		Expect(message).Should(HaveSuffix("\n\nbootstrap"))
+	})
+
+	It("renders all the resources in dry run mode", func() {
+		expectLoadNodes()
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Commit(gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Eq(localRepositaryPath), "remotes/origin/main").Return(dryRunDiff, nil)
+
+		options := &BizOptions{ProductName: defaultGroupName, DryRun: true}
+		result, err := newApplyUsecase().Apply(context.Background(), options, newResources())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.DryRun).Should(BeTrue())
+		Expect(result.Yaml).Should(ContainSubstring("cluster: test-cluster"))
+		Expect(result.Yaml).Should(ContainSubstring("---\n"))
+		Expect(result.Yaml).Should(ContainSubstring(fmt.Sprintf("name: %s", artifactRepo)))
+	})
+
+	It("pushes nothing when the check of the resources fails", func() {
+		expectLoadNodes()
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(ErrorResourceNoMatch)
+
+		options := &BizOptions{ProductName: defaultGroupName}
+		_, err := newApplyUsecase().Apply(context.Background(), options, newResources())
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("rejects a resource applied more than once", func() {
+		resources := append(newResources(), newResources()[0])
+
+		_, err := newApplyUsecase().Apply(context.Background(), &BizOptions{ProductName: defaultGroupName}, resources)
+		Expect(ErrorInvalidApply.Is(err)).Should(BeTrue())
+	})
+
+	It("rejects an unsupported kind", func() {
+		resources := []*ApplyResource{{Kind: nodestree.Cluster, Name: "cluster1"}}
+
+		_, err := newApplyUsecase().Apply(context.Background(), &BizOptions{ProductName: defaultGroupName}, resources)
+		Expect(ErrorInvalidApply.Is(err)).Should(BeTrue())
+	})
+
+	It("deletes the repositories it created when the apply fails", func() {
+		repoName := "new-repo"
+		created := &Project{Id: 1222}
+		repoPath := fmt.Sprintf("%s/%s", defaultProductGroup.Path, repoName)
+		missingRepoPath := fmt.Sprintf("%s/%s", defaultProductGroup.Path, "missing-repo")
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), repoPath).Return(nil, ErrorProjectNotFound).Times(2)
+		codeRepo.EXPECT().CreateCodeRepo(gomock.Any(), int(defaultProductGroup.Id), gomock.Any()).Return(created, nil)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), missingRepoPath).Return(nil, ErrorProjectNotFound)
+		codeRepo.EXPECT().DeleteCodeRepo(gomock.Any(), int(created.Id)).Return(nil)
+
+		resources := []*ApplyResource{
+			{
+				Kind: nodestree.DeploymentRuntime,
+				Name: "dr1",
+				Data: &DeploymentRuntimeData{
+					Name: "dr1",
+					Spec: resourcev1alpha1.DeploymentRuntimeSpec{
+						ManifestSource: resourcev1alpha1.ManifestSource{CodeRepo: "missing-repo"},
+					},
+				},
+			},
+			{
+				Kind:       nodestree.CodeRepo,
+				Name:       repoName,
+				Data:       &CodeRepoData{Name: repoName},
+				GitOptions: &GitCodeRepoOptions{Gitlab: &GitlabCodeRepoOptions{Name: repoName, Path: repoName}},
+			},
+		}
+
+		_, err := newApplyUsecase().Apply(context.Background(), &BizOptions{ProductName: defaultGroupName}, resources)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("reports a deploy key that cannot be saved after the push as a warning", func() {
+		repoName := "new-repo"
+		created := &Project{Id: 1222}
+		repoPath := fmt.Sprintf("%s/%s", defaultProductGroup.Path, repoName)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), repoPath).Return(nil, ErrorProjectNotFound).Times(2)
+		codeRepo.EXPECT().CreateCodeRepo(gomock.Any(), int(defaultProductGroup.Id), gomock.Any()).Return(created, nil)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil)
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil)
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(emptyNodes, nil)
+		nodesTree.EXPECT().GetNode(gomock.Any(), nodestree.CodeRepo, "repo-1222").Return(nil)
+		nodesTree.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&emptyNodes, nil)
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(nil)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
+		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil)
+		secretRepo.EXPECT().GetDeployKey(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("the secret store is sealed"))
+		k8sClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		resources := []*ApplyResource{
+			{
+				Kind:       nodestree.CodeRepo,
+				Name:       repoName,
+				Data:       &CodeRepoData{Name: repoName, Spec: resourcev1alpha1.CodeRepoSpec{Webhook: &resourcev1alpha1.Webhook{}}},
+				GitOptions: &GitCodeRepoOptions{Gitlab: &GitlabCodeRepoOptions{Name: repoName, Path: repoName}},
+			},
+		}
+
+		result, err := newApplyUsecase().Apply(context.Background(), &BizOptions{ProductName: defaultGroupName}, resources)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Commit).Should(Equal(headCommit))
+		Expect(result.Warnings).Should(HaveLen(1))
+		Expect(result.Warnings[0]).Should(ContainSubstring("the secret store is sealed"))
+	})
+
+	It("exports a product without artifact repos", func() {
+		productNodes := createContainEnvironmentNodes(envNode)
+		productNodes.Children = append(productNodes.Children, &nodestree.Node{
+			Name:  _CodeReposSubDir,
+			Path:  fmt.Sprintf("%v/%v", defaultProjectName, _CodeReposSubDir),
+			IsDir: true,
+			Level: 2,
+		})
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Any()).Return(defaultProductGroup, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil).AnyTimes()
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil).AnyTimes()
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil).AnyTimes()
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(productNodes, nil).AnyTimes()
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(nil).AnyTimes()
+
+		resources, err := newApplyUsecase().ExportProduct(context.Background(), defaultGroupName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(resources.ArtifactRepos).Should(BeEmpty())
+		Expect(resources.CodeRepos).Should(BeEmpty())
+		Expect(resources.Environments).Should(HaveLen(1))
+		Expect(resources.Environments[0].Name).Should(Equal(envName))
+	})
+
+	It("reads every kind of the exported product from a single checkout", func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Any()).Return(defaultProductGroup, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil)
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil)
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
+		productNodes := createContainEnvironmentNodes(createEnvironmentNode(createEnvironmentResource(envName)))
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(productNodes, nil)
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(nil)
+
+		resources, err := newApplyUsecase().ExportProduct(context.Background(), defaultGroupName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(resources.Environments).Should(HaveLen(1))
+	})
+
+	It("fails to export a product that cannot be read", func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(nil, ErrorGroupNotFound)
+
+		_, err := newApplyUsecase().ExportProduct(context.Background(), defaultGroupName)
+		Expect(ErrorGroupNotFound.Is(err)).Should(BeTrue())
+	})
+
+	It("exports a product without resources", func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Any()).Return(defaultProductGroup, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil).AnyTimes()
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil).AnyTimes()
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil).AnyTimes()
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(emptyNodes, nil).AnyTimes()
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(nil).AnyTimes()
+
+		resources, err := newApplyUsecase().ExportProduct(context.Background(), defaultGroupName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(resources.CodeRepos).Should(BeEmpty())
+		Expect(resources.Environments).Should(BeEmpty())
+	})
+
+	It("keeps the reason of a template product that cannot be read", func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(nil, ErrorGroupNotFound)
+
+		_, err := newApplyUsecase().ApplyTemplate(context.Background(), "shop", &ProductTemplateOptions{Template: defaultGroupName})
+		Expect(ErrorGroupNotFound.Is(err)).Should(BeTrue())
+		Expect(err.Error()).Should(ContainSubstring("failed to read template product"))
+	})
+})
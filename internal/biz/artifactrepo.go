@@ -0,0 +1,363 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	_ArtifactRepoKind    = "ArtifactRepo"
+	_ArtifactReposSubDir = "artifact-repos"
+)
+
+// ArtifactRepoUsecase manages the artifact repos of a product.
+// An artifact repo is linked to the projects that publish to it through its spec.projects, which CheckReference validates.
+// Runtimes cannot reference artifact repos yet: the DeploymentRuntime and ProjectPipelineRuntime specs of
+// github.com/nautes-labs/pkg have no field for it, so there is nothing for their CheckReference to validate.
+type ArtifactRepoUsecase struct {
+	log              *log.Helper
+	codeRepo         CodeRepo
+	nodestree        nodestree.NodesTree
+	config           *nautesconfigs.Config
+	resourcesUsecase *ResourcesUsecase
+}
+
+type ArtifactRepoData struct {
+	Name string
+	Spec resourcev1alpha1.ArtifactRepoSpec
+}
+
+func NewArtifactRepoUsecase(logger log.Logger, codeRepo CodeRepo, nodestree nodestree.NodesTree, config *nautesconfigs.Config, resourcesUsecase *ResourcesUsecase) *ArtifactRepoUsecase {
+	artifactRepo := &ArtifactRepoUsecase{log: log.NewHelper(log.With(logger)), codeRepo: codeRepo, nodestree: nodestree, config: config, resourcesUsecase: resourcesUsecase}
+	nodestree.AppendOperators(artifactRepo)
+	return artifactRepo
+}
+
+func (a *ArtifactRepoUsecase) convertProductToGroupName(ctx context.Context, artifactRepo *resourcev1alpha1.ArtifactRepo) error {
+	if artifactRepo.Spec.Product == "" {
+		return fmt.Errorf("the product field value of artifact repo %s should not be empty", artifactRepo.Name)
+	}
+
+	groupName, err := a.resourcesUsecase.convertProductToGroupName(ctx, artifactRepo.Spec.Product)
+	if err != nil {
+		return err
+	}
+
+	artifactRepo.Spec.Product = groupName
+
+	return nil
+}
+
+func (a *ArtifactRepoUsecase) GetArtifactRepo(ctx context.Context, artifactRepoName, productName string) (*resourcev1alpha1.ArtifactRepo, error) {
+	node, err := a.resourcesUsecase.Get(ctx, nodestree.ArtifactRepo, productName, a, func(nodes nodestree.Node) (string, error) {
+		return artifactRepoName, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	artifactRepo, err := a.nodeToResource(node)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.convertProductToGroupName(ctx, artifactRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	return artifactRepo, nil
+}
+
+func (a *ArtifactRepoUsecase) ListArtifactRepos(ctx context.Context, productName string) ([]*resourcev1alpha1.ArtifactRepo, error) {
+	nodes, err := a.resourcesUsecase.List(ctx, productName, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.artifactReposFromNodes(ctx, *nodes)
+}
+
+// artifactReposFromNodes converts the artifact repos in the nodes of the product.
+func (a *ArtifactRepoUsecase) artifactReposFromNodes(ctx context.Context, nodes nodestree.Node) ([]*resourcev1alpha1.ArtifactRepo, error) {
+	artifactRepos, err := a.nodesToLists(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, artifactRepo := range artifactRepos {
+		err = a.convertProductToGroupName(ctx, artifactRepo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return artifactRepos, nil
+}
+
+func (a *ArtifactRepoUsecase) nodesToLists(nodes nodestree.Node) ([]*resourcev1alpha1.ArtifactRepo, error) {
+	var artifactReposDir *nodestree.Node
+	var resources []*resourcev1alpha1.ArtifactRepo
+
+	for _, child := range nodes.Children {
+		if child.Name == _ArtifactReposSubDir {
+			artifactReposDir = child
+			break
+		}
+	}
+
+	// The directory is only created with the first artifact repo of the product.
+	if artifactReposDir == nil {
+		return resources, nil
+	}
+
+	for _, subNode := range artifactReposDir.Children {
+		for _, node := range subNode.Children {
+			if node.Kind != nodestree.ArtifactRepo {
+				continue
+			}
+
+			r, err := a.nodeToResource(node)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+func (a *ArtifactRepoUsecase) nodeToResource(node *nodestree.Node) (*resourcev1alpha1.ArtifactRepo, error) {
+	r, ok := node.Content.(*resourcev1alpha1.ArtifactRepo)
+	if !ok {
+		return nil, fmt.Errorf("failed to get instance when get %s artifact repo", node.Name)
+	}
+
+	return r, nil
+}
+
+func (a *ArtifactRepoUsecase) SaveArtifactRepo(ctx context.Context, options *BizOptions, data *ArtifactRepoData) (*ChangeResult, error) {
+	group, err := a.codeRepo.GetGroup(ctx, options.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Spec.Product = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
+	if data.Spec.RepoName == "" {
+		data.Spec.RepoName = data.Name
+	}
+	resourceOptions := &resourceOptions{
+		resourceKind:      nodestree.ArtifactRepo,
+		productName:       options.ProductName,
+		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
+		operator:          a,
+	}
+	result, err := a.resourcesUsecase.Save(ctx, resourceOptions, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (a *ArtifactRepoUsecase) prepareApply(ctx context.Context, group *Group, options *BizOptions, resource *ApplyResource) (string, error) {
+	data, ok := resource.Data.(*ArtifactRepoData)
+	if !ok {
+		return "", ErrorInvalidApply.WithCause(fmt.Errorf("the data of artifact repo %s is invalid", resource.Name))
+	}
+
+	data.Spec.Product = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
+	if data.Spec.RepoName == "" {
+		data.Spec.RepoName = data.Name
+	}
+
+	return data.Name, nil
+}
+
+func (a *ArtifactRepoUsecase) DeleteArtifactRepo(ctx context.Context, options *BizOptions) (*ChangeResult, error) {
+	resourceOptions := &resourceOptions{
+		resourceKind:      nodestree.ArtifactRepo,
+		productName:       options.ProductName,
+		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
+		operator:          a,
+	}
+	result, err := a.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
+		return options.ResouceName, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (a *ArtifactRepoUsecase) GetArtifactRepoHistory(ctx context.Context, artifactRepoName, productName string) ([]*Commit, error) {
+	return a.resourcesUsecase.History(ctx, nodestree.ArtifactRepo, productName, func(nodes nodestree.Node) (string, error) {
+		return artifactRepoName, nil
+	})
+}
+
+// RollbackArtifactRepo saves the artifact repo as it was in the revision.
+func (a *ArtifactRepoUsecase) RollbackArtifactRepo(ctx context.Context, options *BizOptions, revision string) (*ChangeResult, error) {
+	options.CommitMessage = rollbackMessage(revision, options.CommitMessage)
+	node, err := a.resourcesUsecase.GetRevision(ctx, nodestree.ArtifactRepo, options.ProductName, a, func(nodes nodestree.Node) (string, error) {
+		return options.ResouceName, nil
+	}, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	artifactRepo, err := a.nodeToResource(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.SaveArtifactRepo(ctx, options, &ArtifactRepoData{
+		Name: artifactRepo.Name,
+		Spec: artifactRepo.Spec,
+	})
+}
+
+func (a *ArtifactRepoUsecase) CreateNode(path string, data interface{}) (*nodestree.Node, error) {
+	val, ok := data.(*ArtifactRepoData)
+	if !ok {
+		return nil, fmt.Errorf("failed to creating specify node, the path: %s", path)
+	}
+
+	if val.Spec.Projects == nil {
+		val.Spec.Projects = make([]string, 0)
+	}
+
+	artifactRepo := &resourcev1alpha1.ArtifactRepo{
+		TypeMeta: v1.TypeMeta{
+			Kind:       nodestree.ArtifactRepo,
+			APIVersion: resourcev1alpha1.GroupVersion.String(),
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name: val.Name,
+		},
+		Spec: val.Spec,
+	}
+
+	resourceDirectory := fmt.Sprintf("%s/%s", path, _ArtifactReposSubDir)
+	resourcePath := fmt.Sprintf("%s/%s/%s.yaml", resourceDirectory, val.Name, val.Name)
+
+	return &nodestree.Node{
+		Name:    val.Name,
+		Path:    resourcePath,
+		Content: artifactRepo,
+		Kind:    nodestree.ArtifactRepo,
+		Level:   4,
+	}, nil
+}
+
+func (a *ArtifactRepoUsecase) UpdateNode(resourceNode *nodestree.Node, data interface{}) (*nodestree.Node, error) {
+	val, ok := data.(*ArtifactRepoData)
+	if !ok {
+		return nil, fmt.Errorf("failed to get artifact repo %s data when updating node", resourceNode.Name)
+	}
+
+	if val.Spec.Projects == nil {
+		val.Spec.Projects = make([]string, 0)
+	}
+
+	artifactRepo, ok := resourceNode.Content.(*resourcev1alpha1.ArtifactRepo)
+	if !ok {
+		return nil, fmt.Errorf("failed to get artifact repo %s when updating node", resourceNode.Name)
+	}
+
+	if reflect.DeepEqual(artifactRepo.Spec, val.Spec) {
+		return resourceNode, nil
+	}
+
+	artifactRepo.Spec = val.Spec
+	resourceNode.Content = artifactRepo
+
+	return resourceNode, nil
+}
+
+func (a *ArtifactRepoUsecase) CheckReference(options nodestree.CompareOptions, node *nodestree.Node, k8sClient client.Client) (bool, error) {
+	if node.Kind != nodestree.ArtifactRepo {
+		return false, nil
+	}
+
+	artifactRepo, ok := node.Content.(*resourcev1alpha1.ArtifactRepo)
+	if !ok {
+		return true, fmt.Errorf("node %s resource type error", node.Name)
+	}
+
+	err := nodestree.CheckResourceSubdirectory(&options.Nodes, node)
+	if err != nil {
+		return true, err
+	}
+
+	productName := artifactRepo.Spec.Product
+	if productName != options.ProductName {
+		return true, fmt.Errorf("the product name of resource %s does not match the current product name, expected product is %s, but now is %s", artifactRepo.Name, options.ProductName, productName)
+	}
+
+	for _, project := range artifactRepo.Spec.Projects {
+		ok = nodestree.IsResourceExist(options, project, nodestree.Project)
+		if !ok {
+			return true, fmt.Errorf(_ResourceDoesNotExistOrUnavailable, _ProjectKind,
+				project, _ArtifactRepoKind, artifactRepo.Name, _ArtifactReposSubDir+"/"+artifactRepo.Name)
+		}
+	}
+
+	tenantAdminNamespace := a.config.Nautes.Namespace
+	if tenantAdminNamespace == "" {
+		return true, fmt.Errorf("tenant admin namspace cannot be empty")
+	}
+
+	objKey := client.ObjectKey{
+		Namespace: tenantAdminNamespace,
+		Name:      artifactRepo.Spec.ArtifactRepoProvider,
+	}
+
+	err = k8sClient.Get(context.TODO(), objKey, &resourcev1alpha1.ArtifactRepoProvider{})
+	if err != nil {
+		return true, fmt.Errorf("artifactRepoProvider %s is an invalid resource, err: %s", artifactRepo.Spec.ArtifactRepoProvider, err)
+	}
+
+	return true, nil
+}
+
+func (a *ArtifactRepoUsecase) CreateResource(kind string) interface{} {
+	if kind != nodestree.ArtifactRepo {
+		return nil
+	}
+
+	return &resourcev1alpha1.ArtifactRepo{}
+}
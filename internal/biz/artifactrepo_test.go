@@ -0,0 +1,273 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nautes-labs/api-server/pkg/kubernetes"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	utilstrings "github.com/nautes-labs/api-server/util/string"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	artifactRepoProvider = "nexus"
+)
+
+func createArtifactRepoResource(name string) *resourcev1alpha1.ArtifactRepo {
+	return &resourcev1alpha1.ArtifactRepo{
+		TypeMeta: v1.TypeMeta{
+			Kind: nodestree.ArtifactRepo,
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name: name,
+		},
+		Spec: resourcev1alpha1.ArtifactRepoSpec{
+			ArtifactRepoProvider: artifactRepoProvider,
+			Product:              defaultProductId,
+			Projects:             []string{},
+			RepoName:             name,
+			RepoType:             "local",
+			PackageType:          "maven",
+		},
+	}
+}
+
+func createArtifactRepoNode(resource *resourcev1alpha1.ArtifactRepo) *nodestree.Node {
+	return &nodestree.Node{
+		Name:    resource.Name,
+		Kind:    nodestree.ArtifactRepo,
+		Path:    fmt.Sprintf("%s/%s/%s/%s.yaml", localRepositaryPath, _ArtifactReposSubDir, resource.Name, resource.Name),
+		Level:   4,
+		Content: resource,
+	}
+}
+
+func createContainArtifactRepoNodes(node *nodestree.Node) nodestree.Node {
+	return nodestree.Node{
+		Name:  defaultProjectName,
+		Path:  defaultProjectName,
+		IsDir: true,
+		Level: 1,
+		Children: []*nodestree.Node{
+			{
+				Name:  _ArtifactReposSubDir,
+				Path:  fmt.Sprintf("%v/%v", defaultProjectName, _ArtifactReposSubDir),
+				IsDir: true,
+				Level: 2,
+				Children: []*nodestree.Node{
+					{
+						Name:  node.Name,
+						Path:  fmt.Sprintf("%s/%s/%s", localRepositaryPath, _ArtifactReposSubDir, node.Name),
+						IsDir: true,
+						Level: 3,
+						Children: []*nodestree.Node{
+							node,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("Get artifact repo", func() {
+	var (
+		resourceName = "maven-releases"
+		fakeResource = createArtifactRepoResource(resourceName)
+		fakeNode     = createArtifactRepoNode(fakeResource)
+		fakeNodes    = createContainArtifactRepoNodes(fakeNode)
+	)
+	It("will get artifact repo success", testUseCase.GetResourceSuccess(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourcesUsecase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		id, _ := utilstrings.ExtractNumber("product-", fakeResource.Spec.Product)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), id).Return(defaultProductGroup, nil)
+
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourcesUsecase)
+		result, err := biz.GetArtifactRepo(context.Background(), resourceName, defaultGroupName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).Should(Equal(fakeResource))
+	}))
+
+	It("will fail when resource is not found", testUseCase.GetResourceFail(func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourceUseCase)
+		_, err := biz.GetArtifactRepo(context.Background(), resourceName, defaultGroupName)
+		Expect(err).Should(HaveOccurred())
+	}))
+})
+
+var _ = Describe("List artifact repos", func() {
+	var (
+		resourceName = "maven-releases"
+		fakeResource = createArtifactRepoResource(resourceName)
+		fakeNode     = createArtifactRepoNode(fakeResource)
+		fakeNodes    = createContainArtifactRepoNodes(fakeNode)
+	)
+	It("will list successfully", testUseCase.ListResourceSuccess(fakeNodes, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		id, _ := utilstrings.ExtractNumber("product-", fakeResource.Spec.Product)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), id).Return(defaultProductGroup, nil)
+
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourceUseCase)
+		results, err := biz.ListArtifactRepos(ctx, defaultGroupName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results).Should(HaveLen(1))
+		Expect(results[0]).Should(Equal(fakeResource))
+	}))
+
+	It("will list no artifact repos when the product has none", testUseCase.ListResourceSuccess(emptyNodes, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourceUseCase)
+		results, err := biz.ListArtifactRepos(ctx, defaultGroupName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results).Should(BeEmpty())
+	}))
+
+	It("does not conform to the template layout", testUseCase.ListResourceNotMatch(fakeNodes, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourceUseCase)
+		_, err := biz.ListArtifactRepos(ctx, defaultGroupName)
+		Expect(err).Should(HaveOccurred())
+	}))
+})
+
+var _ = Describe("Save artifact repo", func() {
+	var (
+		resourceName     = "maven-releases"
+		fakeResource     = createArtifactRepoResource(resourceName)
+		fakeNode         = createArtifactRepoNode(fakeResource)
+		fakeNodes        = createContainArtifactRepoNodes(fakeNode)
+		artifactRepoData = &ArtifactRepoData{
+			Name: resourceName,
+			Spec: resourcev1alpha1.ArtifactRepoSpec{
+				ArtifactRepoProvider: artifactRepoProvider,
+				RepoType:             "remote",
+				PackageType:          "maven",
+			},
+		}
+		bizOptions = &BizOptions{
+			ResouceName: resourceName,
+			ProductName: defaultGroupName,
+		}
+	)
+
+	It("failed to get product info", testUseCase.GetProductFail(func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourceUseCase)
+		_, err := biz.SaveArtifactRepo(context.Background(), bizOptions, artifactRepoData)
+		Expect(err).Should(HaveOccurred())
+	}))
+
+	It("will created successfully", testUseCase.CreateResourceSuccess(fakeNodes, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourceUseCase)
+		_, err := biz.SaveArtifactRepo(context.Background(), bizOptions, artifactRepoData)
+		Expect(err).ShouldNot(HaveOccurred())
+	}))
+
+	It("will updated successfully", testUseCase.UpdateResoureSuccess(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourceUseCase)
+		_, err := biz.SaveArtifactRepo(context.Background(), bizOptions, artifactRepoData)
+		Expect(err).ShouldNot(HaveOccurred())
+	}))
+
+	It("modify resource but non compliant layout", testUseCase.UpdateResourceButNotConformTemplate(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourceUseCase)
+		_, err := biz.SaveArtifactRepo(context.Background(), bizOptions, artifactRepoData)
+		Expect(err).Should(HaveOccurred())
+	}))
+
+	Describe("check reference by resources", func() {
+		It("incorrect product name", testUseCase.CheckReferenceButIncorrectProduct(fakeNodes, func(options nodestree.CompareOptions, nodestree *nodestree.MockNodesTree) {
+			biz := NewArtifactRepoUsecase(logger, nil, nodestree, nautesConfigs, nil)
+			ok, err := biz.CheckReference(options, fakeNode, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		}))
+
+		It("project reference not found", func() {
+			resource := createArtifactRepoResource(resourceName)
+			resource.Spec.Projects = []string{"project-not-found"}
+			node := createArtifactRepoNode(resource)
+			options := nodestree.CompareOptions{
+				Nodes:       createContainArtifactRepoNodes(node),
+				ProductName: defaultProductId,
+			}
+			nodestree := nodestree.NewMockNodesTree(ctl)
+			nodestree.EXPECT().AppendOperators(gomock.Any())
+
+			biz := NewArtifactRepoUsecase(logger, nil, nodestree, nautesConfigs, nil)
+			ok, err := biz.CheckReference(options, node, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+
+		It("artifact repo provider reference not found", func() {
+			options := nodestree.CompareOptions{
+				Nodes:       fakeNodes,
+				ProductName: defaultProductId,
+			}
+			nodestree := nodestree.NewMockNodesTree(ctl)
+			nodestree.EXPECT().AppendOperators(gomock.Any())
+
+			objKey := client.ObjectKey{
+				Namespace: nautesConfigs.Nautes.Namespace,
+				Name:      artifactRepoProvider,
+			}
+			client := kubernetes.NewMockClient(ctl)
+			client.EXPECT().Get(gomock.Any(), objKey, &resourcev1alpha1.ArtifactRepoProvider{}).Return(ErrorResourceNoFound)
+
+			biz := NewArtifactRepoUsecase(logger, nil, nodestree, nautesConfigs, nil)
+			ok, err := biz.CheckReference(options, fakeNode, client)
+			Expect(err).Should(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("Delete artifact repo", func() {
+	var (
+		resourceName = "maven-releases"
+		fakeResource = createArtifactRepoResource(resourceName)
+		fakeNode     = createArtifactRepoNode(fakeResource)
+		fakeNodes    = createContainArtifactRepoNodes(fakeNode)
+		bizOptions   = &BizOptions{
+			ResouceName: resourceName,
+			ProductName: defaultGroupName,
+		}
+	)
+
+	BeforeEach(func() {
+		err := os.MkdirAll(filepath.Dir(fakeNode.Path), 0644)
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = os.Create(fakeNode.Path)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("will deleted successfully", testUseCase.DeleteResourceSuccess(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourceUseCase)
+		_, err := biz.DeleteArtifactRepo(context.Background(), bizOptions)
+		Expect(err).ShouldNot(HaveOccurred())
+	}))
+
+	It("modify resource but non compliant layout standards", testUseCase.DeleteResourceErrorLayout(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewArtifactRepoUsecase(logger, codeRepo, nodestree, nautesConfigs, resourceUseCase)
+		_, err := biz.DeleteArtifactRepo(context.Background(), bizOptions)
+		Expect(err).Should(HaveOccurred())
+	}))
+})
@@ -17,10 +17,45 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewProjectPipelineRuntimeUsecase, NewDeploymentRuntimeUsecase, NewProductUsecase, NewResourcesUsecase, NewProjectUsecase, NewEnviromentUsecase, NewClusterUsecase, NewCodeRepoUsecase)
+var ProviderSet = wire.NewSet(NewProjectPipelineRuntimeUsecase, NewDeploymentRuntimeUsecase, NewProductUsecase, NewResourcesUsecase, NewProjectUsecase, NewEnviromentUsecase, NewClusterUsecase, NewCodeRepoUsecase, NewArtifactRepoUsecase, NewProposalUsecase, NewApplyUsecase, NewWatchUsecase, NewStatusUsecase)
 
 type BizOptions struct {
 	ResouceName       string
 	ProductName       string
 	InsecureSkipCheck bool
+	// ResourceVersion is the version of the resource the caller read, empty means no precondition.
+	ResourceVersion string
+	// DryRun checks and renders the changes without pushing them.
+	DryRun bool
+	// Proposal pushes the changes to a new branch and opens a merge request instead of pushing to main.
+	Proposal bool
+	// CommitMessage is appended to the generated message of the commit, it is optional.
+	CommitMessage string
+}
+
+// ProposalPolicy decides which products only accept their changes as proposals.
+type ProposalPolicy struct {
+	// Required requires proposals for the changes of all products.
+	Required bool
+	// Products are the products requiring proposals when they are not required for all products.
+	Products []string
+}
+
+// isRequired reports whether the changes of the product must be proposed, no policy requires nothing.
+func (p *ProposalPolicy) isRequired(productName string) bool {
+	if p == nil {
+		return false
+	}
+
+	if p.Required {
+		return true
+	}
+
+	for _, product := range p.Products {
+		if product == productName {
+			return true
+		}
+	}
+
+	return false
 }
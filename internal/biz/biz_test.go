@@ -45,6 +45,7 @@ type SaveRequestTestCases interface {
 	UpdateResoureSuccess(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{}
 	UpdateResourceButNotConformTemplate(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{}
 	UpdateResourceAndAutoMerge(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{}
+	UpdateResourceDryRun(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{}
 	MergeConflictFail(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{}
 	SaveConfigFail(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{}
 	CheckReferenceButIncorrectProduct(nodes nodestree.Node, fn CompareFunc) interface{}
@@ -53,6 +54,7 @@ type SaveRequestTestCases interface {
 type DeleteRequestTestCases interface {
 	DeleteResourceSuccess(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{}
 	DeleteResourceErrorLayout(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{}
+	DeleteResourceDryRun(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{}
 }
 
 type TestUseCases interface {
@@ -74,6 +76,8 @@ var (
 		User:  _GitUser,
 		Email: _GitEmail,
 	}
+	dryRunDiff = "diff --git a/envs/env1.yaml b/envs/env1.yaml"
+	headCommit = "7c5a1e5e1b0d2f8c6f2a4e1b3c9d8e7f6a5b4c3d"
 )
 
 func (t *testBiz) GetResourceSuccess(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{} {
@@ -92,7 +96,7 @@ func (t *testBiz) GetResourceSuccess(nodes nodestree.Node, node *nodestree.Node,
 		nodestree.EXPECT().GetNode(gomock.Any(), gomock.Any(), gomock.Any()).Return(node)
 
 		secretRepo := NewMockSecretrepo(ctl)
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 		nodestree.EXPECT().Compare(gomock.Any()).Return(nil)
 
 		fn(codeRepo, secretRepo, resourcesUsecase, nodestree, gitRepo, nil)
@@ -111,7 +115,7 @@ func (t *testBiz) GetResourceFail(fn BizFunc) interface{} {
 
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 
 		nodestree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(emptyNodes, nil)
 		nodestree.EXPECT().Compare(gomock.Any()).Return(nil)
@@ -137,7 +141,7 @@ func (t *testBiz) GetResourceNoMatch(fn BizFunc) interface{} {
 		nodestree.EXPECT().Compare(gomock.Any()).Return(nil)
 		nodestree.EXPECT().GetNode(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 
 		fn(codeRepo, nil, resourcesUsecase, nodestree, gitRepo, nil)
 	}
@@ -158,7 +162,7 @@ func (t *testBiz) ListResourceSuccess(nodes nodestree.Node, fn BizFunc) interfac
 		nodestree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(nodes, nil)
 		nodestree.EXPECT().Compare(gomock.Any()).Return(nil)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 
 		fn(codeRepo, nil, resourcesUsecase, nodestree, gitRepo, nil)
 	}
@@ -179,7 +183,7 @@ func (t *testBiz) ListResourceNotMatch(nodes nodestree.Node, fn BizFunc) interfa
 		nodestree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(nodes, nil)
 		nodestree.EXPECT().Compare(gomock.Any()).Return(ErrorResourceNoMatch)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 
 		fn(codeRepo, nil, resourcesUsecase, nodestree, gitRepo, nil)
 	}
@@ -190,7 +194,7 @@ func (t *testBiz) GetProductFail(fn BizFunc) interface{} {
 		codeRepo := NewMockCodeRepo(ctl)
 		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(nil, ErrorGroupNotFound)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, nil, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, nil, nil, nautesConfigs, nil)
 
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
@@ -206,7 +210,7 @@ func (t *testBiz) GetDefaultProjectFail(fn BizFunc) interface{} {
 		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil).AnyTimes()
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(nil, fmt.Errorf("default.project is not found"))
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, nil, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, nil, nil, nautesConfigs, nil)
 
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
@@ -224,7 +228,8 @@ func (t *testBiz) CreateResourceSuccess(nodes nodestree.Node, fn BizFunc) interf
 
 		gitRepo := NewMockGitRepo(ctl)
 		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath)).Return(nil)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
 
@@ -235,7 +240,7 @@ func (t *testBiz) CreateResourceSuccess(nodes nodestree.Node, fn BizFunc) interf
 		nodestree.EXPECT().GetNode(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 		nodestree.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&nodes, nil)
 		secretrepo := NewMockSecretrepo(ctl)
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 		client := kubernetes.NewMockClient(ctl)
 
 		fn(codeRepo, secretrepo, resourcesUsecase, nodestree, gitRepo, client)
@@ -251,7 +256,8 @@ func (t *testBiz) UpdateResoureSuccess(nodes nodestree.Node, node *nodestree.Nod
 
 		gitRepo := NewMockGitRepo(ctl)
 		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath)).Return(nil)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
 
@@ -264,7 +270,35 @@ func (t *testBiz) UpdateResoureSuccess(nodes nodestree.Node, node *nodestree.Nod
 		nodestree.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&nodes, nil)
 
 		secretrepo := NewMockSecretrepo(ctl)
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretrepo, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretrepo, gitRepo, nodestree, nautesConfigs, nil)
+		client := kubernetes.NewMockClient(ctl)
+
+		fn(codeRepo, secretrepo, resourcesUsecase, nodestree, gitRepo, client)
+	}
+}
+
+func (t *testBiz) UpdateResourceDryRun(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{} {
+	return func() {
+		codeRepo := NewMockCodeRepo(ctl)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil)
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil)
+
+		gitRepo := NewMockGitRepo(ctl)
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
+		gitRepo.EXPECT().Commit(gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Eq(localRepositaryPath), "remotes/origin/main").Return(dryRunDiff, nil)
+
+		nodestree := nodestree.NewMockNodesTree(ctl)
+		nodestree.EXPECT().AppendOperators(gomock.Any())
+		nodestree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(nodes, nil)
+		nodestree.EXPECT().Compare(gomock.Any()).Return(nil).AnyTimes()
+
+		nodestree.EXPECT().GetNode(gomock.Any(), gomock.Any(), gomock.Any()).Return(node)
+		nodestree.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&nodes, nil)
+
+		secretrepo := NewMockSecretrepo(ctl)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretrepo, gitRepo, nodestree, nautesConfigs, nil)
 		client := kubernetes.NewMockClient(ctl)
 
 		fn(codeRepo, secretrepo, resourcesUsecase, nodestree, gitRepo, client)
@@ -286,6 +320,7 @@ func (t *testBiz) UpdateResourceAndAutoMerge(nodes nodestree.Node, node *nodestr
 		gitRepo.EXPECT().Merge(gomock.Any(), localRepositaryPath).Return("any", nil)
 		gitRepo.EXPECT().Commit(localRepositaryPath, gomock.Any())
 		gitRepo.EXPECT().Push(gomock.Any(), localRepositaryPath).Return(nil)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil)
 
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
@@ -295,7 +330,7 @@ func (t *testBiz) UpdateResourceAndAutoMerge(nodes nodestree.Node, node *nodestr
 		nodestree.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&nodes, nil)
 
 		secretrepo := NewMockSecretrepo(ctl)
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 		client := kubernetes.NewMockClient(ctl)
 
 		fn(codeRepo, secretrepo, resourcesUsecase, nodestree, gitRepo, client)
@@ -323,7 +358,7 @@ func (t *testBiz) MergeConflictFail(nodes nodestree.Node, node *nodestree.Node,
 		nodestree.EXPECT().Compare(gomock.Any()).Return(nil).AnyTimes()
 		nodestree.EXPECT().GetNode(gomock.Any(), gomock.Any(), gomock.Any()).Return(node)
 		nodestree.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&nodes, nil)
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 		secretrepo := NewMockSecretrepo(ctl)
 		client := kubernetes.NewMockClient(ctl)
 
@@ -340,7 +375,7 @@ func (t *testBiz) SaveConfigFail(nodes nodestree.Node, node *nodestree.Node, fn
 
 		gitRepo := NewMockGitRepo(ctl)
 		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath)).Return(fmt.Errorf("failed to save config"))
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath), gomock.Any()).Return(fmt.Errorf("failed to save config"))
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
 
@@ -351,7 +386,7 @@ func (t *testBiz) SaveConfigFail(nodes nodestree.Node, node *nodestree.Node, fn
 		nodestree.EXPECT().GetNode(gomock.Any(), gomock.Any(), gomock.Any()).Return(node)
 		nodestree.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&nodes, nil)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 		secretrepo := NewMockSecretrepo(ctl)
 		client := kubernetes.NewMockClient(ctl)
 
@@ -400,7 +435,7 @@ func (t *testBiz) CreateResourceAndAutoRetry(nodes nodestree.Node, fn BizFunc) i
 
 		secretrepo := NewMockSecretrepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretrepo, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretrepo, gitRepo, nodestree, nautesConfigs, nil)
 
 		client := kubernetes.NewMockClient(ctl)
 
@@ -425,7 +460,7 @@ func (t *testBiz) CreateResourceButNotConformTemplate(fn BizFunc) interface{} {
 
 		secretrepo := NewMockSecretrepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 		fn(codeRepo, secretrepo, resourcesUsecase, nodestree, gitRepo, nil)
 	}
 }
@@ -448,7 +483,7 @@ func (t *testBiz) UpdateResourceButNotConformTemplate(nodes nodestree.Node, node
 		nodestree.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&nodes, nil)
 
 		secretrepo := NewMockSecretrepo(ctl)
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 		client := kubernetes.NewMockClient(ctl)
 
 		fn(codeRepo, secretrepo, resourcesUsecase, nodestree, gitRepo, client)
@@ -464,7 +499,8 @@ func (t *testBiz) DeleteResourceSuccess(nodes nodestree.Node, node *nodestree.No
 
 		gitRepo := NewMockGitRepo(ctl)
 		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath)).Return(nil)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
 
@@ -478,7 +514,35 @@ func (t *testBiz) DeleteResourceSuccess(nodes nodestree.Node, node *nodestree.No
 
 		secretRepo := NewMockSecretrepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nodestree, nautesConfigs, nil)
+
+		fn(codeRepo, secretRepo, resourcesUsecase, nodestree, gitRepo, nil)
+	}
+}
+
+func (t *testBiz) DeleteResourceDryRun(nodes nodestree.Node, node *nodestree.Node, fn BizFunc) interface{} {
+	return func() {
+		codeRepo := NewMockCodeRepo(ctl)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil)
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil)
+
+		gitRepo := NewMockGitRepo(ctl)
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
+		gitRepo.EXPECT().Commit(gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Eq(localRepositaryPath), "remotes/origin/main").Return(dryRunDiff, nil)
+
+		nodestree := nodestree.NewMockNodesTree(ctl)
+		nodestree.EXPECT().AppendOperators(gomock.Any())
+
+		nodestree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(nodes, nil)
+		nodestree.EXPECT().Compare(gomock.Any()).Return(nil).AnyTimes()
+		nodestree.EXPECT().GetNode(gomock.Any(), gomock.Any(), gomock.Any()).Return(node)
+		nodestree.EXPECT().RemoveNode(gomock.Any(), node).Return(&emptyNodes, nil)
+
+		secretRepo := NewMockSecretrepo(ctl)
+
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nodestree, nautesConfigs, nil)
 
 		fn(codeRepo, secretRepo, resourcesUsecase, nodestree, gitRepo, nil)
 	}
@@ -501,7 +565,7 @@ func (t *testBiz) DeleteResouceNoMatch(nodes nodestree.Node, fn BizFunc) interfa
 		nodestree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(nodes, nil)
 		nodestree.EXPECT().Compare(gomock.Any()).Return(ErrorResourceNoMatch)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nodestree, nautesConfigs, nil)
 
 		fn(codeRepo, secretRepo, resourcesUsecase, nodestree, gitRepo, nil)
 	}
@@ -526,7 +590,7 @@ func (t *testBiz) DeleteResourceErrorLayout(nodes nodestree.Node, node *nodestre
 
 		secretRepo := NewMockSecretrepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodestree, nautesConfigs, nil)
 
 		fn(codeRepo, secretRepo, resourcesUsecase, nodestree, gitRepo, nil)
 	}
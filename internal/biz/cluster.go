@@ -21,10 +21,12 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/go-kratos/kratos/v2/log"
 	cluster "github.com/nautes-labs/api-server/pkg/cluster"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
 	utilstrings "github.com/nautes-labs/api-server/util/string"
 	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
 	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
@@ -54,9 +56,11 @@ type ClusterUsecase struct {
 type ClusterData struct {
 	ClusterName string
 	ApiServer   string
+	ClusterKind string
 	ClusterType string
 	Usage       string
 	HostCluster string
+	Vclusters   []string
 }
 
 func NewClusterUsecase(logger log.Logger, codeRepo CodeRepo, secretRepo Secretrepo, resourcesUsecase *ResourcesUsecase, configs *nautesconfigs.Config, client client.Client, cluster cluster.ClusterRegistrationOperator, dex DexRepo) *ClusterUsecase {
@@ -149,12 +153,142 @@ func (c *ClusterUsecase) GetTenantRepository(ctx context.Context) (*Project, err
 	return project, nil
 }
 
-func (c *ClusterUsecase) SaveCluster(ctx context.Context, param *cluster.ClusterRegistrationParam, kubeconfig string) error {
+// checkTenantMaintainer rejects the users who are not maintainers of the tenant repository,
+// they may read the clusters but not use or replace their credentials.
+func (c *ClusterUsecase) checkTenantMaintainer(ctx context.Context) error {
+	project, err := c.GetTenantRepository(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !project.Maintainer {
+		return ErrorNoAuth.WithCause(fmt.Errorf("the maintainer permission of the tenant repository %s is required", project.PathWithNamespace))
+	}
+
+	return nil
+}
+
+// GetCluster reads the cluster from its own resource file in the tenant repository, a host cluster comes with the vclusters it hosts
+func (c *ClusterUsecase) GetCluster(ctx context.Context, clusterName string) (*ClusterData, error) {
+	if clusterName == "" || filepath.Base(clusterName) != clusterName {
+		return nil, ErrorResourceNoFound
+	}
+
+	tenantRepositoryLocalPath, err := c.cloneTenantRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanCodeRepo(tenantRepositoryLocalPath)
+
+	dir := cluster.GetClustersDir(tenantRepositoryLocalPath)
+	resource, err := GetCluster(filepath.Join(dir, fmt.Sprintf("%s.yaml", clusterName)))
+	if os.IsNotExist(err) {
+		return nil, ErrorResourceNoFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resource.Kind != nodestree.Cluster {
+		return nil, ErrorResourceNoFound
+	}
+
+	item := newClusterData(resource)
+	if item.Usage != string(resourcev1alpha1.CLUSTER_USAGE_HOST) {
+		return item, nil
+	}
+
+	clusters, err := c.listClusters(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, cluster := range clusters {
+		if cluster.Spec.HostCluster == item.ClusterName {
+			item.Vclusters = append(item.Vclusters, cluster.Name)
+		}
+	}
+
+	return item, nil
+}
+
+// ListClusters reads all clusters registered in the tenant repository
+func (c *ClusterUsecase) ListClusters(ctx context.Context) ([]*ClusterData, error) {
+	tenantRepositoryLocalPath, err := c.cloneTenantRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanCodeRepo(tenantRepositoryLocalPath)
+
+	clusters, err := c.listClusters(cluster.GetClustersDir(tenantRepositoryLocalPath))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*ClusterData, 0, len(clusters))
+	for _, cluster := range clusters {
+		items = append(items, newClusterData(cluster))
+	}
+
+	for _, host := range items {
+		if host.Usage != string(resourcev1alpha1.CLUSTER_USAGE_HOST) {
+			continue
+		}
+		for _, item := range items {
+			if item.HostCluster == host.ClusterName {
+				host.Vclusters = append(host.Vclusters, item.ClusterName)
+			}
+		}
+	}
+
+	return items, nil
+}
+
+func (c *ClusterUsecase) cloneTenantRepository(ctx context.Context) (string, error) {
+	project, err := c.GetTenantRepository(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tenantRepositoryLocalPath, err := c.CloneRepository(ctx, project.HttpUrlToRepo)
+	if err != nil {
+		c.log.Debugf("failed to clone tenant repository, url: %s", project.HttpUrlToRepo)
+		return "", err
+	}
+
+	return tenantRepositoryLocalPath, nil
+}
+
+// listClusters parses the clusters in the directory, a file that cannot be parsed is logged and skipped
+// so that it does not hide the other clusters.
+func (c *ClusterUsecase) listClusters(dir string) ([]*resourcev1alpha1.Cluster, error) {
+	clusters, invalid, err := ListClusters(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for file, err := range invalid {
+		c.log.Warnf("the cluster file %s cannot be parsed and is skipped, err: %v", file, err)
+	}
+
+	return clusters, nil
+}
+
+func newClusterData(cluster *resourcev1alpha1.Cluster) *ClusterData {
+	return &ClusterData{
+		ClusterName: cluster.Name,
+		ApiServer:   cluster.Spec.ApiServer,
+		ClusterKind: string(cluster.Spec.ClusterKind),
+		ClusterType: string(cluster.Spec.ClusterType),
+		Usage:       string(cluster.Spec.Usage),
+		HostCluster: cluster.Spec.HostCluster,
+	}
+}
+
+func (c *ClusterUsecase) SaveCluster(ctx context.Context, param *cluster.ClusterRegistrationParam, kubeconfig string) (*ChangeResult, error) {
 	if ok := cluster.IsVirtualRuntime(param.Cluster); !ok {
 		err := c.SaveKubeconfig(ctx, param.Cluster.Name, param.Cluster.Spec.ApiServer, kubeconfig)
 		if err != nil {
 			c.log.Debugf("failed to  saved kubeconfig to secre repo, cluster name: %s", param.Cluster.Name)
-			return err
+			return nil, err
 		}
 		c.log.Infof("successfully saved kubeconfig to secre repo, cluster name: %s", param.Cluster.Name)
 	}
@@ -162,26 +296,31 @@ func (c *ClusterUsecase) SaveCluster(ctx context.Context, param *cluster.Cluster
 	cacert, err := c.GetCacert(ctx)
 	if err != nil {
 		c.log.Debugf("failed to get cacert to secre repo, cluster name: %s", param.Cluster.Name)
-		return err
+		return nil, err
 	}
 
 	url := GetClusterTemplateHttpsURL(c.configs)
 	clusterTemplateLocalPath, err := c.CloneRepository(ctx, url)
 	if err != nil {
 		c.log.Debugf("failed to clone cluster template repository, cluster name: %s, url: %s", param.Cluster.Name, url)
-		return err
+		return nil, err
 	}
 	defer cleanCodeRepo(clusterTemplateLocalPath)
 
 	project, err := c.GetTenantRepository(ctx)
 	if err != nil {
 		c.log.Debugf("failed to get tenant repository, cluster name: %s", param.Cluster.Name)
-		return err
+		return nil, err
+	}
+	unlock, err := c.resourcesUsecase.LockCodeRepo(ctx, project.HttpUrlToRepo)
+	if err != nil {
+		return nil, err
 	}
+	defer unlock()
 	tenantRepositoryLocalPath, err := c.CloneRepository(ctx, project.HttpUrlToRepo)
 	if err != nil {
 		c.log.Debugf("failed to clone tenant repository, cluster name: %s, url: %s", param.Cluster.Name, url)
-		return err
+		return nil, err
 	}
 	defer cleanCodeRepo(tenantRepositoryLocalPath)
 
@@ -192,56 +331,69 @@ func (c *ClusterUsecase) SaveCluster(ctx context.Context, param *cluster.Cluster
 	param.Configs = c.configs
 	err = c.cluster.InitializeDependencies(param)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	operation := "update"
+	_, err = os.Stat(fmt.Sprintf("%s/%s.yaml", cluster.GetClustersDir(tenantRepositoryLocalPath), param.Cluster.Name))
+	if os.IsNotExist(err) {
+		operation = "create"
+	}
+
 	err = c.cluster.Save()
 	if err != nil {
 		c.log.Debugf("failed to save cluster, cluster name: %s", param.Cluster.Name)
-		return err
+		return nil, err
 	}
 
-	err = c.resourcesUsecase.SaveConfig(ctx, tenantRepositoryLocalPath)
+	ctx = withCommitMessage(ctx, fmt.Sprintf("api: %s Cluster %s", operation, param.Cluster.Name))
+	result, err := c.resourcesUsecase.SaveConfig(ctx, tenantRepositoryLocalPath)
 	if err != nil {
 		c.log.Debugf("failed to save config to git, cluster name: %s", param.Cluster.Name)
-		return err
+		return nil, err
 	}
 
 	c.log.Infof("successfully register cluster, cluster name: %s", param.Cluster.Name)
 
 	err = c.SaveDexConfig(param, tenantRepositoryLocalPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	result.CommitURL = c.resourcesUsecase.commitURL(project, result.Commit)
 
-	return nil
+	return result, nil
 }
 
-func (c *ClusterUsecase) DeleteCluster(ctx context.Context, clusterName string) error {
+func (c *ClusterUsecase) DeleteCluster(ctx context.Context, clusterName string) (*ChangeResult, error) {
 	url := GetClusterTemplateHttpsURL(c.configs)
 	clusterTemplateLocalPath, err := c.CloneRepository(ctx, url)
 	if err != nil {
 		c.log.Debugf("failed to clone cluster template repository, cluster name: %s, url: %s", clusterName, url)
-		return err
+		return nil, err
 	}
 	defer cleanCodeRepo(clusterTemplateLocalPath)
 
 	project, err := c.GetTenantRepository(ctx)
 	if err != nil {
 		c.log.Debugf("failed to get tenant repository, cluster name: %s", clusterName)
-		return err
+		return nil, err
+	}
+	unlock, err := c.resourcesUsecase.LockCodeRepo(ctx, project.HttpUrlToRepo)
+	if err != nil {
+		return nil, err
 	}
+	defer unlock()
 	tenantRepositoryLocalPath, err := c.CloneRepository(ctx, project.HttpUrlToRepo)
 	if err != nil {
 		c.log.Debugf("failed to get tenant repository local path, cluster name: %s", clusterName)
-		return err
+		return nil, err
 	}
 	defer cleanCodeRepo(tenantRepositoryLocalPath)
 
-	clusterFilePath := fmt.Sprintf("%s/nautes/overlays/production/clusters/%s.yaml", tenantRepositoryLocalPath, clusterName)
+	clusterFilePath := fmt.Sprintf("%s/%s.yaml", cluster.GetClustersDir(tenantRepositoryLocalPath), clusterName)
 	clusterResouce, err := GetCluster(clusterFilePath)
 	if err != nil {
 		c.log.Debugf("failed to get cluster cluster resource, cluster name: %s", clusterName)
-		return err
+		return nil, err
 	}
 
 	param := &cluster.ClusterRegistrationParam{
@@ -253,29 +405,31 @@ func (c *ClusterUsecase) DeleteCluster(ctx context.Context, clusterName string)
 	}
 	err = c.cluster.InitializeDependencies(param)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = c.DeleteDexConfig(param)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = c.cluster.Remove()
 	if err != nil {
 		c.log.Debugf("failed to remove cluster, cluster name: %s", clusterName)
-		return err
+		return nil, err
 	}
 
-	err = c.resourcesUsecase.SaveConfig(ctx, tenantRepositoryLocalPath)
+	ctx = withCommitMessage(ctx, fmt.Sprintf("api: delete Cluster %s", clusterName))
+	result, err := c.resourcesUsecase.SaveConfig(ctx, tenantRepositoryLocalPath)
 	if err != nil {
 		c.log.Debugf("failed to save config to git, cluster name: %s", clusterName)
-		return err
+		return nil, err
 	}
 
 	c.log.Infof("successfully remove cluster, cluster name: %s", clusterName)
+	result.CommitURL = c.resourcesUsecase.commitURL(project, result.Commit)
 
-	return nil
+	return result, nil
 }
 
 func (c *ClusterUsecase) SaveDexConfig(param *cluster.ClusterRegistrationParam, teantLocalPath string) error {
@@ -349,3 +503,37 @@ func GetCluster(filename string) (*resourcev1alpha1.Cluster, error) {
 
 	return &cluster, nil
 }
+
+// ListClusters parses every cluster resource file in the directory, other files such as kustomization.yaml are skipped.
+// The files that cannot be parsed are returned with their error instead of failing the whole list.
+func ListClusters(dir string) ([]*resourcev1alpha1.Cluster, map[string]error, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	var clusters []*resourcev1alpha1.Cluster
+	invalid := make(map[string]error)
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".yaml" || file.Name() == KustomizationFileName {
+			continue
+		}
+
+		cluster, err := GetCluster(filepath.Join(dir, file.Name()))
+		if err != nil {
+			invalid[file.Name()] = err
+			continue
+		}
+
+		if cluster.Kind != nodestree.Cluster {
+			continue
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters, invalid, nil
+}
@@ -28,6 +28,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 var _ = Describe("Save cluster", func() {
@@ -108,7 +109,7 @@ var _ = Describe("Save cluster", func() {
 			ArgocdHost: "https://argocd.com",
 		}
 		tenant = &Project{
-			Id:                int32(22),
+			Id:                int64(22),
 			Name:              "repo-22",
 			Path:              "repo-22",
 			SshUrlToRepo:      tenantRepositoryHttpsURL,
@@ -137,9 +138,10 @@ var _ = Describe("Save cluster", func() {
 		gitRepo.EXPECT().Clone(gomock.Any(), tenantConfigCloneParam).Return(tenantRepositoryLocalPath, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), tenantRepositoryLocalPath)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), tenantRepositoryLocalPath, gomock.Any())
+		gitRepo.EXPECT().Head(tenantRepositoryLocalPath).Return(headCommit, nil)
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 		clusteroperator.EXPECT().InitializeDependencies(gomock.Any()).Return(nil)
@@ -148,7 +150,7 @@ var _ = Describe("Save cluster", func() {
 		dex := NewMockDexRepo(ctl)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
+		_, err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
 		Expect(err).ShouldNot(HaveOccurred())
 	})
 
@@ -161,12 +163,12 @@ var _ = Describe("Save cluster", func() {
 		secretRepo.EXPECT().SaveClusterConfig(gomock.Any(), param.Cluster.Name, gomock.Any()).Return(errors.New("failed to saved kubeconfig"))
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 		dex := NewMockDexRepo(ctl)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
+		_, err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -180,12 +182,12 @@ var _ = Describe("Save cluster", func() {
 		secretRepo.EXPECT().SaveClusterConfig(gomock.Any(), param.Cluster.Name, gomock.Any()).Return(nil)
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 		dex := NewMockDexRepo(ctl)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
+		_, err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -203,13 +205,13 @@ var _ = Describe("Save cluster", func() {
 		gitRepo := NewMockGitRepo(ctl)
 		gitRepo.EXPECT().Clone(gomock.Any(), clusterTemplateCloneParam).Return("", errors.New("failed to get cluster template repository"))
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 
 		dex := NewMockDexRepo(ctl)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
+		_, err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -231,13 +233,13 @@ var _ = Describe("Save cluster", func() {
 		gitRepo.EXPECT().Clone(gomock.Any(), clusterTemplateCloneParam).Return(clusterTemplateLocalPath, nil)
 		gitRepo.EXPECT().Clone(gomock.Any(), tenantConfigCloneParam).Return(tenantRepositoryLocalPath, errors.New("failed to get trnant config repository"))
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 
 		dex := NewMockDexRepo(ctl)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
+		_, err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -260,9 +262,10 @@ var _ = Describe("Save cluster", func() {
 		gitRepo.EXPECT().Clone(gomock.Any(), tenantConfigCloneParam).Return(tenantRepositoryLocalPath, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), tenantRepositoryLocalPath)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), tenantRepositoryLocalPath, gomock.Any())
+		gitRepo.EXPECT().Head(tenantRepositoryLocalPath).Return(headCommit, nil)
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 		clusteroperator.EXPECT().InitializeDependencies(gomock.Any()).Return(nil)
@@ -272,7 +275,7 @@ var _ = Describe("Save cluster", func() {
 		dex.EXPECT().UpdateRedirectURIs(gomock.Any()).Return(nil)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
+		_, err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
 		Expect(err).ShouldNot(HaveOccurred())
 	})
 
@@ -294,9 +297,10 @@ var _ = Describe("Save cluster", func() {
 		gitRepo.EXPECT().Clone(gomock.Any(), tenantConfigCloneParam).Return(tenantRepositoryLocalPath, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), tenantRepositoryLocalPath)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), tenantRepositoryLocalPath, gomock.Any())
+		gitRepo.EXPECT().Head(tenantRepositoryLocalPath).Return(headCommit, nil)
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 		clusteroperator.EXPECT().InitializeDependencies(gomock.Any()).Return(nil)
@@ -307,7 +311,7 @@ var _ = Describe("Save cluster", func() {
 		dex.EXPECT().UpdateRedirectURIs(gomock.Any()).Return(nil)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
+		_, err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
 		Expect(err).ShouldNot(HaveOccurred())
 	})
 
@@ -324,14 +328,14 @@ var _ = Describe("Save cluster", func() {
 		gitRepo := NewMockGitRepo(ctl)
 		gitRepo.EXPECT().Clone(gomock.Any(), clusterTemplateCloneParam).Return(clusterTemplateLocalPath, nil)
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 
 		dex := NewMockDexRepo(ctl)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
+		_, err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -350,7 +354,7 @@ var _ = Describe("Save cluster", func() {
 		gitRepo.EXPECT().Clone(gomock.Any(), clusterTemplateCloneParam).Return(clusterTemplateLocalPath, nil)
 		gitRepo.EXPECT().Clone(gomock.Any(), tenantConfigCloneParam).Return(tenantRepositoryLocalPath, nil)
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 		clusteroperator.EXPECT().InitializeDependencies(gomock.Any()).Return(nil)
@@ -359,7 +363,7 @@ var _ = Describe("Save cluster", func() {
 		dex := NewMockDexRepo(ctl)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
+		_, err := clusterusecase.SaveCluster(context.Background(), param, kubeconfig)
 		Expect(err).Should(HaveOccurred())
 	})
 })
@@ -397,7 +401,7 @@ var _ = Describe("Delete cluster", func() {
 			Email: _GitEmail,
 		}
 		tenant = &Project{
-			Id:                int32(22),
+			Id:                int64(22),
 			Name:              "repo-22",
 			Path:              "repo-22",
 			SshUrlToRepo:      tenantRepositoryHttpsURL,
@@ -424,9 +428,10 @@ var _ = Describe("Delete cluster", func() {
 		gitRepo.EXPECT().Clone(gomock.Any(), tenantConfigCloneParam).Return(tenantRepositoryLocalPath, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), tenantRepositoryLocalPath)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), tenantRepositoryLocalPath, gomock.Any())
+		gitRepo.EXPECT().Head(tenantRepositoryLocalPath).Return(headCommit, nil)
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 		clusteroperator.EXPECT().InitializeDependencies(gomock.Any()).Return(nil)
@@ -437,7 +442,7 @@ var _ = Describe("Delete cluster", func() {
 		dex.EXPECT().RemoveRedirectURIs(gomock.Any()).Return(nil)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.DeleteCluster(context.Background(), cluster.Name)
+		_, err := clusterusecase.DeleteCluster(context.Background(), cluster.Name)
 		Expect(err).ShouldNot(HaveOccurred())
 	})
 
@@ -457,7 +462,7 @@ var _ = Describe("Delete cluster", func() {
 		gitRepo.EXPECT().Clone(gomock.Any(), clusterTemplateCloneParam).Return(clusterTemplateLocalPath, nil)
 		gitRepo.EXPECT().Clone(gomock.Any(), tenantConfigCloneParam).Return(tenantRepositoryLocalPath, nil)
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 		clusteroperator.EXPECT().InitializeDependencies(gomock.Any()).Return(nil)
@@ -468,7 +473,7 @@ var _ = Describe("Delete cluster", func() {
 		dex.EXPECT().RemoveRedirectURIs(gomock.Any()).Return(nil)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.DeleteCluster(context.Background(), cluster.Name)
+		_, err := clusterusecase.DeleteCluster(context.Background(), cluster.Name)
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -486,18 +491,164 @@ var _ = Describe("Delete cluster", func() {
 		gitRepo.EXPECT().Clone(gomock.Any(), clusterTemplateCloneParam).Return(clusterTemplateLocalPath, nil)
 		gitRepo.EXPECT().Clone(gomock.Any(), tenantConfigCloneParam).Return(tenantRepositoryLocalPath, nil)
 
-		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 
 		clusteroperator := clusterregistration.NewMockClusterRegistrationOperator(ctl)
 
 		dex := NewMockDexRepo(ctl)
 
 		clusterusecase := NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, clusteroperator, dex)
-		err := clusterusecase.DeleteCluster(context.Background(), cluster.Name)
+		_, err := clusterusecase.DeleteCluster(context.Background(), cluster.Name)
 		Expect(err).Should(HaveOccurred())
 	})
 })
 
+var _ = Describe("Get cluster", func() {
+	var (
+		tenantRepositoryHttpsURL  = fmt.Sprintf("%s/dev-test-tenant/management.git", nautesConfigs.Git.Addr)
+		tenantRepositoryLocalPath string
+		tenantConfigCloneParam    = &CloneRepositoryParam{
+			URL:   tenantRepositoryHttpsURL,
+			User:  _GitUser,
+			Email: _GitEmail,
+		}
+		tenant = &Project{
+			Id:            int64(22),
+			Name:          "repo-22",
+			Path:          "repo-22",
+			HttpUrlToRepo: tenantRepositoryHttpsURL,
+		}
+		hostCluster = &resourcev1alpha1.Cluster{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: resourcev1alpha1.GroupVersion.String(),
+				Kind:       "Cluster",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "host216",
+				Namespace: nautesConfigs.Nautes.Namespace,
+			},
+			Spec: resourcev1alpha1.ClusterSpec{
+				ApiServer:   "https://10.204.118.216:6443",
+				ClusterType: resourcev1alpha1.ClusterType("physical"),
+				ClusterKind: resourcev1alpha1.ClusterKind("kubernetes"),
+				Usage:       resourcev1alpha1.ClusterUsage("host"),
+			},
+		}
+		virtualCluster = &resourcev1alpha1.Cluster{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: resourcev1alpha1.GroupVersion.String(),
+				Kind:       "Cluster",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vcluster-dev",
+				Namespace: nautesConfigs.Nautes.Namespace,
+			},
+			Spec: resourcev1alpha1.ClusterSpec{
+				ApiServer:   "https://vcluster-dev:8443",
+				ClusterType: resourcev1alpha1.ClusterType("virtual"),
+				ClusterKind: resourcev1alpha1.ClusterKind("kubernetes"),
+				Usage:       resourcev1alpha1.ClusterUsage("worker"),
+				HostCluster: "host216",
+			},
+		}
+		newClusterUsecase = func() *ClusterUsecase {
+			client := kubernetes.NewMockClient(ctl)
+			client.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			codeRepo := NewMockCodeRepo(ctl)
+			codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil).AnyTimes()
+			codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Any()).Return(tenant, nil)
+
+			gitRepo := NewMockGitRepo(ctl)
+			gitRepo.EXPECT().Clone(gomock.Any(), tenantConfigCloneParam).Return(tenantRepositoryLocalPath, nil)
+
+			resourceusecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nil, nautesConfigs, nil)
+			return NewClusterUsecase(logger, codeRepo, nil, resourceusecase, nautesConfigs, client, nil, nil)
+		}
+	)
+
+	BeforeEach(func() {
+		var err error
+		tenantRepositoryLocalPath, err = os.MkdirTemp("", "tenant")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		clustersDir := clusterregistration.GetClustersDir(tenantRepositoryLocalPath)
+		err = os.MkdirAll(clustersDir, 0755)
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, cluster := range []*resourcev1alpha1.Cluster{hostCluster, virtualCluster} {
+			bytes, err := yaml.Marshal(cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			err = os.WriteFile(fmt.Sprintf("%s/%s.yaml", clustersDir, cluster.Name), bytes, 0644)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		err = os.WriteFile(fmt.Sprintf("%s/%s", clustersDir, KustomizationFileName), []byte("resources:\n- host216.yaml\n"), 0644)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tenantRepositoryLocalPath)
+	})
+
+	It("will list clusters with the vclusters of host cluster", func() {
+		clusters, err := newClusterUsecase().ListClusters(context.Background())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(clusters).Should(HaveLen(2))
+		Expect(clusters[0].ClusterName).Should(Equal(hostCluster.Name))
+		Expect(clusters[0].Vclusters).Should(Equal([]string{virtualCluster.Name}))
+		Expect(clusters[1].HostCluster).Should(Equal(hostCluster.Name))
+	})
+
+	It("will get cluster successfully", func() {
+		cluster, err := newClusterUsecase().GetCluster(context.Background(), virtualCluster.Name)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(cluster).Should(Equal(&ClusterData{
+			ClusterName: virtualCluster.Name,
+			ApiServer:   virtualCluster.Spec.ApiServer,
+			ClusterKind: "kubernetes",
+			ClusterType: "virtual",
+			Usage:       "worker",
+			HostCluster: hostCluster.Name,
+		}))
+	})
+
+	It("will fail when cluster is not found", func() {
+		_, err := newClusterUsecase().GetCluster(context.Background(), "not-found")
+		Expect(err).Should(Equal(ErrorResourceNoFound))
+	})
+
+	It("will get host cluster with the vclusters it hosts", func() {
+		cluster, err := newClusterUsecase().GetCluster(context.Background(), hostCluster.Name)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(cluster.Vclusters).Should(Equal([]string{virtualCluster.Name}))
+	})
+
+	Context("with a cluster file that cannot be parsed", func() {
+		BeforeEach(func() {
+			clustersDir := clusterregistration.GetClustersDir(tenantRepositoryLocalPath)
+			err := os.WriteFile(fmt.Sprintf("%s/broken.yaml", clustersDir), []byte("kind: [Cluster"), 0644)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("will skip the file and list the other clusters", func() {
+			clusters, err := newClusterUsecase().ListClusters(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(clusters).Should(HaveLen(2))
+		})
+
+		It("will get cluster from its own file", func() {
+			cluster, err := newClusterUsecase().GetCluster(context.Background(), virtualCluster.Name)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cluster.ClusterName).Should(Equal(virtualCluster.Name))
+		})
+
+		It("will fail to get the cluster of that file", func() {
+			_, err := newClusterUsecase().GetCluster(context.Background(), "broken")
+			Expect(err).Should(HaveOccurred())
+			Expect(err).ShouldNot(Equal(ErrorResourceNoFound))
+		})
+	})
+})
+
 // Check if file exists and create if it does not exist
 func createFileIfNotExist(filename string) (*os.File, error) {
 	if _, err := os.Stat(filename); os.IsNotExist(err) {
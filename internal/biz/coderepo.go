@@ -137,7 +137,12 @@ func (c *CodeRepoUsecase) ListCodeRepos(ctx context.Context, productName string)
 		return nil, err
 	}
 
-	codeRepos, err := c.nodesToLists(*nodes)
+	return c.codeReposFromNodes(ctx, productName, *nodes)
+}
+
+// codeReposFromNodes converts the code repos in the nodes of the product, each with the repository it refers to.
+func (c *CodeRepoUsecase) codeReposFromNodes(ctx context.Context, productName string, nodes nodestree.Node) ([]*CodeRepoAndProject, error) {
+	codeRepos, err := c.nodesToLists(nodes)
 	if err != nil {
 		return nil, err
 	}
@@ -162,15 +167,20 @@ func (c *CodeRepoUsecase) ListCodeRepos(ctx context.Context, productName string)
 	return cps, nil
 }
 
-func (c *CodeRepoUsecase) SaveCodeRepo(ctx context.Context, options *BizOptions, data *CodeRepoData, gitOptions *GitCodeRepoOptions) error {
+func (c *CodeRepoUsecase) SaveCodeRepo(ctx context.Context, options *BizOptions, data *CodeRepoData, gitOptions *GitCodeRepoOptions) (*ChangeResult, error) {
 	group, err := c.codeRepo.GetGroup(ctx, options.ProductName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	project, err := c.saveRepository(ctx, group, options.ResouceName, gitOptions)
+	var project *Project
+	if options.DryRun {
+		project, err = c.getRepository(ctx, group, options.ResouceName)
+	} else {
+		project, err = c.saveRepository(ctx, group, options.ResouceName, gitOptions)
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	data.Spec.Product = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
@@ -179,20 +189,133 @@ func (c *CodeRepoUsecase) SaveCodeRepo(ctx context.Context, options *BizOptions,
 		resourceKind:      nodestree.CodeRepo,
 		productName:       options.ProductName,
 		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
 		operator:          c,
 	}
-	err = c.resourcesUsecase.Save(ctx, resourceOptions, data)
+	result, err := c.resourcesUsecase.Save(ctx, resourceOptions, data)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if options.DryRun {
+		return result, nil
 	}
 
 	pid := fmt.Sprintf("%s/%s", group.Path, options.ResouceName)
 	err = c.SaveDeployKey(ctx, pid, project)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return result, nil
+}
+
+// prepareApply creates or updates the repository of the code repo, in dry run mode the repository must exist already.
+func (c *CodeRepoUsecase) prepareApply(ctx context.Context, group *Group, options *BizOptions, resource *ApplyResource) (string, error) {
+	data, ok := resource.Data.(*CodeRepoData)
+	if !ok {
+		return "", ErrorInvalidApply.WithCause(fmt.Errorf("the data of code repo %s is invalid", resource.Name))
+	}
+
+	var project *Project
+	var err error
+	if options.DryRun {
+		project, err = c.getRepository(ctx, group, resource.Name)
+	} else {
+		_, err = c.codeRepo.GetCodeRepo(ctx, fmt.Sprintf("%s/%s", group.Path, resource.Name))
+		if err != nil && errors.FromError(err).Code != 404 {
+			return "", err
+		}
+		resource.repositoryCreated = err != nil
+		project, err = c.saveRepository(ctx, group, resource.Name, resource.GitOptions)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	resource.project = project
+	data.Spec.Product = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
+	data.Name = fmt.Sprintf("%s%d", _RepoPrefix, int(project.Id))
+
+	return data.Name, nil
+}
+
+func (c *CodeRepoUsecase) GetCodeRepoHistory(ctx context.Context, codeRepoName, productName string) ([]*Commit, error) {
+	group, err := c.codeRepo.GetGroup(ctx, productName)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := c.codeRepo.GetCodeRepo(ctx, fmt.Sprintf("%s/%s", group.Path, codeRepoName))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.resourcesUsecase.History(ctx, nodestree.CodeRepo, productName, func(nodes nodestree.Node) (string, error) {
+		return fmt.Sprintf("%s%d", _RepoPrefix, int(project.Id)), nil
+	})
+}
+
+// RollbackCodeRepo saves the code repo resource as it was in the revision,
+// the repository itself and its deploy key are left as they are.
+func (c *CodeRepoUsecase) RollbackCodeRepo(ctx context.Context, options *BizOptions, revision string) (*ChangeResult, error) {
+	options.CommitMessage = rollbackMessage(revision, options.CommitMessage)
+	group, err := c.codeRepo.GetGroup(ctx, options.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := c.codeRepo.GetCodeRepo(ctx, fmt.Sprintf("%s/%s", group.Path, options.ResouceName))
+	if err != nil {
+		return nil, err
+	}
+
+	resourceName := fmt.Sprintf("%s%d", _RepoPrefix, int(project.Id))
+	node, err := c.resourcesUsecase.GetRevision(ctx, nodestree.CodeRepo, options.ProductName, c, func(nodes nodestree.Node) (string, error) {
+		return resourceName, nil
+	}, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	codeRepo, err := c.nodeToResource(node)
+	if err != nil {
+		return nil, err
+	}
+
+	codeRepo.Spec.Product = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
+	resourceOptions := &resourceOptions{
+		resourceKind:      nodestree.CodeRepo,
+		productName:       options.ProductName,
+		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
+		operator:          c,
+	}
+
+	return c.resourcesUsecase.Save(ctx, resourceOptions, &CodeRepoData{
+		Name: resourceName,
+		Spec: codeRepo.Spec,
+	})
+}
+
+// getRepository returns the existing repository, the dry run cannot render a repository that has not been created yet.
+func (c *CodeRepoUsecase) getRepository(ctx context.Context, group *Group, resourceName string) (*Project, error) {
+	pid := fmt.Sprintf("%s/%s", group.Path, resourceName)
+	project, err := c.codeRepo.GetCodeRepo(ctx, pid)
+	if err != nil {
+		if e := errors.FromError(err); e.Code == 404 {
+			return nil, fmt.Errorf("the repository %s does not exist yet, it cannot be saved in dry run mode", resourceName)
+		}
+		return nil, err
+	}
+
+	return project, nil
 }
 
 func (c *CodeRepoUsecase) saveRepository(ctx context.Context, group *Group, resourceName string, gitOptions *GitCodeRepoOptions) (*Project, error) {
@@ -222,7 +345,7 @@ func (c *CodeRepoUsecase) GetDeployKeyFromSecretRepo(ctx context.Context, repoNa
 	gitType := c.config.Git.GitType
 	secretsEngine := SecretsEngine
 	secretsKey := SecretsKey
-	secretPath := fmt.Sprintf("%s/%s/%s/%s", gitType, repoName, "default", "readonly")
+	secretPath := deployKeySecretPath(gitType, repoName)
 	secretOptions := &SecretOptions{
 		SecretPath:   secretPath,
 		SecretEngine: secretsEngine,
@@ -237,6 +360,11 @@ func (c *CodeRepoUsecase) GetDeployKeyFromSecretRepo(ctx context.Context, repoNa
 	return deployKeySecretData, nil
 }
 
+// deployKeySecretPath is the path of the deploy key of a repository in the git secrets engine.
+func deployKeySecretPath(gitType nautesconfigs.GitType, repoName string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", gitType, repoName, "default", "readonly")
+}
+
 func (c *CodeRepoUsecase) SaveDeployKey(ctx context.Context, pid interface{}, project *Project) error {
 	repoName := fmt.Sprintf("%s%d", _RepoPrefix, int(project.Id))
 	secretData, err := c.GetDeployKeyFromSecretRepo(ctx, repoName)
@@ -478,26 +606,34 @@ func (c *CodeRepoUsecase) CreateResource(kind string) interface{} {
 	return &resourcev1alpha1.CodeRepo{}
 }
 
-func (c *CodeRepoUsecase) DeleteCodeRepo(ctx context.Context, options *BizOptions) error {
+func (c *CodeRepoUsecase) DeleteCodeRepo(ctx context.Context, options *BizOptions) (*ChangeResult, error) {
+	if options.Proposal && !options.DryRun {
+		return nil, fmt.Errorf("the code repository %s cannot be deleted through a change proposal, its repository would be removed before the proposal is merged", options.ResouceName)
+	}
+
 	group, err := c.codeRepo.GetGroup(ctx, options.ProductName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	projectPath := fmt.Sprintf("%s/%s", group.Path, options.ResouceName)
 	project, err := c.codeRepo.GetCodeRepo(ctx, projectPath)
 	e := errors.FromError(err)
 	if err != nil && e.Code != 404 {
-		return err
+		return nil, err
 	}
 
 	resourceOptions := &resourceOptions{
 		resourceKind:      nodestree.CodeRepo,
 		productName:       options.ProductName,
 		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
 		operator:          c,
 	}
-	err = c.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
+	result, err := c.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
 		if project != nil {
 			resourceName := fmt.Sprintf("%s%d", _RepoPrefix, int(project.Id))
 			return resourceName, nil
@@ -511,22 +647,26 @@ func (c *CodeRepoUsecase) DeleteCodeRepo(ctx context.Context, options *BizOption
 		return resourceName, nil
 	})
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if options.DryRun {
+		return result, nil
 	}
 
 	if err == nil {
 		err = c.codeRepo.DeleteCodeRepo(ctx, int(project.Id))
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		err = c.secretRepo.DeleteSecret(ctx, int(project.Id))
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
 func (e *CodeRepoUsecase) nodesToLists(nodes nodestree.Node) ([]*resourcev1alpha1.CodeRepo, error) {
@@ -540,8 +680,9 @@ func (e *CodeRepoUsecase) nodesToLists(nodes nodestree.Node) ([]*resourcev1alpha
 		}
 	}
 
+	// The directory is only created with the first code repo of the product.
 	if codeReposDir == nil {
-		return nil, fmt.Errorf("the %s directory is not exist", _CodeReposSubDir)
+		return resources, nil
 	}
 
 	for _, subNode := range codeReposDir.Children {
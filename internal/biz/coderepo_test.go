@@ -216,7 +216,7 @@ var _ = Describe("Save codeRepo", func() {
 
 	It("failed to get product info", testUseCase.GetProductFail(func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, nil)
-		err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
+		_, err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -225,7 +225,7 @@ var _ = Describe("Save codeRepo", func() {
 		codeRepo.EXPECT().UpdateCodeRepo(gomock.Any(), gomock.Eq(int(toSaveProject.Id)), options).Return(toSaveProject, nil)
 
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, nil)
-		err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
+		_, err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -241,7 +241,7 @@ var _ = Describe("Save codeRepo", func() {
 		client.EXPECT().List(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
 
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, client)
-		err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
+		_, err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
@@ -257,7 +257,7 @@ var _ = Describe("Save codeRepo", func() {
 		client.EXPECT().List(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
 
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, client)
-		err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
+		_, err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
@@ -273,7 +273,7 @@ var _ = Describe("Save codeRepo", func() {
 		client.EXPECT().List(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
 
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, client)
-		err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
+		_, err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
@@ -283,7 +283,7 @@ var _ = Describe("Save codeRepo", func() {
 		client.EXPECT().List(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
 
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, client)
-		err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
+		_, err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -294,7 +294,7 @@ var _ = Describe("Save codeRepo", func() {
 		client.EXPECT().List(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
 
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, client)
-		err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
+		_, err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -304,7 +304,7 @@ var _ = Describe("Save codeRepo", func() {
 		client.EXPECT().List(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
 
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, client)
-		err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
+		_, err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -314,7 +314,7 @@ var _ = Describe("Save codeRepo", func() {
 		client.EXPECT().List(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
 
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, client)
-		err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
+		_, err := biz.SaveCodeRepo(context.Background(), bizOptions, data, options)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -438,7 +438,7 @@ var _ = Describe("Delete codeRepo", func() {
 		secretRepo.EXPECT().DeleteSecret(gomock.Any(), gomock.Eq(int(deletedProject.Id))).Return(nil)
 
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, nil)
-		err := biz.DeleteCodeRepo(context.Background(), bizOptions)
+		_, err := biz.DeleteCodeRepo(context.Background(), bizOptions)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
@@ -446,7 +446,7 @@ var _ = Describe("Delete codeRepo", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(toGetCodeRepoPath)).Return(deletedProject, nil)
 
 		biz := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourceUseCase, nil)
-		err := biz.DeleteCodeRepo(context.Background(), bizOptions)
+		_, err := biz.DeleteCodeRepo(context.Background(), bizOptions)
 		Expect(err).Should(HaveOccurred())
 	}))
 })
@@ -0,0 +1,161 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+)
+
+// Dependency is a reference from a resource to another resource.
+type Dependency struct {
+	From *ProductResource
+	To   *ProductResource
+}
+
+// DependencyGraph is the references between the resources of a product.
+// Code repos are named by their repository names, clusters are referred to by environments but belong to the tenant.
+type DependencyGraph struct {
+	Resources    []*ProductResource
+	Dependencies []*Dependency
+}
+
+// GetDependencyGraph returns the references between the resources of the product.
+func (p *ProductUsecase) GetDependencyGraph(ctx context.Context, productName string) (*DependencyGraph, error) {
+	nodes, err := p.resourcesUsecase.List(ctx, productName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return dependencyGraph(nodes), nil
+}
+
+func dependencyGraph(nodes *nodestree.Node) *DependencyGraph {
+	repoNames := make(map[string]string)
+	for _, node := range nodestree.ListsResourceNodes(*nodes, nodestree.CodeRepo) {
+		if codeRepo, ok := node.Content.(*resourcev1alpha1.CodeRepo); ok {
+			repoNames[codeRepo.Name] = codeRepo.Spec.RepoName
+		}
+	}
+	repoName := func(name string) string {
+		if repoName, ok := repoNames[name]; ok {
+			return repoName
+		}
+		return name
+	}
+
+	graph := &DependencyGraph{}
+	for _, resource := range productResources(nodes) {
+		from := resource
+		if from.Kind == nodestree.CodeRepo {
+			from.Name = repoName(from.Name)
+		}
+		graph.Resources = append(graph.Resources, from)
+	}
+
+	add := func(from *ProductResource, kind string, names ...string) {
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+
+			graph.Dependencies = append(graph.Dependencies, &Dependency{
+				From: from,
+				To:   &ProductResource{Kind: kind, Name: name},
+			})
+		}
+	}
+
+	for _, node := range resourceNodes(nodes) {
+		switch resource := node.Content.(type) {
+		case *resourcev1alpha1.CodeRepo:
+			from := &ProductResource{Kind: nodestree.CodeRepo, Name: repoName(resource.Name)}
+			add(from, nodestree.Project, resource.Spec.Project)
+		case *resourcev1alpha1.ArtifactRepo:
+			from := &ProductResource{Kind: nodestree.ArtifactRepo, Name: resource.Name}
+			add(from, nodestree.Project, resource.Spec.Projects...)
+		case *resourcev1alpha1.Environment:
+			from := &ProductResource{Kind: nodestree.Enviroment, Name: resource.Name}
+			add(from, nodestree.Cluster, resource.Spec.Cluster)
+		case *resourcev1alpha1.DeploymentRuntime:
+			from := &ProductResource{Kind: nodestree.DeploymentRuntime, Name: resource.Name}
+			add(from, nodestree.Project, resource.Spec.ProjectsRef...)
+			add(from, nodestree.CodeRepo, repoName(resource.Spec.ManifestSource.CodeRepo))
+			add(from, nodestree.Enviroment, resource.Spec.Destination)
+		case *resourcev1alpha1.ProjectPipelineRuntime:
+			from := &ProductResource{Kind: nodestree.ProjectPipelineRuntime, Name: resource.Name}
+			add(from, nodestree.Project, resource.Spec.Project)
+			add(from, nodestree.CodeRepo, repoName(resource.Spec.PipelineSource))
+			for _, codeSource := range resource.Spec.CodeSources {
+				add(from, nodestree.CodeRepo, repoName(codeSource))
+			}
+			add(from, nodestree.Enviroment, resource.Spec.Destination)
+		}
+	}
+
+	return graph
+}
+
+// Dependents returns the part of the graph that refers to the resource, directly or through other resources,
+// these are the resources affected by changing or deleting it.
+func (g *DependencyGraph) Dependents(kind, name string) (*DependencyGraph, error) {
+	target := ProductResource{Kind: kind, Name: name}
+	if !g.contains(target) {
+		return nil, ErrorResourceNoFound
+	}
+
+	dependents := &DependencyGraph{}
+	visited := map[ProductResource]bool{target: true}
+	queue := []ProductResource{target}
+	for len(queue) > 0 {
+		to := queue[0]
+		queue = queue[1:]
+
+		for _, dependency := range g.Dependencies {
+			if *dependency.To != to {
+				continue
+			}
+
+			dependents.Dependencies = append(dependents.Dependencies, dependency)
+			if visited[*dependency.From] {
+				continue
+			}
+
+			visited[*dependency.From] = true
+			queue = append(queue, *dependency.From)
+			dependents.Resources = append(dependents.Resources, dependency.From)
+		}
+	}
+
+	return dependents, nil
+}
+
+func (g *DependencyGraph) contains(resource ProductResource) bool {
+	for _, r := range g.Resources {
+		if *r == resource {
+			return true
+		}
+	}
+
+	for _, dependency := range g.Dependencies {
+		if *dependency.To == resource {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Dependency graph", func() {
+	var (
+		project = &resourcev1alpha1.Project{
+			ObjectMeta: v1.ObjectMeta{Name: "project1"},
+		}
+		codeRepo = &resourcev1alpha1.CodeRepo{
+			ObjectMeta: v1.ObjectMeta{Name: "repo-1222"},
+			Spec:       resourcev1alpha1.CodeRepoSpec{Project: "project1", RepoName: "manifests"},
+		}
+		environment = &resourcev1alpha1.Environment{
+			ObjectMeta: v1.ObjectMeta{Name: "env1"},
+			Spec:       resourcev1alpha1.EnvironmentSpec{Cluster: "cluster1"},
+		}
+		deploymentRuntime = &resourcev1alpha1.DeploymentRuntime{
+			ObjectMeta: v1.ObjectMeta{Name: "dr1"},
+			Spec: resourcev1alpha1.DeploymentRuntimeSpec{
+				ProjectsRef:    []string{"project1"},
+				ManifestSource: resourcev1alpha1.ManifestSource{CodeRepo: "repo-1222"},
+				Destination:    "env1",
+			},
+		}
+		pipelineRuntime = &resourcev1alpha1.ProjectPipelineRuntime{
+			ObjectMeta: v1.ObjectMeta{Name: "pr1"},
+			Spec: resourcev1alpha1.ProjectPipelineRuntimeSpec{
+				Project:        "project1",
+				PipelineSource: "repo-1222",
+				Destination:    "env1",
+			},
+		}
+		nodes = &nodestree.Node{
+			IsDir: true,
+			Children: []*nodestree.Node{
+				{Kind: nodestree.Project, Content: project},
+				{IsDir: true, Children: []*nodestree.Node{{Kind: nodestree.CodeRepo, Content: codeRepo}}},
+				{Kind: nodestree.Enviroment, Content: environment},
+				{Kind: nodestree.DeploymentRuntime, Content: deploymentRuntime},
+				{Kind: nodestree.ProjectPipelineRuntime, Content: pipelineRuntime},
+			},
+		}
+	)
+
+	ref := func(kind, name string) *ProductResource {
+		return &ProductResource{Kind: kind, Name: name}
+	}
+
+	It("lists the references between the resources", func() {
+		graph := dependencyGraph(nodes)
+		Expect(graph.Resources).Should(Equal([]*ProductResource{
+			ref(nodestree.Project, "project1"),
+			ref(nodestree.CodeRepo, "manifests"),
+			ref(nodestree.Enviroment, "env1"),
+			ref(nodestree.DeploymentRuntime, "dr1"),
+			ref(nodestree.ProjectPipelineRuntime, "pr1"),
+		}))
+		Expect(graph.Dependencies).Should(ConsistOf(
+			&Dependency{From: ref(nodestree.CodeRepo, "manifests"), To: ref(nodestree.Project, "project1")},
+			&Dependency{From: ref(nodestree.Enviroment, "env1"), To: ref(nodestree.Cluster, "cluster1")},
+			&Dependency{From: ref(nodestree.DeploymentRuntime, "dr1"), To: ref(nodestree.Project, "project1")},
+			&Dependency{From: ref(nodestree.DeploymentRuntime, "dr1"), To: ref(nodestree.CodeRepo, "manifests")},
+			&Dependency{From: ref(nodestree.DeploymentRuntime, "dr1"), To: ref(nodestree.Enviroment, "env1")},
+			&Dependency{From: ref(nodestree.ProjectPipelineRuntime, "pr1"), To: ref(nodestree.Project, "project1")},
+			&Dependency{From: ref(nodestree.ProjectPipelineRuntime, "pr1"), To: ref(nodestree.CodeRepo, "manifests")},
+			&Dependency{From: ref(nodestree.ProjectPipelineRuntime, "pr1"), To: ref(nodestree.Enviroment, "env1")},
+		))
+	})
+
+	It("looks up the resources affected by a cluster through its environments", func() {
+		dependents, err := dependencyGraph(nodes).Dependents(nodestree.Cluster, "cluster1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(dependents.Resources).Should(ConsistOf(
+			ref(nodestree.Enviroment, "env1"),
+			ref(nodestree.DeploymentRuntime, "dr1"),
+			ref(nodestree.ProjectPipelineRuntime, "pr1"),
+		))
+		Expect(dependents.Dependencies).Should(HaveLen(3))
+	})
+
+	It("looks up the resources using a code repo by its repository name", func() {
+		dependents, err := dependencyGraph(nodes).Dependents(nodestree.CodeRepo, "manifests")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(dependents.Resources).Should(ConsistOf(
+			ref(nodestree.DeploymentRuntime, "dr1"),
+			ref(nodestree.ProjectPipelineRuntime, "pr1"),
+		))
+	})
+
+	It("fails to look up a resource that is not in the graph", func() {
+		_, err := dependencyGraph(nodes).Dependents(nodestree.Enviroment, "env2")
+		Expect(err).Should(Equal(ErrorResourceNoFound))
+	})
+})
@@ -0,0 +1,140 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	commonv1 "github.com/nautes-labs/api-server/api/common/v1"
+	gitlabclient "github.com/nautes-labs/api-server/pkg/gitlab"
+	utilkey "github.com/nautes-labs/api-server/util/key"
+)
+
+// DeployKeyRotation is the result of rotating the deploy key of a code repo.
+type DeployKeyRotation struct {
+	ProductName string
+	// CodeRepoName is the name of the repository, it is empty when the code repos of the product cannot be listed.
+	CodeRepoName string
+	// DeployKeyID is the ID of the new deploy key.
+	DeployKeyID int
+	Err         error
+}
+
+// RotateDeployKey replaces the deploy key of the code repo with a new one.
+func (c *CodeRepoUsecase) RotateDeployKey(ctx context.Context, productName, codeRepoName string) (*DeployKeyRotation, error) {
+	group, err := c.codeRepo.GetGroup(ctx, productName)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := c.codeRepo.GetCodeRepo(ctx, fmt.Sprintf("%s/%s", group.Path, codeRepoName))
+	if err != nil {
+		return nil, err
+	}
+
+	deployKeyID, err := c.rotateDeployKey(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeployKeyRotation{ProductName: productName, CodeRepoName: codeRepoName, DeployKeyID: deployKeyID}, nil
+}
+
+// RotateProductDeployKeys replaces the deploy keys of all the code repos of the product,
+// a failed repository is reported in its result and does not stop the others.
+func (c *CodeRepoUsecase) RotateProductDeployKeys(ctx context.Context, productName string) ([]*DeployKeyRotation, error) {
+	codeRepos, err := c.ListCodeRepos(ctx, productName)
+	if err != nil {
+		return nil, err
+	}
+
+	rotations := make([]*DeployKeyRotation, 0, len(codeRepos))
+	for _, codeRepo := range codeRepos {
+		rotation := &DeployKeyRotation{ProductName: productName, CodeRepoName: codeRepo.Project.Path}
+		rotation.DeployKeyID, rotation.Err = c.rotateDeployKey(ctx, codeRepo.Project)
+		if rotation.Err != nil {
+			c.log.Errorf("failed to rotate the deploy key of repository %s of product %s, err: %v", rotation.CodeRepoName, productName, rotation.Err)
+		}
+		rotations = append(rotations, rotation)
+	}
+
+	return rotations, nil
+}
+
+// RotateTenantDeployKeys replaces the deploy keys of the code repos of all the products the user can read.
+// The groups without a default project are not products and are skipped.
+func (c *CodeRepoUsecase) RotateTenantDeployKeys(ctx context.Context) ([]*DeployKeyRotation, error) {
+	groups, err := c.codeRepo.ListAllGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rotations []*DeployKeyRotation
+	for _, group := range groups {
+		_, _, err := c.resourcesUsecase.GetProductAndCodeRepo(ctx, group.Name)
+		if commonv1.IsProjectNotFound(err) {
+			continue
+		}
+
+		var productRotations []*DeployKeyRotation
+		if err == nil {
+			productRotations, err = c.RotateProductDeployKeys(ctx, group.Name)
+		}
+		if err != nil {
+			c.log.Errorf("failed to rotate the deploy keys of product %s, err: %v", group.Name, err)
+			rotations = append(rotations, &DeployKeyRotation{ProductName: group.Name, Err: err})
+			continue
+		}
+
+		rotations = append(rotations, productRotations...)
+	}
+
+	return rotations, nil
+}
+
+// rotateDeployKey adds a new deploy key to the repository and saves it to the secret repo before the old keys are removed,
+// so that the key in the secret repo is valid at any time.
+func (c *CodeRepoUsecase) rotateDeployKey(ctx context.Context, project *Project) (int, error) {
+	publicKey, privateKey, err := utilkey.GenerateKeyPair(c.config.Git.DefaultDeployKeyType)
+	if err != nil {
+		return 0, err
+	}
+
+	projectDeployKey, err := c.codeRepo.SaveDeployKey(ctx, publicKey, project)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add the new deploy key, err: %w", err)
+	}
+
+	extendKVs := make(map[string]string)
+	extendKVs[gitlabclient.FINGERPRINT] = projectDeployKey.Key
+	extendKVs[gitlabclient.DEPLOYID] = strconv.Itoa(projectDeployKey.ID)
+	err = c.secretRepo.SaveDeployKey(ctx, int(project.Id), string(privateKey), extendKVs)
+	if err != nil {
+		deleteErr := c.codeRepo.DeleteDeployKey(ctx, int(project.Id), projectDeployKey.ID)
+		if deleteErr != nil {
+			c.log.Errorf("failed to delete the new deploy key %d of repository %s, err: %v", projectDeployKey.ID, project.Path, deleteErr)
+		}
+		return 0, fmt.Errorf("failed to save the new deploy key, the old deploy key is kept, err: %w", err)
+	}
+
+	err = c.removeInvalidDeploykey(ctx, project, projectDeployKey.ID)
+	if err != nil {
+		return 0, fmt.Errorf("the new deploy key is saved but the old deploy keys cannot be removed, err: %w", err)
+	}
+
+	return projectDeployKey.ID, nil
+}
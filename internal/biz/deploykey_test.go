@@ -0,0 +1,96 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rotate deploy keys", func() {
+	var (
+		codeRepo   *MockCodeRepo
+		secretRepo *MockSecretrepo
+		gitRepo    *MockGitRepo
+		nodesTree  *nodestree.MockNodesTree
+		repoName   = "repo1"
+		repo       = &Project{Id: 1222, Path: repoName}
+		oldKey     = &ProjectDeployKey{ID: 1, Key: "old-fingerprint"}
+		newKey     = &ProjectDeployKey{ID: 2, Key: "new-fingerprint"}
+	)
+
+	newCodeRepoUsecase := func() *CodeRepoUsecase {
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nodesTree, nautesConfigs, nil)
+		return NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodesTree, nautesConfigs, resourcesUsecase, nil)
+	}
+
+	BeforeEach(func() {
+		codeRepo = NewMockCodeRepo(ctl)
+		secretRepo = NewMockSecretrepo(ctl)
+		gitRepo = NewMockGitRepo(ctl)
+		nodesTree = nodestree.NewMockNodesTree(ctl)
+		nodesTree.EXPECT().AppendOperators(gomock.Any()).AnyTimes()
+	})
+
+	expectRepository := func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), fmt.Sprintf("%s/%s", defaultProductGroup.Path, repoName)).Return(repo, nil)
+	}
+
+	It("saves the new key before the old keys are removed", func() {
+		expectRepository()
+		gomock.InOrder(
+			codeRepo.EXPECT().SaveDeployKey(gomock.Any(), gomock.Any(), repo).Return(newKey, nil),
+			secretRepo.EXPECT().SaveDeployKey(gomock.Any(), int(repo.Id), gomock.Any(), gomock.Any()).Return(nil),
+			codeRepo.EXPECT().ListDeployKeys(gomock.Any(), int(repo.Id), gomock.Any()).Return([]*ProjectDeployKey{oldKey, newKey}, nil),
+			codeRepo.EXPECT().DeleteDeployKey(gomock.Any(), int(repo.Id), oldKey.ID).Return(nil),
+		)
+
+		rotation, err := newCodeRepoUsecase().RotateDeployKey(context.Background(), defaultGroupName, repoName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rotation.CodeRepoName).Should(Equal(repoName))
+		Expect(rotation.DeployKeyID).Should(Equal(newKey.ID))
+	})
+
+	It("keeps the old key when the new key cannot be saved", func() {
+		expectRepository()
+		codeRepo.EXPECT().SaveDeployKey(gomock.Any(), gomock.Any(), repo).Return(newKey, nil)
+		secretRepo.EXPECT().SaveDeployKey(gomock.Any(), int(repo.Id), gomock.Any(), gomock.Any()).Return(fmt.Errorf("vault is sealed"))
+		codeRepo.EXPECT().DeleteDeployKey(gomock.Any(), int(repo.Id), newKey.ID).Return(nil)
+
+		_, err := newCodeRepoUsecase().RotateDeployKey(context.Background(), defaultGroupName, repoName)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("skips the groups that are not products and reports the products that fail", func() {
+		notProduct := &Group{Id: 1, Name: "not-product", Path: "not-product"}
+		brokenProduct := &Group{Id: 2, Name: "broken-product", Path: "broken-product"}
+		codeRepo.EXPECT().ListAllGroups(gomock.Any()).Return([]*Group{notProduct, brokenProduct}, nil)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), notProduct.Name).Return(notProduct, nil)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), fmt.Sprintf("%s/%s", notProduct.Path, nautesConfigs.Git.DefaultProductName)).Return(nil, ErrorProjectNotFound)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), brokenProduct.Name).Return(nil, ErrorGroupNotFound)
+
+		rotations, err := newCodeRepoUsecase().RotateTenantDeployKeys(context.Background())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rotations).Should(HaveLen(1))
+		Expect(rotations[0].ProductName).Should(Equal(brokenProduct.Name))
+		Expect(ErrorGroupNotFound.Is(rotations[0].Err)).Should(BeTrue())
+	})
+})
@@ -104,20 +104,25 @@ func (d *DeploymentRuntimeUsecase) GetDeploymentRuntime(ctx context.Context, dep
 }
 
 func (d *DeploymentRuntimeUsecase) ListDeploymentRuntimes(ctx context.Context, productName string) ([]*resourcev1alpha1.DeploymentRuntime, error) {
-	var runtimes []*resourcev1alpha1.DeploymentRuntime
-
 	resourceNodes, err := d.resourcesUsecase.List(ctx, productName, d)
 	if err != nil {
 		return nil, err
 	}
 
-	nodes := nodestree.ListsResourceNodes(*resourceNodes, nodestree.DeploymentRuntime)
+	return d.deploymentRuntimesFromNodes(ctx, *resourceNodes)
+}
+
+// deploymentRuntimesFromNodes converts the deployment runtimes in the nodes of the product.
+func (d *DeploymentRuntimeUsecase) deploymentRuntimesFromNodes(ctx context.Context, resourceNodes nodestree.Node) ([]*resourcev1alpha1.DeploymentRuntime, error) {
+	var runtimes []*resourcev1alpha1.DeploymentRuntime
+
+	nodes := nodestree.ListsResourceNodes(resourceNodes, nodestree.DeploymentRuntime)
 	for _, node := range nodes {
 		if node.Kind == nodestree.DeploymentRuntime && !node.IsDir {
 			runtime, ok := node.Content.(*resourcev1alpha1.DeploymentRuntime)
 			if ok {
 
-				err = d.convertCodeRepoToRepoName(ctx, runtime)
+				err := d.convertCodeRepoToRepoName(ctx, runtime)
 				if err != nil {
 					return nil, err
 				}
@@ -135,34 +140,96 @@ func (d *DeploymentRuntimeUsecase) ListDeploymentRuntimes(ctx context.Context, p
 	return runtimes, nil
 }
 
-func (d *DeploymentRuntimeUsecase) SaveDeploymentRuntime(ctx context.Context, options *BizOptions, data *DeploymentRuntimeData) error {
+func (d *DeploymentRuntimeUsecase) SaveDeploymentRuntime(ctx context.Context, options *BizOptions, data *DeploymentRuntimeData) (*ChangeResult, error) {
 	group, err := d.codeRepo.GetGroup(ctx, options.ProductName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	pid := fmt.Sprintf("%s/%s", group.Path, data.Spec.ManifestSource.CodeRepo)
-	project, err := d.codeRepo.GetCodeRepo(ctx, pid)
+	err = d.convertReferences(ctx, group, data)
 	if err != nil {
-		return fmt.Errorf("the referenced code repository %s does not exist", data.Spec.ManifestSource.CodeRepo)
+		return nil, err
 	}
 
-	data.Spec.ManifestSource.CodeRepo = fmt.Sprintf("%s%d", _RepoPrefix, int(project.Id))
-	data.Spec.Product = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
 	resourceOptions := &resourceOptions{
 		resourceKind:      nodestree.DeploymentRuntime,
 		productName:       options.ProductName,
 		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
 		operator:          d,
 	}
-	err = d.resourcesUsecase.Save(ctx, resourceOptions, data)
+	result, err := d.resourcesUsecase.Save(ctx, resourceOptions, data)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// convertReferences replaces the product and code repo names of the deployment runtime with their resource names.
+func (d *DeploymentRuntimeUsecase) convertReferences(ctx context.Context, group *Group, data *DeploymentRuntimeData) error {
+	pid := fmt.Sprintf("%s/%s", group.Path, data.Spec.ManifestSource.CodeRepo)
+	project, err := d.codeRepo.GetCodeRepo(ctx, pid)
+	if err != nil {
+		return fmt.Errorf("the referenced code repository %s does not exist", data.Spec.ManifestSource.CodeRepo)
 	}
 
+	data.Spec.ManifestSource.CodeRepo = fmt.Sprintf("%s%d", _RepoPrefix, int(project.Id))
+	data.Spec.Product = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
+
 	return nil
 }
 
+func (d *DeploymentRuntimeUsecase) prepareApply(ctx context.Context, group *Group, options *BizOptions, resource *ApplyResource) (string, error) {
+	data, ok := resource.Data.(*DeploymentRuntimeData)
+	if !ok {
+		return "", ErrorInvalidApply.WithCause(fmt.Errorf("the data of deployment runtime %s is invalid", resource.Name))
+	}
+
+	err := d.convertReferences(ctx, group, data)
+	if err != nil {
+		return "", err
+	}
+
+	return data.Name, nil
+}
+
+func (d *DeploymentRuntimeUsecase) GetDeploymentRuntimeHistory(ctx context.Context, deploymentRuntimeName, productName string) ([]*Commit, error) {
+	return d.resourcesUsecase.History(ctx, nodestree.DeploymentRuntime, productName, func(nodes nodestree.Node) (string, error) {
+		return deploymentRuntimeName, nil
+	})
+}
+
+// RollbackDeploymentRuntime saves the deployment runtime as it was in the revision,
+// the code repo it references must still exist.
+func (d *DeploymentRuntimeUsecase) RollbackDeploymentRuntime(ctx context.Context, options *BizOptions, revision string) (*ChangeResult, error) {
+	options.CommitMessage = rollbackMessage(revision, options.CommitMessage)
+	node, err := d.resourcesUsecase.GetRevision(ctx, nodestree.DeploymentRuntime, options.ProductName, d, func(nodes nodestree.Node) (string, error) {
+		return options.ResouceName, nil
+	}, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, ok := node.Content.(*resourcev1alpha1.DeploymentRuntime)
+	if !ok {
+		return nil, fmt.Errorf("the resource type of %s is inconsistent", options.ResouceName)
+	}
+
+	err = d.convertCodeRepoToRepoName(ctx, runtime)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.SaveDeploymentRuntime(ctx, options, &DeploymentRuntimeData{
+		Name: runtime.Name,
+		Spec: runtime.Spec,
+	})
+}
+
 func (e *DeploymentRuntimeUsecase) CreateNode(path string, data interface{}) (*nodestree.Node, error) {
 	val, ok := data.(*DeploymentRuntimeData)
 	if !ok {
@@ -285,20 +352,24 @@ func (d *DeploymentRuntimeUsecase) CreateResource(kind string) interface{} {
 	return &resourcev1alpha1.DeploymentRuntime{}
 }
 
-func (d *DeploymentRuntimeUsecase) DeleteDeploymentRuntime(ctx context.Context, options *BizOptions) error {
+func (d *DeploymentRuntimeUsecase) DeleteDeploymentRuntime(ctx context.Context, options *BizOptions) (*ChangeResult, error) {
 	resourceOptions := &resourceOptions{
 		resourceKind:      nodestree.DeploymentRuntime,
 		productName:       options.ProductName,
 		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
 		operator:          d,
 	}
-	err := d.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
+	result, err := d.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
 		return options.ResouceName, nil
 	})
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return result, nil
 }
@@ -141,7 +141,7 @@ var _ = Describe("List deployment runtimes", func() {
 		in := nodestree.NewMockNodesTree(ctl)
 		in.EXPECT().AppendOperators(gomock.Any())
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, in, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, in, nautesConfigs, nil)
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, in, resourcesUsecase)
 		_, err := biz.ListDeploymentRuntimes(ctx, defaultGroupName)
 		Expect(err).Should(HaveOccurred())
@@ -176,21 +176,21 @@ var _ = Describe("Save deployment runtime", func() {
 
 	It("failed to get product info", testUseCase.GetProductFail(func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
+		_, err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("failed to get default project info", testUseCase.GetDefaultProjectFail(func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), pid).Return(project, nil)
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
+		_, err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("will created successfully", testUseCase.CreateResourceSuccess(fakeNodes, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), pid).Return(project, nil)
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
+		_, err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
@@ -198,7 +198,7 @@ var _ = Describe("Save deployment runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), pid).Return(project, nil)
 
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
+		_, err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
@@ -206,7 +206,7 @@ var _ = Describe("Save deployment runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), pid).Return(project, nil)
 
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
+		_, err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
@@ -214,7 +214,7 @@ var _ = Describe("Save deployment runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), pid).Return(project, nil)
 
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
+		_, err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -222,7 +222,7 @@ var _ = Describe("Save deployment runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), pid).Return(project, nil)
 
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
+		_, err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
 		Expect(err).Should(HaveOccurred())
 	}))
 	It("auto merge code push successed and retry three times when the remote code changes", func() {
@@ -247,14 +247,14 @@ var _ = Describe("Save deployment runtime", func() {
 
 		in := nodestree.NewMockNodesTree(ctl)
 		in.EXPECT().AppendOperators(gomock.Any())
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, in, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, in, nautesConfigs, nil)
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, in, resourcesUsecase)
 		in.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(emptyNodes, nil)
 		in.EXPECT().Compare(gomock.Any()).Return(nil).AnyTimes()
 		in.EXPECT().GetNode(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeNode)
 		in.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&fakeNodes, nil)
 
-		err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
+		_, err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -262,7 +262,7 @@ var _ = Describe("Save deployment runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), pid).Return(project, nil)
 
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
+		_, err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -270,7 +270,7 @@ var _ = Describe("Save deployment runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), pid).Return(project, nil)
 
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
+		_, err := biz.SaveDeploymentRuntime(context.Background(), bizOptions, deploymentRuntimeData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -378,13 +378,13 @@ var _ = Describe("Delete deployment runtime", func() {
 
 	It("will deleted successfully", testUseCase.DeleteResourceSuccess(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.DeleteDeploymentRuntime(context.Background(), bizOptions)
+		_, err := biz.DeleteDeploymentRuntime(context.Background(), bizOptions)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
 	It("modify resource but non compliant layout standards", testUseCase.DeleteResourceErrorLayout(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewDeploymentRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.DeleteDeploymentRuntime(context.Background(), bizOptions)
+		_, err := biz.DeleteDeploymentRuntime(context.Background(), bizOptions)
 		Expect(err).Should(HaveOccurred())
 	}))
 })
@@ -94,7 +94,12 @@ func (e *EnvironmentUsecase) ListEnvironments(ctx context.Context, productName s
 		return nil, err
 	}
 
-	envs, err := e.nodesToLists(*nodes)
+	return e.environmentsFromNodes(ctx, *nodes)
+}
+
+// environmentsFromNodes converts the environments in the nodes of the product.
+func (e *EnvironmentUsecase) environmentsFromNodes(ctx context.Context, nodes nodestree.Node) ([]*resourcev1alpha1.Environment, error) {
+	envs, err := e.nodesToLists(nodes)
 	if err != nil {
 		return nil, err
 	}
@@ -120,8 +125,9 @@ func (e *EnvironmentUsecase) nodesToLists(nodes nodestree.Node) ([]*resourcev1al
 		}
 	}
 
+	// The directory is only created with the first environment of the product.
 	if resourcesSubDir == nil {
-		return nil, ErrorNodetNotFound
+		return resources, nil
 	}
 
 	for _, node := range resourcesSubDir.Children {
@@ -145,10 +151,10 @@ func (e *EnvironmentUsecase) nodeToResource(node *nodestree.Node) (*resourcev1al
 	return env, nil
 }
 
-func (e *EnvironmentUsecase) SaveEnvironment(ctx context.Context, options *BizOptions, data *EnviromentData) error {
+func (e *EnvironmentUsecase) SaveEnvironment(ctx context.Context, options *BizOptions, data *EnviromentData) (*ChangeResult, error) {
 	group, err := e.codeRepo.GetGroup(ctx, options.ProductName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	data.Spec.Product = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
@@ -156,14 +162,56 @@ func (e *EnvironmentUsecase) SaveEnvironment(ctx context.Context, options *BizOp
 		resourceKind:      nodestree.Enviroment,
 		productName:       options.ProductName,
 		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
 		operator:          e,
 	}
-	err = e.resourcesUsecase.Save(ctx, resourceOptions, data)
+	result, err := e.resourcesUsecase.Save(ctx, resourceOptions, data)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return result, nil
+}
+
+func (e *EnvironmentUsecase) prepareApply(ctx context.Context, group *Group, options *BizOptions, resource *ApplyResource) (string, error) {
+	data, ok := resource.Data.(*EnviromentData)
+	if !ok {
+		return "", ErrorInvalidApply.WithCause(fmt.Errorf("the data of environment %s is invalid", resource.Name))
+	}
+
+	data.Spec.Product = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
+
+	return data.Name, nil
+}
+
+func (e *EnvironmentUsecase) GetEnvironmentHistory(ctx context.Context, enviromentName, productName string) ([]*Commit, error) {
+	return e.resourcesUsecase.History(ctx, nodestree.Enviroment, productName, func(nodes nodestree.Node) (string, error) {
+		return enviromentName, nil
+	})
+}
+
+// RollbackEnvironment saves the environment as it was in the revision.
+func (e *EnvironmentUsecase) RollbackEnvironment(ctx context.Context, options *BizOptions, revision string) (*ChangeResult, error) {
+	options.CommitMessage = rollbackMessage(revision, options.CommitMessage)
+	node, err := e.resourcesUsecase.GetRevision(ctx, nodestree.Enviroment, options.ProductName, e, func(nodes nodestree.Node) (string, error) {
+		return options.ResouceName, nil
+	}, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := e.nodeToResource(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.SaveEnvironment(ctx, options, &EnviromentData{
+		Name: env.Name,
+		Spec: env.Spec,
+	})
 }
 
 func (e *EnvironmentUsecase) CreateNode(path string, data interface{}) (*nodestree.Node, error) {
@@ -264,21 +312,25 @@ func (e *EnvironmentUsecase) CreateResource(kind string) interface{} {
 	return &resourcev1alpha1.Environment{}
 }
 
-func (e *EnvironmentUsecase) DeleteEnvironment(ctx context.Context, options *BizOptions) error {
+func (e *EnvironmentUsecase) DeleteEnvironment(ctx context.Context, options *BizOptions) (*ChangeResult, error) {
 	resourceOptions := &resourceOptions{
 		resourceKind:      nodestree.Enviroment,
 		productName:       options.ProductName,
 		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
 		operator:          e,
 	}
-	err := e.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
+	result, err := e.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
 		return options.ResouceName, nil
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return result, nil
 }
 
 func (e *EnvironmentUsecase) compare(nodes nodestree.Node) (bool, error) {
@@ -154,55 +154,75 @@ var _ = Describe("Save environment", func() {
 
 	It("failed to get product info", testUseCase.GetProductFail(func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
+		_, err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("failed to get default project info", testUseCase.GetDefaultProjectFail(func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
+		_, err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("will created successfully", testUseCase.CreateResourceSuccess(fakeNodes, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
+		_, err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
 	It("will updated successfully", testUseCase.UpdateResoureSuccess(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
+		result, err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
 		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Commit).Should(Equal(headCommit))
+		Expect(result.Branch).Should(Equal(_MainBranch))
+		Expect(result.CommitURL).Should(Equal(defautlProject.WebUrl + "/-/commit/" + headCommit))
+		Expect(result.AutoMerged).Should(BeFalse())
+	}))
+
+	It("will render the changes without pushing in dry run mode", testUseCase.UpdateResourceDryRun(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
+		options := &BizOptions{
+			ResouceName: resourceName,
+			ProductName: defaultGroupName,
+			DryRun:      true,
+		}
+		result, err := biz.SaveEnvironment(context.Background(), options, enviromentData)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.DryRun).Should(BeTrue())
+		Expect(result.Diff).Should(Equal(dryRunDiff))
+		Expect(result.Yaml).Should(ContainSubstring("cluster: test-cluster"))
 	}))
 
 	It("auto merge conflict, updated successfully", testUseCase.UpdateResourceAndAutoMerge(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
+		result, err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
 		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Commit).Should(Equal(headCommit))
+		Expect(result.AutoMerged).Should(BeTrue())
 	}))
 
 	It("failed to auto merge conflict", testUseCase.MergeConflictFail(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
+		_, err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("failed to push code retry three times", testUseCase.CreateResourceAndAutoRetry(fakeNodes, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
+		_, err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("modify resource but non compliant layout", testUseCase.UpdateResourceButNotConformTemplate(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
+		_, err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("failed to save config", testUseCase.SaveConfigFail(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
+		_, err := biz.SaveEnvironment(context.Background(), bizOptions, enviromentData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -257,13 +277,160 @@ var _ = Describe("Delete environment", func() {
 
 	It("will deleted successfully", testUseCase.DeleteResourceSuccess(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.DeleteEnvironment(context.Background(), bizOptions)
+		_, err := biz.DeleteEnvironment(context.Background(), bizOptions)
+		Expect(err).ShouldNot(HaveOccurred())
+	}))
+
+	It("will render the deletion without pushing in dry run mode", testUseCase.DeleteResourceDryRun(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
+		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
+		options := &BizOptions{
+			ResouceName: resourceName,
+			ProductName: defaultGroupName,
+			DryRun:      true,
+		}
+		result, err := biz.DeleteEnvironment(context.Background(), options)
 		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.DryRun).Should(BeTrue())
+		Expect(result.Diff).Should(Equal(dryRunDiff))
+		Expect(result.Yaml).Should(BeEmpty())
 	}))
 
 	It("modify resource but non compliant layout standards", testUseCase.DeleteResourceErrorLayout(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodestree, resourceUseCase)
-		err := biz.DeleteEnvironment(context.Background(), bizOptions)
+		_, err := biz.DeleteEnvironment(context.Background(), bizOptions)
 		Expect(err).Should(HaveOccurred())
 	}))
 })
+
+var _ = Describe("Environment history", func() {
+	var (
+		resourceName = "env1"
+		fakeNode     *nodestree.Node
+		fakeNodes    nodestree.Node
+		codeRepo     *MockCodeRepo
+		gitRepo      *MockGitRepo
+		nodesTree    *nodestree.MockNodesTree
+		commits      = []*Commit{
+			{SHA: "2a0f0c5", Author: _GitUser, Message: "api: saved configuration file"},
+			{SHA: "b3e1c4a", Author: _GitUser, Message: "api: saved configuration file"},
+		}
+	)
+
+	BeforeEach(func() {
+		fakeNode = createEnvironmentNode(createEnvironmentResource(resourceName))
+		fakeNodes = createContainEnvironmentNodes(fakeNode)
+
+		codeRepo = NewMockCodeRepo(ctl)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil).AnyTimes()
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil).AnyTimes()
+
+		gitRepo = NewMockGitRepo(ctl)
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil).AnyTimes()
+
+		nodesTree = nodestree.NewMockNodesTree(ctl)
+		nodesTree.EXPECT().AppendOperators(gomock.Any())
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(fakeNodes, nil).AnyTimes()
+		nodesTree.EXPECT().GetNode(gomock.Any(), nodestree.Enviroment, gomock.Any()).Return(fakeNode).AnyTimes()
+	})
+
+	It("will list the commits of the resource file", func() {
+		gitRepo.EXPECT().Log(gomock.Any(), localRepositaryPath, _EnvSubDir+"/env1.yaml").Return(commits, nil)
+
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodesTree, nautesConfigs, nil)
+		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodesTree, resourcesUsecase)
+		result, err := biz.GetEnvironmentHistory(ctx, resourceName, defaultGroupName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).Should(Equal(commits))
+	})
+
+	It("will get the resource of a revision without validating it", func() {
+		id, _ := utilstrings.ExtractNumber("product-", fakeNode.Content.(*resourcev1alpha1.Environment).Spec.Product)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), id).Return(defaultProductGroup, nil)
+		gitRepo.EXPECT().Checkout(gomock.Any(), localRepositaryPath, commits[1].SHA).Return(nil)
+
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodesTree, nautesConfigs, nil)
+		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodesTree, resourcesUsecase)
+		result, err := biz.GetEnvironment(WithRevision(ctx, commits[1].SHA), resourceName, defaultGroupName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Name).Should(Equal(resourceName))
+	})
+
+	It("will save the resource of the revision", func() {
+		content := "apiVersion: nautes.resource.nautes.io/v1alpha1\nkind: Environment\nmetadata:\n  name: env1\nspec:\n  product: product-1\n  cluster: old-cluster\n  envType: host\n"
+		gitRepo.EXPECT().Show(gomock.Any(), localRepositaryPath, commits[1].SHA, _EnvSubDir+"/env1.yaml").Return([]byte(content), nil)
+		gitRepo.EXPECT().Commit(gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Eq(localRepositaryPath), "remotes/origin/main").Return(dryRunDiff, nil)
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(nil).AnyTimes()
+		nodesTree.EXPECT().InsertNodes(gomock.Any(), gomock.Any()).Return(&fakeNodes, nil)
+
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodesTree, nautesConfigs, nil)
+		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodesTree, resourcesUsecase)
+		options := &BizOptions{
+			ResouceName: resourceName,
+			ProductName: defaultGroupName,
+			DryRun:      true,
+		}
+		result, err := biz.RollbackEnvironment(ctx, options, commits[1].SHA)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Diff).Should(Equal(dryRunDiff))
+		Expect(result.Yaml).Should(ContainSubstring("cluster: old-cluster"))
+	})
+
+	It("will fail when the resource does not exist in the revision", func() {
+		gitRepo.EXPECT().Show(gomock.Any(), localRepositaryPath, "b3e1c4a~1", _EnvSubDir+"/env1.yaml").Return(nil, ErrorRevisionNotFound)
+
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodesTree, nautesConfigs, nil)
+		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodesTree, resourcesUsecase)
+		options := &BizOptions{
+			ResouceName: resourceName,
+			ProductName: defaultGroupName,
+		}
+		_, err := biz.RollbackEnvironment(ctx, options, "b3e1c4a~1")
+		Expect(err).Should(Equal(ErrorRevisionNotFound))
+	})
+})
+
+var _ = Describe("Deleted environment history", func() {
+	var (
+		resourceName = "env1"
+		revision     = "b3e1c4a"
+		fakeNode     *nodestree.Node
+		codeRepo     *MockCodeRepo
+		gitRepo      *MockGitRepo
+		nodesTree    *nodestree.MockNodesTree
+	)
+
+	BeforeEach(func() {
+		fakeNode = createEnvironmentNode(createEnvironmentResource(resourceName))
+
+		codeRepo = NewMockCodeRepo(ctl)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil).AnyTimes()
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil).AnyTimes()
+
+		gitRepo = NewMockGitRepo(ctl)
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
+
+		nodesTree = nodestree.NewMockNodesTree(ctl)
+		nodesTree.EXPECT().AppendOperators(gomock.Any())
+		loadMain := nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(emptyNodes, nil)
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(createContainEnvironmentNodes(fakeNode), nil).After(loadMain)
+		getMain := nodesTree.EXPECT().GetNode(gomock.Any(), nodestree.Enviroment, resourceName).Return(nil)
+		nodesTree.EXPECT().GetNode(gomock.Any(), nodestree.Enviroment, resourceName).Return(fakeNode).After(getMain)
+	})
+
+	It("will find the file of the resource in the revision when it has been deleted from main", func() {
+		content := "apiVersion: nautes.resource.nautes.io/v1alpha1\nkind: Environment\nmetadata:\n  name: env1\nspec:\n  product: product-1\n  cluster: old-cluster\n  envType: host\n"
+		gitRepo.EXPECT().Checkout(gomock.Any(), localRepositaryPath, revision).Return(nil)
+		gitRepo.EXPECT().Show(gomock.Any(), localRepositaryPath, revision, _EnvSubDir+"/env1.yaml").Return([]byte(content), nil)
+
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodesTree, nautesConfigs, nil)
+		biz := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodesTree, resourcesUsecase)
+		node, err := resourcesUsecase.GetRevision(ctx, nodestree.Enviroment, defaultGroupName, biz, func(nodes nodestree.Node) (string, error) {
+			return resourceName, nil
+		}, revision)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(node.Content.(*resourcev1alpha1.Environment).Spec.Cluster).Should(Equal("old-cluster"))
+	})
+})
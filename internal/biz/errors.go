@@ -19,21 +19,53 @@ import (
 )
 
 const (
-	PROJECT_NOT_FOUND  = "PROJECT_NOT_FOUND"
-	GROUP_NOT_FOUND    = "GROUP_NOT_FOUND"
-	NODE_NOT_FOUND     = "NODE_NOT_FOUND"
-	RESOURCE_NOT_FOUND = "RESOURCE_NOT_FOUND"
-	RESOURCE_NOT_MATCH = "RESOURCE_NOT_MATCH"
-	NO_AUTHORIZATION   = "NO_AUTHORIZATION"
+	PROJECT_NOT_FOUND    = "PROJECT_NOT_FOUND"
+	GROUP_NOT_FOUND      = "GROUP_NOT_FOUND"
+	NODE_NOT_FOUND       = "NODE_NOT_FOUND"
+	RESOURCE_NOT_FOUND   = "RESOURCE_NOT_FOUND"
+	RESOURCE_NOT_MATCH   = "RESOURCE_NOT_MATCH"
+	NO_AUTHORIZATION     = "NO_AUTHORIZATION"
+	GIT_CONFLICT         = "GIT_CONFLICT"
+	GIT_NON_FAST_FORWARD = "GIT_NON_FAST_FORWARD"
+	GIT_AUTH_FAILED      = "GIT_AUTH_FAILED"
+	RESOURCE_CONFLICT    = "RESOURCE_CONFLICT"
+	PROPOSAL_UNSUPPORTED = "PROPOSAL_UNSUPPORTED"
+	PROPOSAL_NOT_FOUND   = "PROPOSAL_NOT_FOUND"
+	PROPOSAL_NOT_MERGED  = "PROPOSAL_NOT_MERGED"
+	REVISION_NOT_FOUND   = "REVISION_NOT_FOUND"
+	INVALID_APPLY        = "INVALID_APPLY"
+	INVALID_KUBECONFIG   = "INVALID_KUBECONFIG"
+	PREFLIGHT_FAILED     = "PREFLIGHT_FAILED"
+	REPOSITORY_LOCK_LOST = "REPOSITORY_LOCK_LOST"
+	GROUP_UNSUPPORTED    = "GROUP_UNSUPPORTED"
+	PROPOSAL_REQUIRED    = "PROPOSAL_REQUIRED"
+	PLAN_CHANGED         = "PLAN_CHANGED"
+	PRODUCT_EXISTS       = "PRODUCT_EXISTS"
 )
 
 var (
-	ErrorProjectNotFound = errors.New(404, PROJECT_NOT_FOUND, "the project path is not found")
-	ErrorGroupNotFound   = errors.New(404, GROUP_NOT_FOUND, "the group path is not found")
-	ErrorNodetNotFound   = errors.New(404, NODE_NOT_FOUND, "the node is not found")
-	ErrorResourceNoFound = errors.New(404, RESOURCE_NOT_FOUND, "the resource is not found")
-	ErrorResourceNoMatch = errors.New(500, RESOURCE_NOT_MATCH, "the resource is not match")
-	ErrorNoAuth          = errors.New(403, NO_AUTHORIZATION, "no access to the code repository")
+	ErrorProjectNotFound      = errors.New(404, PROJECT_NOT_FOUND, "the project path is not found")
+	ErrorGroupNotFound        = errors.New(404, GROUP_NOT_FOUND, "the group path is not found")
+	ErrorNodetNotFound        = errors.New(404, NODE_NOT_FOUND, "the node is not found")
+	ErrorResourceNoFound      = errors.New(404, RESOURCE_NOT_FOUND, "the resource is not found")
+	ErrorResourceNoMatch      = errors.New(500, RESOURCE_NOT_MATCH, "the resource is not match")
+	ErrorNoAuth               = errors.New(403, NO_AUTHORIZATION, "no access to the code repository")
+	ErrorGitConflict          = errors.New(409, GIT_CONFLICT, "the changes conflict with the remote repository and cannot be merged automatically")
+	ErrorGitNonFastForward    = errors.New(409, GIT_NON_FAST_FORWARD, "the remote branch has been updated, the push is not a fast-forward")
+	ErrorGitAuthFailed        = errors.New(401, GIT_AUTH_FAILED, "failed to authenticate with the git server")
+	ErrorResourceConflict     = errors.New(409, RESOURCE_CONFLICT, "the resource has been changed since it was read, get it again and retry")
+	ErrorProposalNotSupported = errors.New(501, PROPOSAL_UNSUPPORTED, "the git provider does not support change proposals")
+	ErrorProposalNotFound     = errors.New(404, PROPOSAL_NOT_FOUND, "the change proposal is not found")
+	ErrorProposalNotMerged    = errors.New(409, PROPOSAL_NOT_MERGED, "the change proposal cannot be merged, it may be unapproved or conflict with the main branch")
+	ErrorRevisionNotFound     = errors.New(404, REVISION_NOT_FOUND, "the revision is not found, or the resource does not exist in it")
+	ErrorInvalidApply         = errors.New(400, INVALID_APPLY, "the resources to apply are invalid")
+	ErrorInvalidKubeconfig    = errors.New(400, INVALID_KUBECONFIG, "the kubeconfig is invalid")
+	ErrorPreflightFailed      = errors.New(412, PREFLIGHT_FAILED, "the cluster does not meet the requirements of the registration")
+	ErrorRepositoryLockLost   = errors.New(409, REPOSITORY_LOCK_LOST, "the lock of the repository was lost before the changes were pushed, retry the request")
+	ErrorGroupNotSupported    = errors.New(501, GROUP_UNSUPPORTED, "the git provider does not support creating groups through its API")
+	ErrorProposalRequired     = errors.New(403, PROPOSAL_REQUIRED, "the changes of the product must be proposed, set proposal to open a merge request")
+	ErrorPlanChanged          = errors.New(409, PLAN_CHANGED, "the deletion plan has changed since it was reviewed, get the plan again and confirm its fingerprint")
+	ErrorProductExists        = errors.New(409, PRODUCT_EXISTS, "the product already exists, a template can only be applied when the product is created")
 )
 
 const _ResourceDoesNotExistOrUnavailable = "During global validation, it was found that %s '%s' does not exist or is unavailable. Please check %s '%s' in directory '%s'."
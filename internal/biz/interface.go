@@ -47,12 +47,30 @@ type Secretrepo interface {
 
 type GitRepo interface {
 	Commit(path, message string) error
-	SaveConfig(ctx context.Context, path string) error
+	SaveConfig(ctx context.Context, path, message string) error
 	Clone(ctx context.Context, param *CloneRepositoryParam) (string, error)
 	Merge(ctx context.Context, path string) (string, error)
 	Push(ctx context.Context, path string, command ...string) error
 	Diff(ctx context.Context, path string, command ...string) (string, error)
 	Fetch(ctx context.Context, path string, command ...string) (string, error)
+	Log(ctx context.Context, path, file string) ([]*Commit, error)
+	Show(ctx context.Context, path, revision, file string) ([]byte, error)
+	Checkout(ctx context.Context, path, revision string) error
+	Head(path string) (string, error)
+}
+
+// MergeRequestRepo is implemented by a CodeRepo that can propose changes through merge requests.
+type MergeRequestRepo interface {
+	CreateMergeRequest(ctx context.Context, pid interface{}, options *MergeRequestOptions) (*MergeRequest, error)
+	GetMergeRequest(ctx context.Context, pid interface{}, mergeRequestID int) (*MergeRequest, error)
+	ListMergeRequests(ctx context.Context, pid interface{}, state string) ([]*MergeRequest, error)
+	ApproveMergeRequest(ctx context.Context, pid interface{}, mergeRequestID int) error
+	AcceptMergeRequest(ctx context.Context, pid interface{}, mergeRequestID int) (*MergeRequest, error)
+}
+
+// RepositoryLocker is implemented by a GitRepo that can serialize changes to the same repository.
+type RepositoryLocker interface {
+	Lock(ctx context.Context, url string) (unlock func(), err error)
 }
 
 type DexRepo interface {
@@ -394,6 +394,20 @@ func (m *MockGitRepo) EXPECT() *MockGitRepoMockRecorder {
 	return m.recorder
 }
 
+// Checkout mocks base method.
+func (m *MockGitRepo) Checkout(ctx context.Context, path, revision string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, path, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockGitRepoMockRecorder) Checkout(ctx, path, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockGitRepo)(nil).Checkout), ctx, path, revision)
+}
+
 // Clone mocks base method.
 func (m *MockGitRepo) Clone(ctx context.Context, param *CloneRepositoryParam) (string, error) {
 	m.ctrl.T.Helper()
@@ -463,6 +477,36 @@ func (mr *MockGitRepoMockRecorder) Fetch(ctx, path interface{}, command ...inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockGitRepo)(nil).Fetch), varargs...)
 }
 
+// Head mocks base method.
+func (m *MockGitRepo) Head(path string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Head", path)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Head indicates an expected call of Head.
+func (mr *MockGitRepoMockRecorder) Head(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Head", reflect.TypeOf((*MockGitRepo)(nil).Head), path)
+}
+
+// Log mocks base method.
+func (m *MockGitRepo) Log(ctx context.Context, path, file string) ([]*Commit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Log", ctx, path, file)
+	ret0, _ := ret[0].([]*Commit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockGitRepoMockRecorder) Log(ctx, path, file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockGitRepo)(nil).Log), ctx, path, file)
+}
+
 // Merge mocks base method.
 func (m *MockGitRepo) Merge(ctx context.Context, path string) (string, error) {
 	m.ctrl.T.Helper()
@@ -498,17 +542,167 @@ func (mr *MockGitRepoMockRecorder) Push(ctx, path interface{}, command ...interf
 }
 
 // SaveConfig mocks base method.
-func (m *MockGitRepo) SaveConfig(ctx context.Context, path string) error {
+func (m *MockGitRepo) SaveConfig(ctx context.Context, path, message string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveConfig", ctx, path)
+	ret := m.ctrl.Call(m, "SaveConfig", ctx, path, message)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SaveConfig indicates an expected call of SaveConfig.
-func (mr *MockGitRepoMockRecorder) SaveConfig(ctx, path interface{}) *gomock.Call {
+func (mr *MockGitRepoMockRecorder) SaveConfig(ctx, path, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConfig", reflect.TypeOf((*MockGitRepo)(nil).SaveConfig), ctx, path, message)
+}
+
+// Show mocks base method.
+func (m *MockGitRepo) Show(ctx context.Context, path, revision, file string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Show", ctx, path, revision, file)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Show indicates an expected call of Show.
+func (mr *MockGitRepoMockRecorder) Show(ctx, path, revision, file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Show", reflect.TypeOf((*MockGitRepo)(nil).Show), ctx, path, revision, file)
+}
+
+// MockMergeRequestRepo is a mock of MergeRequestRepo interface.
+type MockMergeRequestRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockMergeRequestRepoMockRecorder
+}
+
+// MockMergeRequestRepoMockRecorder is the mock recorder for MockMergeRequestRepo.
+type MockMergeRequestRepoMockRecorder struct {
+	mock *MockMergeRequestRepo
+}
+
+// NewMockMergeRequestRepo creates a new mock instance.
+func NewMockMergeRequestRepo(ctrl *gomock.Controller) *MockMergeRequestRepo {
+	mock := &MockMergeRequestRepo{ctrl: ctrl}
+	mock.recorder = &MockMergeRequestRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMergeRequestRepo) EXPECT() *MockMergeRequestRepoMockRecorder {
+	return m.recorder
+}
+
+// AcceptMergeRequest mocks base method.
+func (m *MockMergeRequestRepo) AcceptMergeRequest(ctx context.Context, pid interface{}, mergeRequestID int) (*MergeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptMergeRequest", ctx, pid, mergeRequestID)
+	ret0, _ := ret[0].(*MergeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptMergeRequest indicates an expected call of AcceptMergeRequest.
+func (mr *MockMergeRequestRepoMockRecorder) AcceptMergeRequest(ctx, pid, mergeRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptMergeRequest", reflect.TypeOf((*MockMergeRequestRepo)(nil).AcceptMergeRequest), ctx, pid, mergeRequestID)
+}
+
+// ApproveMergeRequest mocks base method.
+func (m *MockMergeRequestRepo) ApproveMergeRequest(ctx context.Context, pid interface{}, mergeRequestID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveMergeRequest", ctx, pid, mergeRequestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveMergeRequest indicates an expected call of ApproveMergeRequest.
+func (mr *MockMergeRequestRepoMockRecorder) ApproveMergeRequest(ctx, pid, mergeRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveMergeRequest", reflect.TypeOf((*MockMergeRequestRepo)(nil).ApproveMergeRequest), ctx, pid, mergeRequestID)
+}
+
+// CreateMergeRequest mocks base method.
+func (m *MockMergeRequestRepo) CreateMergeRequest(ctx context.Context, pid interface{}, options *MergeRequestOptions) (*MergeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMergeRequest", ctx, pid, options)
+	ret0, _ := ret[0].(*MergeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMergeRequest indicates an expected call of CreateMergeRequest.
+func (mr *MockMergeRequestRepoMockRecorder) CreateMergeRequest(ctx, pid, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMergeRequest", reflect.TypeOf((*MockMergeRequestRepo)(nil).CreateMergeRequest), ctx, pid, options)
+}
+
+// GetMergeRequest mocks base method.
+func (m *MockMergeRequestRepo) GetMergeRequest(ctx context.Context, pid interface{}, mergeRequestID int) (*MergeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMergeRequest", ctx, pid, mergeRequestID)
+	ret0, _ := ret[0].(*MergeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMergeRequest indicates an expected call of GetMergeRequest.
+func (mr *MockMergeRequestRepoMockRecorder) GetMergeRequest(ctx, pid, mergeRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergeRequest", reflect.TypeOf((*MockMergeRequestRepo)(nil).GetMergeRequest), ctx, pid, mergeRequestID)
+}
+
+// ListMergeRequests mocks base method.
+func (m *MockMergeRequestRepo) ListMergeRequests(ctx context.Context, pid interface{}, state string) ([]*MergeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMergeRequests", ctx, pid, state)
+	ret0, _ := ret[0].([]*MergeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMergeRequests indicates an expected call of ListMergeRequests.
+func (mr *MockMergeRequestRepoMockRecorder) ListMergeRequests(ctx, pid, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMergeRequests", reflect.TypeOf((*MockMergeRequestRepo)(nil).ListMergeRequests), ctx, pid, state)
+}
+
+// MockRepositoryLocker is a mock of RepositoryLocker interface.
+type MockRepositoryLocker struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryLockerMockRecorder
+}
+
+// MockRepositoryLockerMockRecorder is the mock recorder for MockRepositoryLocker.
+type MockRepositoryLockerMockRecorder struct {
+	mock *MockRepositoryLocker
+}
+
+// NewMockRepositoryLocker creates a new mock instance.
+func NewMockRepositoryLocker(ctrl *gomock.Controller) *MockRepositoryLocker {
+	mock := &MockRepositoryLocker{ctrl: ctrl}
+	mock.recorder = &MockRepositoryLockerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryLocker) EXPECT() *MockRepositoryLockerMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method.
+func (m *MockRepositoryLocker) Lock(ctx context.Context, url string) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, url)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockRepositoryLockerMockRecorder) Lock(ctx, url interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConfig", reflect.TypeOf((*MockGitRepo)(nil).SaveConfig), ctx, path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockRepositoryLocker)(nil).Lock), ctx, url)
 }
 
 // MockDexRepo is a mock of DexRepo interface.
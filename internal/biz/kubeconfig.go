@@ -0,0 +1,268 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"time"
+
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	"k8s.io/kops/pkg/kubeconfig"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	_ClusterSecretsEngine = "cluster"
+	_ClusterSecretsKey    = "kubeconfig"
+)
+
+// ClusterCertificate is a certificate found in the kubeconfig of a cluster.
+type ClusterCertificate struct {
+	// Source is the entry of the kubeconfig the certificate is in, e.g. "user admin", or "cluster local" for the CA of the cluster.
+	Source   string
+	Subject  string
+	NotAfter time.Time
+}
+
+// ClusterCredentials are the certificates in the kubeconfig of a cluster.
+type ClusterCredentials struct {
+	ClusterName  string
+	Certificates []*ClusterCertificate
+	// ExpiresAt is when the first of the certificates expires, it is zero when the kubeconfig has none, e.g. it uses a token.
+	ExpiresAt time.Time
+	// Err is why the kubeconfig saved for the cluster cannot be read, it is only set by ListExpiringClusters.
+	Err error
+}
+
+// clusterSecretPath is the path of the kubeconfig of a cluster in the cluster secrets engine.
+func clusterSecretPath(clusterName string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", "kubernetes", clusterName, "default", "admin")
+}
+
+// UpdateKubeconfig replaces the kubeconfig saved for the cluster, the tenant repository is not changed.
+// Only the maintainers of the tenant repository may replace it.
+// The kubeconfig is rejected if its certificates cannot be parsed, have expired or do not match their keys,
+// or if it cannot reach the API server of the cluster.
+func (c *ClusterUsecase) UpdateKubeconfig(ctx context.Context, clusterName, config string) (*ClusterCredentials, error) {
+	err := c.checkTenantMaintainer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.GetCluster(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasKubeconfig(cluster) {
+		return nil, ErrorInvalidKubeconfig.WithCause(fmt.Errorf("the virtual cluster %s has no kubeconfig, it is reached through its host cluster %s", clusterName, cluster.HostCluster))
+	}
+
+	credentials, err := kubeconfigCredentials(clusterName, config)
+	if err != nil {
+		return nil, ErrorInvalidKubeconfig.WithCause(err)
+	}
+
+	now := time.Now()
+	for _, certificate := range credentials.Certificates {
+		if certificate.NotAfter.Before(now) {
+			return nil, ErrorInvalidKubeconfig.WithCause(fmt.Errorf("the certificate of %s expired at %s", certificate.Source, certificate.NotAfter.Format(time.RFC3339)))
+		}
+	}
+
+	err = c.checkKubeconfigReachability(config, cluster.ApiServer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.SaveKubeconfig(ctx, clusterName, cluster.ApiServer, config)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Infof("successfully updated the kubeconfig of cluster %s", clusterName)
+
+	return credentials, nil
+}
+
+// checkKubeconfigReachability connects to the API server with the kubeconfig, as it is saved for the cluster.
+func (c *ClusterUsecase) checkKubeconfigReachability(config, apiServer string) error {
+	config, err := c.ConvertKubeconfig(config, apiServer)
+	if err != nil {
+		return ErrorInvalidKubeconfig.WithCause(err)
+	}
+
+	clientset, err := preflightClientset(config)
+	if err != nil {
+		return err
+	}
+
+	if _, check := checkReachability(clientset); !check.Passed {
+		return ErrorPreflightFailed.WithCause(fmt.Errorf("%s", check.Message))
+	}
+
+	return nil
+}
+
+// ListExpiringClusters lists the clusters whose kubeconfig has a certificate that expires within the duration,
+// the clusters whose kubeconfig cannot be read are listed with the error.
+func (c *ClusterUsecase) ListExpiringClusters(ctx context.Context, within time.Duration) ([]*ClusterCredentials, error) {
+	clusters, err := c.ListClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(within)
+	var expiring []*ClusterCredentials
+	for _, cluster := range clusters {
+		if !hasKubeconfig(cluster) {
+			continue
+		}
+
+		credentials, err := c.getClusterCredentials(ctx, cluster.ClusterName)
+		if err != nil {
+			expiring = append(expiring, &ClusterCredentials{ClusterName: cluster.ClusterName, Err: err})
+			continue
+		}
+
+		if !credentials.ExpiresAt.IsZero() && credentials.ExpiresAt.Before(deadline) {
+			expiring = append(expiring, credentials)
+		}
+	}
+
+	return expiring, nil
+}
+
+func (c *ClusterUsecase) getClusterCredentials(ctx context.Context, clusterName string) (*ClusterCredentials, error) {
+	config, err := c.getKubeconfig(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubeconfigCredentials(clusterName, config)
+}
+
+// getKubeconfig returns the kubeconfig saved for the cluster.
+func (c *ClusterUsecase) getKubeconfig(ctx context.Context, clusterName string) (string, error) {
+	secretOptions := &SecretOptions{
+		SecretPath:   clusterSecretPath(clusterName),
+		SecretEngine: _ClusterSecretsEngine,
+		SecretKey:    _ClusterSecretsKey,
+	}
+
+	return c.secretRepo.GetSecret(ctx, secretOptions)
+}
+
+// hasKubeconfig reports whether a kubeconfig is saved for the cluster, virtual worker clusters are reached through their host clusters.
+func hasKubeconfig(cluster *ClusterData) bool {
+	return cluster.ClusterType != string(resourcev1alpha1.CLUSTER_TYPE_VIRTUAL) || cluster.Usage != string(resourcev1alpha1.CLUSTER_USAGE_WORKER)
+}
+
+// kubeconfigCredentials reads the certificates of the clusters and users in the kubeconfig.
+func kubeconfigCredentials(clusterName, config string) (*ClusterCredentials, error) {
+	kubectlConfig := &kubeconfig.KubectlConfig{}
+	jsonData, err := yaml.YAMLToJSONStrict([]byte(config))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(jsonData, kubectlConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(kubectlConfig.Clusters) < 1 {
+		return nil, fmt.Errorf("invalid kubeconfig file: must have at least one cluster")
+	}
+
+	if len(kubectlConfig.Users) < 1 {
+		return nil, fmt.Errorf("invalid kubeconfig file: must have at least one user")
+	}
+
+	credentials := &ClusterCredentials{ClusterName: clusterName}
+	for _, cluster := range kubectlConfig.Clusters {
+		if len(cluster.Cluster.CertificateAuthorityData) == 0 {
+			continue
+		}
+
+		certificates, err := parseCertificates(fmt.Sprintf("cluster %s", cluster.Name), cluster.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, err
+		}
+		credentials.Certificates = append(credentials.Certificates, certificates...)
+	}
+
+	for _, user := range kubectlConfig.Users {
+		if len(user.User.ClientCertificateData) == 0 {
+			continue
+		}
+
+		source := fmt.Sprintf("user %s", user.Name)
+		_, err := tls.X509KeyPair(user.User.ClientCertificateData, user.User.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("the client certificate of %s does not match its key, err: %w", source, err)
+		}
+
+		certificates, err := parseCertificates(source, user.User.ClientCertificateData)
+		if err != nil {
+			return nil, err
+		}
+		credentials.Certificates = append(credentials.Certificates, certificates...)
+	}
+
+	sort.SliceStable(credentials.Certificates, func(i, j int) bool {
+		return credentials.Certificates[i].NotAfter.Before(credentials.Certificates[j].NotAfter)
+	})
+	if len(credentials.Certificates) > 0 {
+		credentials.ExpiresAt = credentials.Certificates[0].NotAfter
+	}
+
+	return credentials, nil
+}
+
+func parseCertificates(source string, data []byte) ([]*ClusterCertificate, error) {
+	var certificates []*ClusterCertificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the certificate of %s, err: %w", source, err)
+		}
+		certificates = append(certificates, &ClusterCertificate{
+			Source:   source,
+			Subject:  certificate.Subject.String(),
+			NotAfter: certificate.NotAfter,
+		})
+	}
+
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("no certificate is found in %s", source)
+	}
+
+	return certificates, nil
+}
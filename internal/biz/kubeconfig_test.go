@@ -0,0 +1,236 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	clusterregistration "github.com/nautes-labs/api-server/pkg/cluster"
+	"github.com/nautes-labs/api-server/pkg/kubernetes"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// createCertificate creates a self-signed certificate and its key in PEM format.
+func createCertificate(commonName string, notAfter time.Time) (cert, key []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	Expect(err).ShouldNot(HaveOccurred())
+	keyDer, err := x509.MarshalECPrivateKey(privateKey)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+// createKubeconfig creates a kubeconfig whose CA expires in a year and whose client certificate expires at notAfter.
+func createKubeconfig(notAfter time.Time) string {
+	cert, key := createCertificate("admin", notAfter)
+	return createKubeconfigWithKey(cert, key)
+}
+
+func createKubeconfigWithKey(cert, key []byte) string {
+	ca, _ := createCertificate("kubernetes", time.Now().AddDate(1, 0, 0))
+	return createKubeconfigWithCA(ca, cert, key)
+}
+
+// createServerKubeconfig creates a kubeconfig that trusts the TLS server and whose client certificate expires at notAfter.
+func createServerKubeconfig(server *httptest.Server, notAfter time.Time) string {
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	cert, key := createCertificate("admin", notAfter)
+	return createKubeconfigWithCA(ca, cert, key)
+}
+
+func createKubeconfigWithCA(ca, cert, key []byte) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: local
+  cluster:
+    server: https://127.0.0.1:6443
+    certificate-authority-data: %s
+users:
+- name: admin
+  user:
+    client-certificate-data: %s
+    client-key-data: %s
+contexts:
+- name: admin@local
+  context:
+    cluster: local
+    user: admin
+current-context: admin@local
+`, base64.StdEncoding.EncodeToString(ca), base64.StdEncoding.EncodeToString(cert), base64.StdEncoding.EncodeToString(key))
+}
+
+var _ = Describe("Cluster credentials", func() {
+	var (
+		tenantRepositoryHttpsURL  = fmt.Sprintf("%s/dev-test-tenant/management.git", nautesConfigs.Git.Addr)
+		tenantRepositoryLocalPath string
+		tenant                    = &Project{Id: int64(22), Name: "repo-22", Path: "repo-22", HttpUrlToRepo: tenantRepositoryHttpsURL, PathWithNamespace: "dev-test-tenant/management"}
+		hostCluster               = &resourcev1alpha1.Cluster{
+			TypeMeta:   metav1.TypeMeta{APIVersion: resourcev1alpha1.GroupVersion.String(), Kind: "Cluster"},
+			ObjectMeta: metav1.ObjectMeta{Name: "host216", Namespace: nautesConfigs.Nautes.Namespace},
+			Spec: resourcev1alpha1.ClusterSpec{
+				ApiServer:   "https://10.204.118.216:6443",
+				ClusterType: resourcev1alpha1.CLUSTER_TYPE_PHYSICAL,
+				ClusterKind: resourcev1alpha1.ClusterKind("kubernetes"),
+				Usage:       resourcev1alpha1.CLUSTER_USAGE_HOST,
+			},
+		}
+		virtualCluster = &resourcev1alpha1.Cluster{
+			TypeMeta:   metav1.TypeMeta{APIVersion: resourcev1alpha1.GroupVersion.String(), Kind: "Cluster"},
+			ObjectMeta: metav1.ObjectMeta{Name: "vcluster-dev", Namespace: nautesConfigs.Nautes.Namespace},
+			Spec: resourcev1alpha1.ClusterSpec{
+				ApiServer:   "https://vcluster-dev:8443",
+				ClusterType: resourcev1alpha1.CLUSTER_TYPE_VIRTUAL,
+				ClusterKind: resourcev1alpha1.ClusterKind("kubernetes"),
+				Usage:       resourcev1alpha1.CLUSTER_USAGE_WORKER,
+				HostCluster: "host216",
+			},
+		}
+		secretRepo *MockSecretrepo
+		server     *httptest.Server
+	)
+
+	newClusterUsecase := func() *ClusterUsecase {
+		client := kubernetes.NewMockClient(ctl)
+		client.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		codeRepo := NewMockCodeRepo(ctl)
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Any()).Return(tenant, nil).AnyTimes()
+
+		gitRepo := NewMockGitRepo(ctl)
+		gitRepo.EXPECT().Clone(gomock.Any(), gomock.Any()).Return(tenantRepositoryLocalPath, nil).AnyTimes()
+
+		resourceusecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nil, nautesConfigs, nil)
+		return NewClusterUsecase(logger, codeRepo, secretRepo, resourceusecase, nautesConfigs, client, nil, nil)
+	}
+
+	BeforeEach(func() {
+		secretRepo = NewMockSecretrepo(ctl)
+		server = httptest.NewTLSServer(&fakeAPIServer{gitVersion: "v1.23.1"})
+		hostCluster.Spec.ApiServer = server.URL
+		tenant.Maintainer = true
+
+		var err error
+		tenantRepositoryLocalPath, err = os.MkdirTemp("", "tenant")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		clustersDir := clusterregistration.GetClustersDir(tenantRepositoryLocalPath)
+		err = os.MkdirAll(clustersDir, 0755)
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, cluster := range []*resourcev1alpha1.Cluster{hostCluster, virtualCluster} {
+			bytes, err := yaml.Marshal(cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			err = os.WriteFile(fmt.Sprintf("%s/%s.yaml", clustersDir, cluster.Name), bytes, 0644)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(tenantRepositoryLocalPath)
+	})
+
+	It("saves the kubeconfig and reports its certificates", func() {
+		notAfter := time.Now().AddDate(0, 3, 0).Truncate(time.Second)
+		secretRepo.EXPECT().SaveClusterConfig(gomock.Any(), hostCluster.Name, gomock.Any()).Return(nil)
+
+		credentials, err := newClusterUsecase().UpdateKubeconfig(context.Background(), hostCluster.Name, createServerKubeconfig(server, notAfter))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(credentials.Certificates).Should(HaveLen(2))
+		Expect(credentials.Certificates[0].Source).Should(Equal("user admin"))
+		Expect(credentials.Certificates[1].Source).Should(Equal("cluster local"))
+		Expect(credentials.ExpiresAt.Equal(notAfter)).Should(BeTrue())
+	})
+
+	It("only lets the maintainers of the tenant repository replace the kubeconfig", func() {
+		tenant.Maintainer = false
+
+		_, err := newClusterUsecase().UpdateKubeconfig(context.Background(), hostCluster.Name, createServerKubeconfig(server, time.Now().AddDate(0, 3, 0)))
+		Expect(ErrorNoAuth.Is(err)).Should(BeTrue())
+		Expect(err.Error()).Should(ContainSubstring(tenant.PathWithNamespace))
+	})
+
+	It("rejects a kubeconfig that cannot reach the API server of the cluster", func() {
+		_, err := newClusterUsecase().UpdateKubeconfig(context.Background(), hostCluster.Name, createKubeconfig(time.Now().AddDate(0, 3, 0)))
+		Expect(ErrorPreflightFailed.Is(err)).Should(BeTrue())
+	})
+
+	It("rejects a kubeconfig whose certificate has expired", func() {
+		_, err := newClusterUsecase().UpdateKubeconfig(context.Background(), hostCluster.Name, createKubeconfig(time.Now().Add(-time.Minute)))
+		Expect(ErrorInvalidKubeconfig.Is(err)).Should(BeTrue())
+	})
+
+	It("rejects a kubeconfig for a virtual cluster", func() {
+		_, err := newClusterUsecase().UpdateKubeconfig(context.Background(), virtualCluster.Name, createKubeconfig(time.Now().AddDate(0, 3, 0)))
+		Expect(ErrorInvalidKubeconfig.Is(err)).Should(BeTrue())
+	})
+
+	It("rejects a client certificate that does not match its key", func() {
+		cert, _ := createCertificate("admin", time.Now().AddDate(0, 3, 0))
+		_, otherKey := createCertificate("admin", time.Now().AddDate(0, 3, 0))
+
+		_, err := kubeconfigCredentials(hostCluster.Name, createKubeconfigWithKey(cert, otherKey))
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("lists the clusters whose credentials expire within the duration", func() {
+		secretOptions := &SecretOptions{
+			SecretPath:   clusterSecretPath(hostCluster.Name),
+			SecretEngine: _ClusterSecretsEngine,
+			SecretKey:    _ClusterSecretsKey,
+		}
+		secretRepo.EXPECT().GetSecret(gomock.Any(), secretOptions).Return(createKubeconfig(time.Now().AddDate(0, 0, 10)), nil)
+
+		clusters, err := newClusterUsecase().ListExpiringClusters(context.Background(), 30*24*time.Hour)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(clusters).Should(HaveLen(1))
+		Expect(clusters[0].ClusterName).Should(Equal(hostCluster.Name))
+	})
+
+	It("leaves out the clusters whose credentials expire later", func() {
+		secretRepo.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Return(createKubeconfig(time.Now().AddDate(0, 0, 10)), nil)
+
+		clusters, err := newClusterUsecase().ListExpiringClusters(context.Background(), 5*24*time.Hour)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(clusters).Should(BeEmpty())
+	})
+})
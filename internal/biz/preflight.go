@@ -0,0 +1,394 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nautes-labs/api-server/pkg/cluster"
+	utilstrings "github.com/nautes-labs/api-server/util/string"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	apiversion "k8s.io/apimachinery/pkg/version"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	PreflightReachability = "reachability"
+	PreflightVersion      = "version"
+	PreflightPermission   = "permission"
+	PreflightCRD          = "crd"
+	PreflightNodePort     = "nodeport"
+)
+
+const (
+	_PreflightTimeout = 10 * time.Second
+	_TraefikNamespace = "traefik"
+)
+
+// _PreflightPermissions are what the kubeconfig must be allowed to do to install the components of the cluster.
+var _PreflightPermissions = []authorizationv1.ResourceAttributes{
+	{Verb: "create", Resource: "namespaces"},
+	{Verb: "create", Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"},
+	{Verb: "create", Group: "rbac.authorization.k8s.io", Resource: "clusterroles"},
+	{Verb: "create", Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"},
+	{Verb: "list", Resource: "services"},
+}
+
+// PreflightRequirements are the configured requirements a cluster must meet before it is registered.
+type PreflightRequirements struct {
+	// MinVersion is the lowest Kubernetes version the cluster may run, it is not checked if it is empty.
+	MinVersion string
+	// CRDs are the names of the CustomResourceDefinitions that must be installed in the cluster.
+	CRDs []string
+}
+
+// PreflightCheck is the result of one check of a cluster.
+type PreflightCheck struct {
+	// Type is one of reachability, version, permission, crd and nodeport.
+	Type    string
+	Name    string
+	Passed  bool
+	Message string
+}
+
+// PreflightReport is the result of the checks of a cluster, it passes if every check passes.
+type PreflightReport struct {
+	ClusterName string
+	Passed      bool
+	Checks      []*PreflightCheck
+}
+
+// FailedChecks returns the checks that did not pass.
+func (r *PreflightReport) FailedChecks() []*PreflightCheck {
+	var failed []*PreflightCheck
+	for _, check := range r.Checks {
+		if !check.Passed {
+			failed = append(failed, check)
+		}
+	}
+
+	return failed
+}
+
+func (r *PreflightReport) add(checks ...*PreflightCheck) {
+	r.Checks = append(r.Checks, checks...)
+}
+
+// preflightNodePort is a NodePort the components of the cluster will listen on,
+// it may only be used by the services in the namespace the components are installed in.
+type preflightNodePort struct {
+	name      string
+	port      string
+	namespace string
+}
+
+// ValidateCluster checks the cluster to register can be reached with the kubeconfig and meets the requirements.
+// Physical clusters are checked with the kubeconfig, virtual clusters are checked on their host clusters with the saved kubeconfig of the host.
+// Only the maintainers of the tenant repository may run the checks, nothing is read from Vault or the cluster for the others.
+// An error is returned only if the kubeconfig cannot be used, failed checks are reported in the report.
+func (c *ClusterUsecase) ValidateCluster(ctx context.Context, param *cluster.ClusterRegistrationParam, kubeconfig string, requirements *PreflightRequirements) (*PreflightReport, error) {
+	if requirements == nil {
+		requirements = &PreflightRequirements{}
+	}
+
+	err := c.checkTenantMaintainer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config, nodePorts, err := c.preflightTarget(ctx, param, kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := preflightClientset(config)
+	if err != nil {
+		return nil, err
+	}
+
+	report := runPreflight(ctx, clientset, requirements, nodePorts)
+	report.ClusterName = param.Cluster.Name
+	if !report.Passed {
+		c.log.Infof("cluster %s failed %d of %d preflight checks", report.ClusterName, len(report.FailedChecks()), len(report.Checks))
+	}
+
+	return report, nil
+}
+
+// preflightTarget returns the kubeconfig of the cluster the checks run against and the NodePorts that must be free in it.
+func (c *ClusterUsecase) preflightTarget(ctx context.Context, param *cluster.ClusterRegistrationParam, kubeconfig string) (string, []*preflightNodePort, error) {
+	if cluster.IsVirtualRuntime(param.Cluster) {
+		hostCluster := param.Cluster.Spec.HostCluster
+		if hostCluster == "" {
+			return "", nil, fmt.Errorf("the host cluster of virtual cluster %s is not specified", param.Cluster.Name)
+		}
+
+		config, err := c.getKubeconfig(ctx, hostCluster)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to get the kubeconfig of host cluster %s, err: %w", hostCluster, err)
+		}
+
+		port := ""
+		if param.Vcluster != nil {
+			port = param.Vcluster.HttpsNodePort
+		}
+		if port == "" {
+			port, err = utilstrings.ExtractPortFromURL(param.Cluster.Spec.ApiServer)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to get the port of vcluster %s, err: %w", param.Cluster.Name, err)
+			}
+		}
+
+		return config, []*preflightNodePort{
+			{name: "vcluster https", port: port, namespace: param.Cluster.Name},
+		}, nil
+	}
+
+	if kubeconfig == "" {
+		return "", nil, ErrorInvalidKubeconfig.WithCause(fmt.Errorf("the kubeconfig of cluster %s is empty", param.Cluster.Name))
+	}
+
+	config, err := c.ConvertKubeconfig(kubeconfig, param.Cluster.Spec.ApiServer)
+	if err != nil {
+		return "", nil, ErrorInvalidKubeconfig.WithCause(err)
+	}
+
+	var nodePorts []*preflightNodePort
+	if param.Traefik != nil {
+		nodePorts = append(nodePorts,
+			&preflightNodePort{name: "traefik http", port: param.Traefik.HttpNodePort, namespace: _TraefikNamespace},
+			&preflightNodePort{name: "traefik https", port: param.Traefik.HttpsNodePort, namespace: _TraefikNamespace},
+		)
+	}
+
+	return config, nodePorts, nil
+}
+
+// preflightClientset creates the client the checks run with, requests time out after _PreflightTimeout.
+func preflightClientset(config string) (kubeclient.Interface, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(config))
+	if err != nil {
+		return nil, ErrorInvalidKubeconfig.WithCause(err)
+	}
+	restConfig.Timeout = _PreflightTimeout
+
+	clientset, err := kubeclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, ErrorInvalidKubeconfig.WithCause(err)
+	}
+
+	return clientset, nil
+}
+
+// checkReachability reads the version of the API server, the version is nil if the API server cannot be reached.
+func checkReachability(clientset kubeclient.Interface) (*apiversion.Info, *PreflightCheck) {
+	serverVersion, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		return nil, &PreflightCheck{
+			Type:    PreflightReachability,
+			Name:    "apiserver",
+			Message: fmt.Sprintf("the API server cannot be reached, err: %s", err),
+		}
+	}
+
+	return serverVersion, &PreflightCheck{
+		Type:    PreflightReachability,
+		Name:    "apiserver",
+		Passed:  true,
+		Message: fmt.Sprintf("the API server is reachable and runs %s", serverVersion.GitVersion),
+	}
+}
+
+func runPreflight(ctx context.Context, clientset kubeclient.Interface, requirements *PreflightRequirements, nodePorts []*preflightNodePort) *PreflightReport {
+	report := &PreflightReport{}
+
+	serverVersion, check := checkReachability(clientset)
+	if serverVersion == nil {
+		check.Message = fmt.Sprintf("%s, the other checks are skipped", check.Message)
+		report.add(check)
+		return report
+	}
+	report.add(check)
+
+	if requirements.MinVersion != "" {
+		report.add(checkVersion(serverVersion.GitVersion, requirements.MinVersion))
+	}
+
+	for i := range _PreflightPermissions {
+		report.add(checkPermission(ctx, clientset, &_PreflightPermissions[i]))
+	}
+
+	for _, name := range requirements.CRDs {
+		report.add(checkCRD(ctx, clientset, name))
+	}
+
+	report.add(checkNodePorts(ctx, clientset, nodePorts)...)
+
+	report.Passed = len(report.FailedChecks()) == 0
+
+	return report
+}
+
+func checkVersion(serverVersion, minVersion string) *PreflightCheck {
+	check := &PreflightCheck{Type: PreflightVersion, Name: minVersion}
+
+	required, err := version.ParseGeneric(minVersion)
+	if err != nil {
+		check.Message = fmt.Sprintf("the required version %s is invalid, err: %s", minVersion, err)
+		return check
+	}
+
+	current, err := version.ParseGeneric(serverVersion)
+	if err != nil {
+		check.Message = fmt.Sprintf("the version %s of the cluster cannot be parsed, err: %s", serverVersion, err)
+		return check
+	}
+
+	if !current.AtLeast(required) {
+		check.Message = fmt.Sprintf("the cluster runs %s, at least %s is required", serverVersion, minVersion)
+		return check
+	}
+
+	check.Passed = true
+	check.Message = fmt.Sprintf("the cluster runs %s", serverVersion)
+
+	return check
+}
+
+func checkPermission(ctx context.Context, clientset kubeclient.Interface, attributes *authorizationv1.ResourceAttributes) *PreflightCheck {
+	resource := attributes.Resource
+	if attributes.Group != "" {
+		resource = fmt.Sprintf("%s.%s", attributes.Resource, attributes.Group)
+	}
+	check := &PreflightCheck{Type: PreflightPermission, Name: fmt.Sprintf("%s %s", attributes.Verb, resource)}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: attributes,
+		},
+	}
+	review, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		check.Message = fmt.Sprintf("failed to review the permission to %s, err: %s", check.Name, err)
+		return check
+	}
+
+	if !review.Status.Allowed {
+		check.Message = fmt.Sprintf("the kubeconfig is not allowed to %s", check.Name)
+		if review.Status.Reason != "" {
+			check.Message = fmt.Sprintf("%s: %s", check.Message, review.Status.Reason)
+		}
+		return check
+	}
+
+	check.Passed = true
+	check.Message = fmt.Sprintf("the kubeconfig is allowed to %s", check.Name)
+
+	return check
+}
+
+func checkCRD(ctx context.Context, clientset kubeclient.Interface, name string) *PreflightCheck {
+	check := &PreflightCheck{Type: PreflightCRD, Name: name}
+
+	err := clientset.Discovery().RESTClient().
+		Get().
+		AbsPath("/apis/apiextensions.k8s.io/v1/customresourcedefinitions", name).
+		Do(ctx).
+		Error()
+	if k8serrors.IsNotFound(err) {
+		check.Message = fmt.Sprintf("the CustomResourceDefinition %s is not installed", name)
+		return check
+	}
+	if err != nil {
+		check.Message = fmt.Sprintf("failed to get the CustomResourceDefinition %s, err: %s", name, err)
+		return check
+	}
+
+	check.Passed = true
+	check.Message = fmt.Sprintf("the CustomResourceDefinition %s is installed", name)
+
+	return check
+}
+
+// checkNodePorts checks the NodePorts are not used by the services outside the namespaces they are reserved for.
+func checkNodePorts(ctx context.Context, clientset kubeclient.Interface, nodePorts []*preflightNodePort) []*PreflightCheck {
+	var checks []*PreflightCheck
+	var nodePortsToCheck []*preflightNodePort
+	for _, nodePort := range nodePorts {
+		if nodePort.port == "" {
+			continue
+		}
+		nodePortsToCheck = append(nodePortsToCheck, nodePort)
+	}
+	if len(nodePortsToCheck) == 0 {
+		return nil
+	}
+
+	services, err := clientset.CoreV1().Services(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		for _, nodePort := range nodePortsToCheck {
+			checks = append(checks, &PreflightCheck{
+				Type:    PreflightNodePort,
+				Name:    fmt.Sprintf("%s %s", nodePort.name, nodePort.port),
+				Message: fmt.Sprintf("failed to list the services, err: %s", err),
+			})
+		}
+		return checks
+	}
+
+	usedBy := make(map[int32][]string)
+	for _, service := range services.Items {
+		for _, port := range service.Spec.Ports {
+			if port.NodePort != 0 {
+				usedBy[port.NodePort] = append(usedBy[port.NodePort], fmt.Sprintf("%s/%s", service.Namespace, service.Name))
+			}
+		}
+	}
+
+	for _, nodePort := range nodePortsToCheck {
+		check := &PreflightCheck{Type: PreflightNodePort, Name: fmt.Sprintf("%s %s", nodePort.name, nodePort.port)}
+		checks = append(checks, check)
+
+		port, err := strconv.ParseInt(nodePort.port, 10, 32)
+		if err != nil || port < 1 || port > 65535 {
+			check.Message = fmt.Sprintf("the port %s of %s is invalid", nodePort.port, nodePort.name)
+			continue
+		}
+
+		var conflicts []string
+		for _, service := range usedBy[int32(port)] {
+			if !strings.HasPrefix(service, nodePort.namespace+"/") {
+				conflicts = append(conflicts, service)
+			}
+		}
+		if len(conflicts) != 0 {
+			check.Message = fmt.Sprintf("the port %d of %s is used by service %s", port, nodePort.name, strings.Join(conflicts, ", "))
+			continue
+		}
+
+		check.Passed = true
+		check.Message = fmt.Sprintf("the port %d of %s is free", port, nodePort.name)
+	}
+
+	return checks
+}
@@ -0,0 +1,282 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	clusterregistration "github.com/nautes-labs/api-server/pkg/cluster"
+	"github.com/nautes-labs/api-server/pkg/kubernetes"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// fakeAPIServer serves the requests of the preflight checks.
+type fakeAPIServer struct {
+	gitVersion string
+	// denied are the resources the kubeconfig is not allowed to access.
+	denied []string
+	// crds are the names of the installed CustomResourceDefinitions.
+	crds     []string
+	services []corev1.Service
+}
+
+func (f *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	const crdPath = "/apis/apiextensions.k8s.io/v1/customresourcedefinitions/"
+
+	switch {
+	case r.URL.Path == "/version":
+		_ = json.NewEncoder(w).Encode(&version.Info{GitVersion: f.gitVersion})
+	case r.URL.Path == "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews" && r.Method == http.MethodPost:
+		review := &authorizationv1.SelfSubjectAccessReview{}
+		Expect(json.NewDecoder(r.Body).Decode(review)).ShouldNot(HaveOccurred())
+		review.APIVersion = authorizationv1.SchemeGroupVersion.String()
+		review.Kind = "SelfSubjectAccessReview"
+		review.Status.Allowed = !contains(f.denied, review.Spec.ResourceAttributes.Resource)
+		_ = json.NewEncoder(w).Encode(review)
+	case strings.HasPrefix(r.URL.Path, crdPath):
+		name := strings.TrimPrefix(r.URL.Path, crdPath)
+		if !contains(f.crds, name) {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(&metav1.Status{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"},
+				Status:   metav1.StatusFailure,
+				Reason:   metav1.StatusReasonNotFound,
+				Code:     http.StatusNotFound,
+			})
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"apiVersion":"apiextensions.k8s.io/v1","kind":"CustomResourceDefinition","metadata":{"name":%q}}`, name)
+	case r.URL.Path == "/api/v1/services":
+		_ = json.NewEncoder(w).Encode(&corev1.ServiceList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceList"},
+			Items:    f.services,
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+func createTokenKubeconfig(server string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: local
+  cluster:
+    server: %s
+users:
+- name: admin
+  user:
+    token: fake-token
+contexts:
+- name: admin@local
+  context:
+    cluster: local
+    user: admin
+current-context: admin@local
+`, server)
+}
+
+func createNodePortService(namespace, name string, nodePort int32) corev1.Service {
+	return corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeNodePort,
+			Ports: []corev1.ServicePort{{Port: 443, NodePort: nodePort}},
+		},
+	}
+}
+
+func failedCheckNames(report *PreflightReport) []string {
+	var names []string
+	for _, check := range report.FailedChecks() {
+		names = append(names, fmt.Sprintf("%s %s", check.Type, check.Name))
+	}
+	return names
+}
+
+var _ = Describe("Cluster preflight", func() {
+	var (
+		apiServer    *fakeAPIServer
+		server       *httptest.Server
+		secretRepo   *MockSecretrepo
+		requirements *PreflightRequirements
+		tenant       *Project
+	)
+
+	newPhysicalClusterParam := func(apiServerURL string) *clusterregistration.ClusterRegistrationParam {
+		return &clusterregistration.ClusterRegistrationParam{
+			Cluster: &resourcev1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-dev", Namespace: nautesConfigs.Nautes.Namespace},
+				Spec: resourcev1alpha1.ClusterSpec{
+					ApiServer:   apiServerURL,
+					ClusterType: resourcev1alpha1.CLUSTER_TYPE_PHYSICAL,
+					ClusterKind: resourcev1alpha1.ClusterKind("kubernetes"),
+					Usage:       resourcev1alpha1.CLUSTER_USAGE_WORKER,
+				},
+			},
+			Traefik: &clusterregistration.Traefik{HttpNodePort: "30080", HttpsNodePort: "30443"},
+		}
+	}
+
+	newClusterUsecase := func() *ClusterUsecase {
+		client := kubernetes.NewMockClient(ctl)
+		client.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		codeRepo := NewMockCodeRepo(ctl)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Any()).Return(tenant, nil).AnyTimes()
+
+		return NewClusterUsecase(logger, codeRepo, secretRepo, nil, nautesConfigs, client, nil, nil)
+	}
+
+	BeforeEach(func() {
+		secretRepo = NewMockSecretrepo(ctl)
+		tenant = &Project{Id: int64(22), PathWithNamespace: "dev-test-tenant/management", Maintainer: true}
+		apiServer = &fakeAPIServer{
+			gitVersion: "v1.23.1",
+			crds:       []string{"certificates.cert-manager.io"},
+			services: []corev1.Service{
+				createNodePortService(_TraefikNamespace, "traefik", 30443),
+				createNodePortService("default", "web", 31080),
+			},
+		}
+		server = httptest.NewServer(apiServer)
+		requirements = &PreflightRequirements{
+			MinVersion: "1.21",
+			CRDs:       []string{"certificates.cert-manager.io"},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("passes when the cluster meets the requirements", func() {
+		biz := newClusterUsecase()
+		report, err := biz.ValidateCluster(context.Background(), newPhysicalClusterParam(server.URL), createTokenKubeconfig("https://127.0.0.1:6443"), requirements)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(failedCheckNames(report)).Should(BeEmpty())
+		Expect(report.Passed).Should(BeTrue())
+		Expect(report.ClusterName).Should(Equal("cluster-dev"))
+		Expect(report.Checks).Should(HaveLen(1 + 1 + len(_PreflightPermissions) + 1 + 2))
+	})
+
+	It("reports every check the cluster fails", func() {
+		apiServer.gitVersion = "v1.20.4"
+		apiServer.denied = []string{"clusterrolebindings"}
+		apiServer.crds = nil
+		apiServer.services = append(apiServer.services, createNodePortService("default", "blog", 30080))
+
+		biz := newClusterUsecase()
+		report, err := biz.ValidateCluster(context.Background(), newPhysicalClusterParam(server.URL), createTokenKubeconfig(server.URL), requirements)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Passed).Should(BeFalse())
+		Expect(failedCheckNames(report)).Should(Equal([]string{
+			"version 1.21",
+			"permission create clusterrolebindings.rbac.authorization.k8s.io",
+			"crd certificates.cert-manager.io",
+			"nodeport traefik http 30080",
+		}))
+		Expect(report.FailedChecks()[3].Message).Should(ContainSubstring("default/blog"))
+	})
+
+	It("checks virtual clusters on their host clusters", func() {
+		apiServer.services = append(apiServer.services, createNodePortService("vcluster-dev", "vcluster", 31443))
+		secretRepo.EXPECT().GetSecret(gomock.Any(), &SecretOptions{
+			SecretPath:   clusterSecretPath("host216"),
+			SecretEngine: _ClusterSecretsEngine,
+			SecretKey:    _ClusterSecretsKey,
+		}).Return(createTokenKubeconfig(server.URL), nil)
+
+		param := &clusterregistration.ClusterRegistrationParam{
+			Cluster: &resourcev1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "vcluster-dev", Namespace: nautesConfigs.Nautes.Namespace},
+				Spec: resourcev1alpha1.ClusterSpec{
+					ApiServer:   "https://10.204.118.216:31443",
+					ClusterType: resourcev1alpha1.CLUSTER_TYPE_VIRTUAL,
+					ClusterKind: resourcev1alpha1.ClusterKind("kubernetes"),
+					Usage:       resourcev1alpha1.CLUSTER_USAGE_WORKER,
+					HostCluster: "host216",
+				},
+			},
+		}
+
+		biz := newClusterUsecase()
+		report, err := biz.ValidateCluster(context.Background(), param, "", requirements)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(failedCheckNames(report)).Should(BeEmpty())
+		Expect(report.Checks[len(report.Checks)-1].Name).Should(Equal("vcluster https 31443"))
+
+		apiServer.services = append(apiServer.services, createNodePortService("default", "api", 31443))
+		secretRepo.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Return(createTokenKubeconfig(server.URL), nil)
+		report, err = biz.ValidateCluster(context.Background(), param, "", requirements)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(failedCheckNames(report)).Should(Equal([]string{"nodeport vcluster https 31443"}))
+	})
+
+	It("skips the other checks when the API server cannot be reached", func() {
+		server.Close()
+
+		biz := newClusterUsecase()
+		report, err := biz.ValidateCluster(context.Background(), newPhysicalClusterParam(server.URL), createTokenKubeconfig(server.URL), requirements)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Passed).Should(BeFalse())
+		Expect(failedCheckNames(report)).Should(Equal([]string{"reachability apiserver"}))
+	})
+
+	It("only lets the maintainers of the tenant repository check a cluster", func() {
+		tenant.Maintainer = false
+		param := newPhysicalClusterParam(server.URL)
+		param.Cluster.Spec.ClusterType = resourcev1alpha1.CLUSTER_TYPE_VIRTUAL
+		param.Cluster.Spec.HostCluster = "host216"
+
+		_, err := newClusterUsecase().ValidateCluster(context.Background(), param, "", requirements)
+		Expect(ErrorNoAuth.Is(err)).Should(BeTrue())
+
+		_, err = newClusterUsecase().ValidateCluster(context.Background(), newPhysicalClusterParam(server.URL), createTokenKubeconfig(server.URL), requirements)
+		Expect(ErrorNoAuth.Is(err)).Should(BeTrue())
+	})
+
+	It("will fail when the kubeconfig is invalid", func() {
+		biz := newClusterUsecase()
+		_, err := biz.ValidateCluster(context.Background(), newPhysicalClusterParam(server.URL), "", requirements)
+		Expect(err).Should(HaveOccurred())
+		Expect(ErrorInvalidKubeconfig.Is(err)).Should(BeTrue())
+
+		_, err = biz.ValidateCluster(context.Background(), newPhysicalClusterParam(server.URL), "clusters: [", requirements)
+		Expect(ErrorInvalidKubeconfig.Is(err)).Should(BeTrue())
+	})
+})
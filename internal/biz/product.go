@@ -16,32 +16,39 @@ package biz
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	errors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	commonv1 "github.com/nautes-labs/api-server/api/common/v1"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
 	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
 	_ProductKind     = "Product"
 	_ProductDestUser = "Argo"
+	// _GroupProjectsPerPage is the page size of listing the repositories of a product.
+	_GroupProjectsPerPage = 100
 )
 
 type Group struct {
-	Id          int32
+	Id          int64
 	Name        string
 	Visibility  string
 	Description string
 	Path        string
 	WebUrl      string
-	ParentId    int32
+	ParentId    int64
 }
 
 type Project struct {
-	Id                int32
+	Id                int64
 	Name              string
 	Visibility        string
 	Description       string
@@ -50,6 +57,9 @@ type Project struct {
 	SshUrlToRepo      string
 	HttpUrlToRepo     string
 	PathWithNamespace string
+	// Maintainer reports whether the user of the request is at least a maintainer of the repository,
+	// it is only reliable for repositories read with GetCodeRepo.
+	Maintainer bool
 }
 
 // ProductUsecase is a Product usecase.
@@ -61,6 +71,12 @@ type ProductUsecase struct {
 	configs          *nautesconfigs.Config
 	resourcesUsecase *ResourcesUsecase
 	codeRepoUsecase  *CodeRepoUsecase
+	templates        templateApplier
+}
+
+// templateApplier copies the resources of a template product into a product.
+type templateApplier interface {
+	ApplyTemplate(ctx context.Context, productName string, options *ProductTemplateOptions) (*ChangeResult, error)
 }
 
 type GroupAndProjectItem struct {
@@ -68,8 +84,8 @@ type GroupAndProjectItem struct {
 	Project *Project
 }
 
-func NewProductUsecase(logger log.Logger, codeRepo CodeRepo, secretRepo Secretrepo, gitRepo GitRepo, configs *nautesconfigs.Config, resourcesUsecase *ResourcesUsecase, codeRepoUsecase *CodeRepoUsecase) *ProductUsecase {
-	return &ProductUsecase{log: log.NewHelper(logger), codeRepo: codeRepo, secretRepo: secretRepo, gitRepo: gitRepo, configs: configs, resourcesUsecase: resourcesUsecase, codeRepoUsecase: codeRepoUsecase}
+func NewProductUsecase(logger log.Logger, codeRepo CodeRepo, secretRepo Secretrepo, gitRepo GitRepo, configs *nautesconfigs.Config, resourcesUsecase *ResourcesUsecase, codeRepoUsecase *CodeRepoUsecase, applyUsecase *ApplyUsecase) *ProductUsecase {
+	return &ProductUsecase{log: log.NewHelper(logger), codeRepo: codeRepo, secretRepo: secretRepo, gitRepo: gitRepo, configs: configs, resourcesUsecase: resourcesUsecase, codeRepoUsecase: codeRepoUsecase, templates: applyUsecase}
 }
 
 func (p *ProductUsecase) GetGroupAndDefaultProject(ctx context.Context, productName string) (*GroupAndProjectItem, error) {
@@ -166,6 +182,61 @@ func (p *ProductUsecase) SaveProduct(ctx context.Context, productName string, gi
 	return group, project, nil
 }
 
+// SaveProductFromTemplate saves the product and copies the resources of the template product to it.
+// When options has no template, a new product is created from defaultTemplate, an existing product is only saved.
+// A template in options is rejected for an existing product, its resources would overwrite the resources of the product.
+// The returned template is the one the resources are copied from, it is empty when nothing is copied.
+func (p *ProductUsecase) SaveProductFromTemplate(ctx context.Context, productName string, gitOptions *GitGroupOptions, options *ProductTemplateOptions, defaultTemplate string) (string, *ChangeResult, error) {
+	template, err := p.templateFor(ctx, productName, options.Template, defaultTemplate)
+	if err != nil {
+		return "", nil, err
+	}
+
+	_, _, err = p.SaveProduct(ctx, productName, gitOptions)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if template == "" {
+		return "", &ChangeResult{}, nil
+	}
+
+	templateOptions := *options
+	templateOptions.Template = template
+	result, err := p.templates.ApplyTemplate(ctx, productName, &templateOptions)
+	if err != nil {
+		return "", nil, errors.FromError(err).WithCause(fmt.Errorf("the product %s is saved, but failed to copy the resources of template %s, err: %w", productName, template, err))
+	}
+
+	return template, result, nil
+}
+
+// templateFor returns the template to create the product from, templates are only applied to new products.
+func (p *ProductUsecase) templateFor(ctx context.Context, productName, template, defaultTemplate string) (string, error) {
+	if template == "" && defaultTemplate == "" {
+		return "", nil
+	}
+
+	existing, err := p.GetGroupAndDefaultProject(ctx, productName)
+	if err != nil {
+		return "", err
+	}
+
+	if existing != nil && template != "" {
+		return "", ErrorProductExists.WithCause(fmt.Errorf("the product %s cannot be created from template %s", productName, template))
+	}
+
+	if existing != nil {
+		return "", nil
+	}
+
+	if template != "" {
+		return template, nil
+	}
+
+	return defaultTemplate, nil
+}
+
 func (p *ProductUsecase) saveDefaultProject(ctx context.Context, group *Group) (*Project, error) {
 	defaultProjectPath := fmt.Sprintf("%s/%s", group.Path, p.configs.Git.DefaultProductName)
 	project, err := p.codeRepo.GetCodeRepo(ctx, defaultProjectPath)
@@ -174,6 +245,9 @@ func (p *ProductUsecase) saveDefaultProject(ctx context.Context, group *Group) (
 			Gitlab: &GitlabCodeRepoOptions{
 				Name: p.configs.Git.DefaultProductName,
 			},
+			Github: &GithubCodeRepoOptions{
+				Name: p.configs.Git.DefaultProductName,
+			},
 		}
 
 		project, err = p.codeRepo.CreateCodeRepo(ctx, int(group.Id), opt)
@@ -202,7 +276,8 @@ func (p *ProductUsecase) saveDefaultProject(ctx context.Context, group *Group) (
 			return nil, err
 		}
 
-		err = p.resourcesUsecase.SaveConfig(ctx, localPath)
+		ctx = withCommitMessage(ctx, fmt.Sprintf("api: create Product %s", group.Name))
+		_, err = p.resourcesUsecase.SaveConfig(ctx, localPath)
 		if err != nil {
 			return nil, err
 		}
@@ -278,6 +353,194 @@ func (p *ProductUsecase) DeleteProduct(ctx context.Context, productID string) er
 	return nil
 }
 
+// ProductDeletionPlan lists everything removed by the cascading deletion of a product.
+type ProductDeletionPlan struct {
+	Group *Group
+	// Projects are the repositories of the product, including the default project.
+	// Their deploy keys are removed together with them.
+	Projects []*Project
+	// SecretPaths are the paths of the deploy keys of the projects in Vault.
+	SecretPaths []string
+	// Resources are the resources declared in the default project.
+	Resources []*ProductResource
+}
+
+// Fingerprint identifies the content of the plan, a deletion is only executed for the plan that was reviewed.
+func (p *ProductDeletionPlan) Fingerprint() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "group:%d\n", p.Group.Id)
+	for _, project := range p.Projects {
+		fmt.Fprintf(&builder, "repository:%d:%s\n", project.Id, project.PathWithNamespace)
+	}
+	for _, secretPath := range p.SecretPaths {
+		fmt.Fprintf(&builder, "secret:%s\n", secretPath)
+	}
+	for _, resource := range p.Resources {
+		fmt.Fprintf(&builder, "resource:%s/%s\n", resource.Kind, resource.Name)
+	}
+
+	sum := sha256.Sum256([]byte(builder.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// ProductResource is a resource declared in the default project of a product.
+type ProductResource struct {
+	Kind string
+	Name string
+}
+
+// ProductDeletionFailure is a part of a deletion plan that could not be removed.
+type ProductDeletionFailure struct {
+	// Target describes what could not be removed, e.g. "secret git/gitlab/repo-1/default/readonly".
+	Target string
+	Err    error
+}
+
+// PlanProductDeletion lists everything the cascading deletion of the product would remove, nothing is removed.
+func (p *ProductUsecase) PlanProductDeletion(ctx context.Context, productName string) (*ProductDeletionPlan, error) {
+	group, err := p.codeRepo.GetGroup(ctx, productName)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := p.listGroupProjects(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &ProductDeletionPlan{
+		Group:    group,
+		Projects: projects,
+	}
+
+	for _, project := range projects {
+		repoName := fmt.Sprintf("%s%d", _RepoPrefix, int(project.Id))
+		secretPath := fmt.Sprintf("%s/%s", SecretsEngine, deployKeySecretPath(p.configs.Git.GitType, repoName))
+		plan.SecretPaths = append(plan.SecretPaths, secretPath)
+
+		if project.Path != p.configs.Git.DefaultProductName {
+			continue
+		}
+
+		plan.Resources, err = p.listProductResources(ctx, project)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// DeleteProductCascade removes everything in the deletion plan of the product.
+// The fingerprint must be the one of the current plan, otherwise nothing is removed.
+// It continues past the parts that cannot be removed and returns them as failures,
+// the group is removed last, after all of its repositories.
+func (p *ProductUsecase) DeleteProductCascade(ctx context.Context, productName, fingerprint string) (*ProductDeletionPlan, []*ProductDeletionFailure, error) {
+	plan, err := p.PlanProductDeletion(ctx, productName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if current := plan.Fingerprint(); current != fingerprint {
+		return plan, nil, ErrorPlanChanged.WithCause(fmt.Errorf("the fingerprint of the plan of product %s is %s, not %s", productName, current, fingerprint))
+	}
+
+	var failures []*ProductDeletionFailure
+	for i, project := range plan.Projects {
+		err = p.secretRepo.DeleteSecret(ctx, int(project.Id))
+		if err != nil && !commonv1.IsSecretNotFound(err) {
+			failures = append(failures, &ProductDeletionFailure{
+				Target: fmt.Sprintf("secret %s", plan.SecretPaths[i]),
+				Err:    err,
+			})
+		}
+
+		err = p.codeRepo.DeleteCodeRepo(ctx, int(project.Id))
+		if err != nil {
+			failures = append(failures, &ProductDeletionFailure{
+				Target: fmt.Sprintf("repository %s", project.PathWithNamespace),
+				Err:    err,
+			})
+		}
+	}
+
+	err = p.codeRepo.DeleteGroup(ctx, int(plan.Group.Id))
+	if err != nil {
+		failures = append(failures, &ProductDeletionFailure{
+			Target: fmt.Sprintf("group %s", plan.Group.Path),
+			Err:    err,
+		})
+	}
+
+	for _, failure := range failures {
+		p.log.Errorf("failed to delete %s of product %s, err: %v", failure.Target, productName, failure.Err)
+	}
+
+	return plan, failures, nil
+}
+
+func (p *ProductUsecase) listGroupProjects(ctx context.Context, group *Group) ([]*Project, error) {
+	var projects []*Project
+	for page := 1; ; page++ {
+		list, err := p.codeRepo.ListGroupCodeRepos(ctx, int(group.Id), page, _GroupProjectsPerPage)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, list...)
+		if len(list) < _GroupProjectsPerPage {
+			return projects, nil
+		}
+	}
+}
+
+// listProductResources lists the resources in the default project as they are, without validating them,
+// so that a product whose resources have become invalid can still be deleted.
+func (p *ProductUsecase) listProductResources(ctx context.Context, project *Project) ([]*ProductResource, error) {
+	localPath, err := p.resourcesUsecase.CloneCodeRepo(ctx, project.HttpUrlToRepo)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanCodeRepo(localPath)
+
+	nodes, err := p.resourcesUsecase.nodestree.Load(localPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return productResources(&nodes), nil
+}
+
+func productResources(nodes *nodestree.Node) []*ProductResource {
+	var resources []*ProductResource
+	for _, node := range resourceNodes(nodes) {
+		object, ok := node.Content.(metav1.Object)
+		if !ok {
+			continue
+		}
+
+		resources = append(resources, &ProductResource{
+			Kind: node.Kind,
+			Name: object.GetName(),
+		})
+	}
+
+	return resources
+}
+
+func resourceNodes(nodes *nodestree.Node) []*nodestree.Node {
+	var list []*nodestree.Node
+	for _, node := range nodes.Children {
+		if node.IsDir {
+			list = append(list, resourceNodes(node)...)
+		} else if node.Kind != "" {
+			list = append(list, node)
+		}
+	}
+
+	return list
+}
+
 func GetProject(ctx context.Context, codeRepo CodeRepo, pid interface{}) (project *Project, err error) {
 	project, err = codeRepo.GetCodeRepo(ctx, pid)
 	if err != nil {
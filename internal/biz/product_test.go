@@ -15,6 +15,7 @@
 package biz
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -44,9 +45,9 @@ var _ = Describe("Get product", func() {
 		gitRepo := NewMockGitRepo(ctl)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nil, nautesConfigs, nil)
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		p, err := product.GetProduct(ctx, ProductName)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -60,12 +61,12 @@ var _ = Describe("Get product", func() {
 		secretRepo := NewMockSecretrepo(ctl)
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourcesUsecase, nil)
 
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		result, err := product.GetProduct(ctx, ProductName)
 		Expect(err).Should(HaveOccurred())
@@ -80,11 +81,11 @@ var _ = Describe("Get product", func() {
 		secretRepo := NewMockSecretrepo(ctl)
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		result, err := product.GetProduct(ctx, ProductName)
 		Expect(err).Should(HaveOccurred())
@@ -114,11 +115,11 @@ var _ = Describe("List products", func() {
 		secretRepo := NewMockSecretrepo(ctl)
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		result, err := product.ListProducts(ctx)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -132,11 +133,11 @@ var _ = Describe("List products", func() {
 		secretRepo := NewMockSecretrepo(ctl)
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 		result, err := product.ListProducts(ctx)
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(result).ToNot(Equal([]*Group{}))
@@ -196,15 +197,16 @@ var _ = Describe("Save product", func() {
 
 		gitRepo := NewMockGitRepo(ctl)
 		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath)).Return(nil)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		_, _, err := product.SaveProduct(ctx, productName, gitOptions)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -234,11 +236,11 @@ var _ = Describe("Save product", func() {
 
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		_, _, err := product.SaveProduct(ctx, productName, gitOptions)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -253,11 +255,11 @@ var _ = Describe("Save product", func() {
 
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, nil, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, nil, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		_, _, err := product.SaveProduct(ctx, productName, gitOptions)
 		Expect(err).Should(HaveOccurred())
@@ -273,11 +275,11 @@ var _ = Describe("Save product", func() {
 
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		_, _, err := product.SaveProduct(ctx, productName, gitOptions)
 		Expect(err).Should(HaveOccurred())
@@ -298,15 +300,16 @@ var _ = Describe("Save product", func() {
 
 		gitRepo := NewMockGitRepo(ctl)
 		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath)).Return(nil)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		_, _, err := product.SaveProduct(ctx, productName, gitOptions)
 		Expect(err).Should(HaveOccurred())
@@ -329,15 +332,16 @@ var _ = Describe("Save product", func() {
 
 		gitRepo := NewMockGitRepo(ctl)
 		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath)).Return(nil)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		_, _, err := product.SaveProduct(ctx, productName, gitOptions)
 		Expect(err).Should(HaveOccurred())
@@ -361,26 +365,140 @@ var _ = Describe("Save product", func() {
 
 		gitRepo := NewMockGitRepo(ctl)
 		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
-		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath)).Return(nil)
+		gitRepo.EXPECT().SaveConfig(gomock.Any(), gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil)
 		gitRepo.EXPECT().Fetch(gomock.Any(), gomock.Any(), "origin").Return("any", nil)
 		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Any(), "main", "remotes/origin/main").Return("", nil)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodestree, nautesConfigs, resourcesUsecase, nil)
 
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		_, _, err := product.SaveProduct(ctx, productName, gitOptions)
 		Expect(err).Should(HaveOccurred())
 	})
 })
 
+type fakeTemplateApplier struct {
+	options *ProductTemplateOptions
+	result  *ChangeResult
+	err     error
+}
+
+func (f *fakeTemplateApplier) ApplyTemplate(ctx context.Context, productName string, options *ProductTemplateOptions) (*ChangeResult, error) {
+	f.options = options
+	return f.result, f.err
+}
+
+var _ = Describe("Save product from template", func() {
+	var (
+		productName = "test-1"
+		gitOptions  = &GitGroupOptions{
+			Gitlab: &GroupOptions{
+				Name: "test-1",
+				Path: "test-2",
+			},
+		}
+		deployKey = &ProjectDeployKey{
+			ID:  2013,
+			Key: "Fingerprint",
+		}
+		codeRepo   *MockCodeRepo
+		secretRepo *MockSecretrepo
+		templates  *fakeTemplateApplier
+	)
+
+	newProductUsecase := func() *ProductUsecase {
+		gitRepo := NewMockGitRepo(ctl)
+		nodesTree := nodestree.NewMockNodesTree(ctl)
+		nodesTree.EXPECT().AppendOperators(gomock.Any())
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nodesTree, nautesConfigs, nil)
+		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodesTree, nautesConfigs, resourcesUsecase, nil)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
+		product.templates = templates
+		return product
+	}
+
+	expectSaveProduct := func() {
+		codeRepo.EXPECT().CreateGroup(gomock.Any(), gitOptions).Return(defaultProductGroup, nil)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), defaultProjectPath).Return(defautlProject, nil)
+		codeRepo.EXPECT().GetDeployKey(gomock.Any(), int(defautlProject.Id), deployKey.ID).Return(deployKey, nil)
+		secretRepo.EXPECT().GetDeployKey(gomock.Any(), gomock.Any()).Return(&DeployKeySecretData{ID: deployKey.ID, Fingerprint: deployKey.Key}, nil)
+		secretRepo.EXPECT().AuthorizationSecret(gomock.Any(), gomock.Eq(int(defautlProject.Id)), _ProductDestUser).Return(nil)
+	}
+
+	BeforeEach(func() {
+		codeRepo = NewMockCodeRepo(ctl)
+		secretRepo = NewMockSecretrepo(ctl)
+		templates = &fakeTemplateApplier{result: &ChangeResult{Commit: headCommit}}
+	})
+
+	It("creates a new product from the default template", func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), productName).Return(nil, ErrorGroupNotFound).Times(2)
+		expectSaveProduct()
+
+		options := &ProductTemplateOptions{RepoNames: map[string]string{"manifests": "test-1-manifests"}}
+		template, result, err := newProductUsecase().SaveProductFromTemplate(ctx, productName, gitOptions, options, "tpl")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(template).Should(Equal("tpl"))
+		Expect(result.Commit).Should(Equal(headCommit))
+		Expect(templates.options.Template).Should(Equal("tpl"))
+		Expect(templates.options.RepoNames).Should(Equal(options.RepoNames))
+		Expect(options.Template).Should(BeEmpty())
+	})
+
+	It("prefers the requested template to the default template", func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), productName).Return(nil, ErrorGroupNotFound).Times(2)
+		expectSaveProduct()
+
+		template, _, err := newProductUsecase().SaveProductFromTemplate(ctx, productName, gitOptions, &ProductTemplateOptions{Template: "other"}, "tpl")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(template).Should(Equal("other"))
+		Expect(templates.options.Template).Should(Equal("other"))
+	})
+
+	It("does not copy the default template to an existing product", func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), productName).Return(defaultProductGroup, nil)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), defaultProjectPath).Return(defautlProject, nil)
+
+		template, err := newProductUsecase().templateFor(ctx, productName, "", "tpl")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(template).Should(BeEmpty())
+	})
+
+	It("does not look up the product without a default template", func() {
+		template, err := newProductUsecase().templateFor(ctx, productName, "", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(template).Should(BeEmpty())
+	})
+
+	It("rejects the requested template for an existing product", func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), productName).Return(defaultProductGroup, nil)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), defaultProjectPath).Return(defautlProject, nil)
+
+		_, _, err := newProductUsecase().SaveProductFromTemplate(ctx, productName, gitOptions, &ProductTemplateOptions{Template: "tpl"}, "")
+		Expect(ErrorProductExists.Is(err)).Should(BeTrue())
+		Expect(templates.options).Should(BeNil())
+	})
+
+	It("keeps the reason of a template that cannot be copied", func() {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), productName).Return(nil, ErrorGroupNotFound).Times(2)
+		expectSaveProduct()
+		templates.err = ErrorInvalidApply.WithCause(fmt.Errorf("the template is broken"))
+
+		_, _, err := newProductUsecase().SaveProductFromTemplate(ctx, productName, gitOptions, &ProductTemplateOptions{Template: "tpl"}, "")
+		Expect(ErrorInvalidApply.Is(err)).Should(BeTrue())
+		Expect(err.Error()).Should(ContainSubstring("the product test-1 is saved"))
+	})
+})
+
 var _ = Describe("Delete product", func() {
 	var (
 		TestProject = &Project{
-			Id:                int32(297),
+			Id:                int64(297),
 			Name:              "test",
 			Path:              "test",
 			WebUrl:            "https://github.com/test-2/test",
@@ -403,11 +521,11 @@ var _ = Describe("Delete product", func() {
 
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		err := product.DeleteProduct(ctx, ProductID)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -422,13 +540,110 @@ var _ = Describe("Delete product", func() {
 
 		gitRepo := NewMockGitRepo(ctl)
 
-		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs)
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nil, nautesConfigs, nil)
 		nodestree := nodestree.NewMockNodesTree(ctl)
 		nodestree.EXPECT().AppendOperators(gomock.Any())
 		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourcesUsecase, nil)
-		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase)
+		product := NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
 
 		err := product.DeleteProduct(ctx, ProductID)
 		Expect(err).Should(HaveOccurred())
 	})
 })
+
+var _ = Describe("Delete product with its resources", func() {
+	var (
+		codeRepo   *MockCodeRepo
+		secretRepo *MockSecretrepo
+		gitRepo    *MockGitRepo
+		nodesTree  *nodestree.MockNodesTree
+		envName    = "env1"
+		nodes      = createContainEnvironmentNodes(createEnvironmentNode(createEnvironmentResource(envName)))
+		repository = &Project{Id: 1222, Path: "repo", PathWithNamespace: fmt.Sprintf("%s/repo", defaultProductGroup.Path)}
+	)
+
+	newProductUsecase := func() *ProductUsecase {
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, secretRepo, gitRepo, nodesTree, nautesConfigs, nil)
+		codeRepoUsecase := NewCodeRepoUsecase(logger, codeRepo, secretRepo, nodesTree, nautesConfigs, resourcesUsecase, nil)
+		return NewProductUsecase(logger, codeRepo, secretRepo, gitRepo, nautesConfigs, resourcesUsecase, codeRepoUsecase, nil)
+	}
+
+	reviewedPlan := func() *ProductDeletionPlan {
+		return &ProductDeletionPlan{
+			Group:    defaultProductGroup,
+			Projects: []*Project{defautlProject, repository},
+			SecretPaths: []string{
+				fmt.Sprintf("git/gitlab/repo-%d/default/readonly", defautlProject.Id),
+				fmt.Sprintf("git/gitlab/repo-%d/default/readonly", repository.Id),
+			},
+			Resources: []*ProductResource{{Kind: nodestree.Enviroment, Name: envName}},
+		}
+	}
+
+	BeforeEach(func() {
+		codeRepo = NewMockCodeRepo(ctl)
+		secretRepo = NewMockSecretrepo(ctl)
+		gitRepo = NewMockGitRepo(ctl)
+		nodesTree = nodestree.NewMockNodesTree(ctl)
+		nodesTree.EXPECT().AppendOperators(gomock.Any()).AnyTimes()
+
+		codeRepo.EXPECT().GetGroup(gomock.Any(), defaultGroupName).Return(defaultProductGroup, nil)
+		codeRepo.EXPECT().ListGroupCodeRepos(gomock.Any(), int(defaultProductGroup.Id), 1, _GroupProjectsPerPage).Return([]*Project{defautlProject, repository}, nil)
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil)
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(nodes, nil)
+	})
+
+	It("plans the deletion without deleting anything", func() {
+		plan, err := newProductUsecase().PlanProductDeletion(ctx, defaultGroupName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(plan.Projects).Should(Equal([]*Project{defautlProject, repository}))
+		Expect(plan.SecretPaths).Should(Equal([]string{
+			fmt.Sprintf("git/gitlab/repo-%d/default/readonly", defautlProject.Id),
+			fmt.Sprintf("git/gitlab/repo-%d/default/readonly", repository.Id),
+		}))
+		Expect(plan.Resources).Should(Equal([]*ProductResource{{Kind: nodestree.Enviroment, Name: envName}}))
+		Expect(plan.Fingerprint()).Should(Equal(reviewedPlan().Fingerprint()))
+	})
+
+	It("continues past the parts that cannot be deleted", func() {
+		secretRepo.EXPECT().DeleteSecret(gomock.Any(), int(defautlProject.Id)).Return(nil)
+		secretRepo.EXPECT().DeleteSecret(gomock.Any(), int(repository.Id)).Return(fmt.Errorf("vault is sealed"))
+		codeRepo.EXPECT().DeleteCodeRepo(gomock.Any(), int(defautlProject.Id)).Return(fmt.Errorf("forbidden"))
+		codeRepo.EXPECT().DeleteCodeRepo(gomock.Any(), int(repository.Id)).Return(nil)
+		codeRepo.EXPECT().DeleteGroup(gomock.Any(), int(defaultProductGroup.Id)).Return(nil)
+
+		plan, failures, err := newProductUsecase().DeleteProductCascade(ctx, defaultGroupName, reviewedPlan().Fingerprint())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(plan.Projects).Should(HaveLen(2))
+		Expect(failures).Should(HaveLen(2))
+		Expect(failures[0].Target).Should(Equal(fmt.Sprintf("repository %s", defautlProject.PathWithNamespace)))
+		Expect(failures[1].Target).Should(Equal(fmt.Sprintf("secret git/gitlab/repo-%d/default/readonly", repository.Id)))
+	})
+
+	It("still deletes the group when a repository cannot be deleted, and reports both", func() {
+		secretRepo.EXPECT().DeleteSecret(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		codeRepo.EXPECT().DeleteCodeRepo(gomock.Any(), int(defautlProject.Id)).Return(nil)
+		codeRepo.EXPECT().DeleteCodeRepo(gomock.Any(), int(repository.Id)).Return(fmt.Errorf("forbidden"))
+		codeRepo.EXPECT().DeleteGroup(gomock.Any(), int(defaultProductGroup.Id)).Return(fmt.Errorf("the group is not empty"))
+
+		_, failures, err := newProductUsecase().DeleteProductCascade(ctx, defaultGroupName, reviewedPlan().Fingerprint())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(failures).Should(HaveLen(2))
+		Expect(failures[0].Target).Should(Equal(fmt.Sprintf("repository %s", repository.PathWithNamespace)))
+		Expect(failures[0].Err).Should(MatchError("forbidden"))
+		Expect(failures[1].Target).Should(Equal(fmt.Sprintf("group %s", defaultProductGroup.Path)))
+		Expect(failures[1].Err).Should(MatchError("the group is not empty"))
+	})
+
+	It("deletes nothing when the plan has changed since it was reviewed", func() {
+		changed := reviewedPlan()
+		changed.Projects = changed.Projects[:1]
+		changed.SecretPaths = changed.SecretPaths[:1]
+
+		plan, failures, err := newProductUsecase().DeleteProductCascade(ctx, defaultGroupName, changed.Fingerprint())
+		Expect(ErrorPlanChanged.Is(err)).Should(BeTrue())
+		Expect(plan.Projects).Should(HaveLen(2))
+		Expect(failures).Should(BeEmpty())
+	})
+})
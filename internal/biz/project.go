@@ -102,7 +102,12 @@ func (p *ProjectUsecase) ListProjects(ctx context.Context, productName string) (
 		return nil, err
 	}
 
-	projects, err := p.listProjects(*nodes)
+	return p.projectsFromNodes(ctx, *nodes)
+}
+
+// projectsFromNodes converts the projects in the nodes of the product.
+func (p *ProjectUsecase) projectsFromNodes(ctx context.Context, nodes nodestree.Node) ([]*resourcev1alpha1.Project, error) {
+	projects, err := p.listProjects(nodes)
 	if err != nil {
 		return nil, err
 	}
@@ -132,10 +137,10 @@ func (p *ProjectUsecase) listProjects(nodes nodestree.Node) ([]*resourcev1alpha1
 	return projects, nil
 }
 
-func (p *ProjectUsecase) SaveProject(ctx context.Context, options *BizOptions, data *ProjectData) error {
+func (p *ProjectUsecase) SaveProject(ctx context.Context, options *BizOptions, data *ProjectData) (*ChangeResult, error) {
 	group, err := p.codeRepo.GetGroup(ctx, options.ProductName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	data.ProductName = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
@@ -144,14 +149,56 @@ func (p *ProjectUsecase) SaveProject(ctx context.Context, options *BizOptions, d
 		resourceName:      options.ResouceName,
 		productName:       options.ProductName,
 		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
 		operator:          p,
 	}
-	err = p.resourcesUsecase.Save(ctx, resourceOptions, data)
+	result, err := p.resourcesUsecase.Save(ctx, resourceOptions, data)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return result, nil
+}
+
+func (p *ProjectUsecase) prepareApply(ctx context.Context, group *Group, options *BizOptions, resource *ApplyResource) (string, error) {
+	data, ok := resource.Data.(*ProjectData)
+	if !ok {
+		return "", ErrorInvalidApply.WithCause(fmt.Errorf("the data of project %s is invalid", resource.Name))
+	}
+
+	data.ProductName = fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id))
+
+	return data.ProjectName, nil
+}
+
+func (p *ProjectUsecase) GetProjectHistory(ctx context.Context, projectName, productName string) ([]*Commit, error) {
+	return p.resourcesUsecase.History(ctx, nodestree.Project, productName, func(nodes nodestree.Node) (string, error) {
+		return projectName, nil
+	})
+}
+
+// RollbackProject saves the project as it was in the revision.
+func (p *ProjectUsecase) RollbackProject(ctx context.Context, options *BizOptions, revision string) (*ChangeResult, error) {
+	options.CommitMessage = rollbackMessage(revision, options.CommitMessage)
+	node, err := p.resourcesUsecase.GetRevision(ctx, nodestree.Project, options.ProductName, p, func(nodes nodestree.Node) (string, error) {
+		return options.ResouceName, nil
+	}, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := p.nodeToProject(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.SaveProject(ctx, options, &ProjectData{
+		ProjectName: project.Name,
+		Language:    project.Spec.Language,
+	})
 }
 
 func (p *ProjectUsecase) CreateNode(path string, data interface{}) (*nodestree.Node, error) {
@@ -247,19 +294,23 @@ func (p *ProjectUsecase) CreateResource(kind string) interface{} {
 	return &resourcev1alpha1.Project{}
 }
 
-func (p *ProjectUsecase) DeleteProject(ctx context.Context, options *BizOptions) error {
+func (p *ProjectUsecase) DeleteProject(ctx context.Context, options *BizOptions) (*ChangeResult, error) {
 	resourceOptions := &resourceOptions{
 		resourceKind:      nodestree.Project,
 		productName:       options.ProductName,
 		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
 		operator:          p,
 	}
-	err := p.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
+	result, err := p.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
 		return options.ResouceName, nil
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return result, nil
 }
@@ -157,49 +157,49 @@ var _ = Describe("Save project", func() {
 
 	It("failed to get product info", testUseCase.GetProductFail(func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourceUseCase)
-		err := biz.SaveProject(context.Background(), bizOptions, projectData)
+		_, err := biz.SaveProject(context.Background(), bizOptions, projectData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("failed to get default project info", testUseCase.GetDefaultProjectFail(func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourceUseCase)
-		err := biz.SaveProject(context.Background(), bizOptions, projectData)
+		_, err := biz.SaveProject(context.Background(), bizOptions, projectData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("will created successfully", testUseCase.CreateResourceSuccess(fakeNodes, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourceUseCase)
-		err := biz.SaveProject(context.Background(), bizOptions, projectData)
+		_, err := biz.SaveProject(context.Background(), bizOptions, projectData)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
 	It("will updated successfully", testUseCase.UpdateResoureSuccess(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourceUseCase)
-		err := biz.SaveProject(context.Background(), bizOptions, projectData)
+		_, err := biz.SaveProject(context.Background(), bizOptions, projectData)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
 	It("failed to auto merge conflict", testUseCase.MergeConflictFail(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourceUseCase)
-		err := biz.SaveProject(context.Background(), bizOptions, projectData)
+		_, err := biz.SaveProject(context.Background(), bizOptions, projectData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("failed to push code retry three times", testUseCase.CreateResourceAndAutoRetry(fakeNodes, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourceUseCase)
-		err := biz.SaveProject(context.Background(), bizOptions, projectData)
+		_, err := biz.SaveProject(context.Background(), bizOptions, projectData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("modify resource but non compliant layout", testUseCase.UpdateResourceButNotConformTemplate(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourceUseCase)
-		err := biz.SaveProject(context.Background(), bizOptions, projectData)
+		_, err := biz.SaveProject(context.Background(), bizOptions, projectData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
 	It("failed to save config", testUseCase.SaveConfigFail(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourceUseCase)
-		err := biz.SaveProject(context.Background(), bizOptions, projectData)
+		_, err := biz.SaveProject(context.Background(), bizOptions, projectData)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -234,13 +234,13 @@ var _ = Describe("Delete project", func() {
 
 	It("will deleted successfully", testUseCase.DeleteResourceSuccess(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourceUseCase)
-		err := biz.DeleteProject(context.Background(), bizOptions)
+		_, err := biz.DeleteProject(context.Background(), bizOptions)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
 	It("modify resource but non compliant layout standards", testUseCase.DeleteResourceErrorLayout(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectUsecase(logger, codeRepo, nil, nodestree, nautesConfigs, resourceUseCase)
-		err := biz.DeleteProject(context.Background(), bizOptions)
+		_, err := biz.DeleteProject(context.Background(), bizOptions)
 		Expect(err).Should(HaveOccurred())
 	}))
 })
@@ -94,19 +94,24 @@ func (p *ProjectPipelineRuntimeUsecase) GetProjectPipelineRuntime(ctx context.Co
 }
 
 func (p *ProjectPipelineRuntimeUsecase) ListProjectPipelineRuntimes(ctx context.Context, productName string) ([]*resourcev1alpha1.ProjectPipelineRuntime, error) {
-	var runtimes []*resourcev1alpha1.ProjectPipelineRuntime
-
 	resourceNodes, err := p.resourcesUsecase.List(ctx, productName, p)
 	if err != nil {
 		return nil, err
 	}
 
-	nodes := nodestree.ListsResourceNodes(*resourceNodes, nodestree.ProjectPipelineRuntime)
+	return p.projectPipelineRuntimesFromNodes(ctx, *resourceNodes)
+}
+
+// projectPipelineRuntimesFromNodes converts the project pipeline runtimes in the nodes of the product.
+func (p *ProjectPipelineRuntimeUsecase) projectPipelineRuntimesFromNodes(ctx context.Context, resourceNodes nodestree.Node) ([]*resourcev1alpha1.ProjectPipelineRuntime, error) {
+	var runtimes []*resourcev1alpha1.ProjectPipelineRuntime
+
+	nodes := nodestree.ListsResourceNodes(resourceNodes, nodestree.ProjectPipelineRuntime)
 	for _, node := range nodes {
 		if node.Kind == nodestree.ProjectPipelineRuntime && !node.IsDir {
 			runtime, ok := node.Content.(*resourcev1alpha1.ProjectPipelineRuntime)
 			if ok {
-				err = p.convertCodeRepoToRepoName(ctx, runtime)
+				err := p.convertCodeRepoToRepoName(ctx, runtime)
 				if err != nil {
 					return nil, err
 				}
@@ -118,11 +123,36 @@ func (p *ProjectPipelineRuntimeUsecase) ListProjectPipelineRuntimes(ctx context.
 	return runtimes, nil
 }
 
-func (p *ProjectPipelineRuntimeUsecase) SaveProjectPipelineRuntime(ctx context.Context, options *BizOptions, data *ProjectPipelineRuntimeData) error {
-	project, err := p.resourcesUsecase.GetCodeRepo(ctx, options.ProductName, data.Spec.PipelineSource)
+func (p *ProjectPipelineRuntimeUsecase) SaveProjectPipelineRuntime(ctx context.Context, options *BizOptions, data *ProjectPipelineRuntimeData) (*ChangeResult, error) {
+	err := p.convertCodeRepos(ctx, options.ProductName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceOptions := &resourceOptions{
+		resourceKind:      nodestree.ProjectPipelineRuntime,
+		productName:       options.ProductName,
+		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
+		operator:          p,
+	}
+	result, err := p.resourcesUsecase.Save(ctx, resourceOptions, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// convertCodeRepos replaces the pipeline source and code source names of the pipeline runtime with their resource names.
+func (p *ProjectPipelineRuntimeUsecase) convertCodeRepos(ctx context.Context, productName string, data *ProjectPipelineRuntimeData) error {
+	project, err := p.resourcesUsecase.GetCodeRepo(ctx, productName, data.Spec.PipelineSource)
 	if err != nil {
 		if ok := commonv1.IsProjectNotFound(err); ok {
-			return projectpipelineruntimev1.ErrorPipelineResourceNotFound("failed to get repository please check pipeline source %s or product %s valid", data.Spec.PipelineSource, options.ProductName)
+			return projectpipelineruntimev1.ErrorPipelineResourceNotFound("failed to get repository please check pipeline source %s or product %s valid", data.Spec.PipelineSource, productName)
 		} else {
 			return err
 		}
@@ -132,7 +162,7 @@ func (p *ProjectPipelineRuntimeUsecase) SaveProjectPipelineRuntime(ctx context.C
 
 	if len(data.Spec.CodeSources) > 0 {
 		for i, source := range data.Spec.CodeSources {
-			project, err := p.resourcesUsecase.GetCodeRepo(ctx, options.ProductName, source)
+			project, err := p.resourcesUsecase.GetCodeRepo(ctx, productName, source)
 			if err != nil {
 				return fmt.Errorf("failed to get repository please check codeRepo source or product name, err: %w", err)
 			}
@@ -141,35 +171,75 @@ func (p *ProjectPipelineRuntimeUsecase) SaveProjectPipelineRuntime(ctx context.C
 		}
 	}
 
-	resourceOptions := &resourceOptions{
-		resourceKind:      nodestree.ProjectPipelineRuntime,
-		productName:       options.ProductName,
-		insecureSkipCheck: options.InsecureSkipCheck,
-		operator:          p,
+	return nil
+}
+
+func (p *ProjectPipelineRuntimeUsecase) prepareApply(ctx context.Context, group *Group, options *BizOptions, resource *ApplyResource) (string, error) {
+	data, ok := resource.Data.(*ProjectPipelineRuntimeData)
+	if !ok {
+		return "", ErrorInvalidApply.WithCause(fmt.Errorf("the data of project pipeline runtime %s is invalid", resource.Name))
 	}
-	err = p.resourcesUsecase.Save(ctx, resourceOptions, data)
+
+	err := p.convertCodeRepos(ctx, options.ProductName, data)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	return data.Name, nil
 }
 
-func (p *ProjectPipelineRuntimeUsecase) DeleteProjectPipelineRuntime(ctx context.Context, options *BizOptions) error {
+func (p *ProjectPipelineRuntimeUsecase) DeleteProjectPipelineRuntime(ctx context.Context, options *BizOptions) (*ChangeResult, error) {
 	resourceOptions := &resourceOptions{
 		resourceKind:      nodestree.ProjectPipelineRuntime,
 		productName:       options.ProductName,
 		insecureSkipCheck: options.InsecureSkipCheck,
+		resourceVersion:   options.ResourceVersion,
+		dryRun:            options.DryRun,
+		proposal:          options.Proposal,
+		commitMessage:     options.CommitMessage,
 		operator:          p,
 	}
-	err := p.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
+	result, err := p.resourcesUsecase.Delete(ctx, resourceOptions, func(nodes nodestree.Node) (string, error) {
 		return options.ResouceName, nil
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return result, nil
+}
+
+func (p *ProjectPipelineRuntimeUsecase) GetProjectPipelineRuntimeHistory(ctx context.Context, projectPipelineName, productName string) ([]*Commit, error) {
+	return p.resourcesUsecase.History(ctx, nodestree.ProjectPipelineRuntime, productName, func(nodes nodestree.Node) (string, error) {
+		return projectPipelineName, nil
+	})
+}
+
+// RollbackProjectPipelineRuntime saves the project pipeline runtime as it was in the revision,
+// the code repos it references must still exist.
+func (p *ProjectPipelineRuntimeUsecase) RollbackProjectPipelineRuntime(ctx context.Context, options *BizOptions, revision string) (*ChangeResult, error) {
+	options.CommitMessage = rollbackMessage(revision, options.CommitMessage)
+	node, err := p.resourcesUsecase.GetRevision(ctx, nodestree.ProjectPipelineRuntime, options.ProductName, p, func(nodes nodestree.Node) (string, error) {
+		return options.ResouceName, nil
+	}, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, ok := node.Content.(*resourcev1alpha1.ProjectPipelineRuntime)
+	if !ok {
+		return nil, fmt.Errorf("the resource %s type is inconsistent", options.ResouceName)
+	}
+
+	err = p.convertCodeRepoToRepoName(ctx, runtime)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.SaveProjectPipelineRuntime(ctx, options, &ProjectPipelineRuntimeData{
+		Name: runtime.Name,
+		Spec: runtime.Spec,
+	})
 }
 
 func (p *ProjectPipelineRuntimeUsecase) CreateNode(path string, data interface{}) (*nodestree.Node, error) {
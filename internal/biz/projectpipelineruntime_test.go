@@ -206,7 +206,7 @@ var _ = Describe("Save project pipeline runtime", func() {
 
 	It("failed to get product info", testUseCase.GetProductFail(func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
+		_, err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -215,7 +215,7 @@ var _ = Describe("Save project pipeline runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(codeRepoSourcePath)).Return(codeRepoSouceProject, nil)
 
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
+		_, err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -224,7 +224,7 @@ var _ = Describe("Save project pipeline runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(codeRepoSourcePath)).Return(codeRepoSouceProject, nil)
 
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
+		_, err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
@@ -233,7 +233,7 @@ var _ = Describe("Save project pipeline runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(codeRepoSourcePath)).Return(codeRepoSouceProject, nil)
 
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
+		_, err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
@@ -242,7 +242,7 @@ var _ = Describe("Save project pipeline runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(codeRepoSourcePath)).Return(codeRepoSouceProject, nil)
 
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
+		_, err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
@@ -251,7 +251,7 @@ var _ = Describe("Save project pipeline runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(codeRepoSourcePath)).Return(codeRepoSouceProject, nil)
 
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
+		_, err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -260,7 +260,7 @@ var _ = Describe("Save project pipeline runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(codeRepoSourcePath)).Return(codeRepoSouceProject, nil)
 
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
+		_, err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -269,7 +269,7 @@ var _ = Describe("Save project pipeline runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(codeRepoSourcePath)).Return(codeRepoSouceProject, nil)
 
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
+		_, err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -278,7 +278,7 @@ var _ = Describe("Save project pipeline runtime", func() {
 		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(codeRepoSourcePath)).Return(codeRepoSouceProject, nil)
 
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
+		_, err := biz.SaveProjectPipelineRuntime(context.Background(), bizOptions, data)
 		Expect(err).Should(HaveOccurred())
 	}))
 
@@ -442,13 +442,13 @@ var _ = Describe("Delete project pipeline runtime", func() {
 
 	It("will deleted successfully", testUseCase.DeleteResourceSuccess(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.DeleteProjectPipelineRuntime(context.Background(), bizOptions)
+		_, err := biz.DeleteProjectPipelineRuntime(context.Background(), bizOptions)
 		Expect(err).ShouldNot(HaveOccurred())
 	}))
 
 	It("modify resource but non compliant layout standards", testUseCase.DeleteResourceErrorLayout(fakeNodes, fakeNode, func(codeRepo *MockCodeRepo, secretRepo *MockSecretrepo, resourceUseCase *ResourcesUsecase, nodestree *nodestree.MockNodesTree, gitRepo *MockGitRepo, client *kubernetes.MockClient) {
 		biz := NewProjectPipelineRuntimeUsecase(logger, codeRepo, nodestree, resourceUseCase)
-		err := biz.DeleteProjectPipelineRuntime(context.Background(), bizOptions)
+		_, err := biz.DeleteProjectPipelineRuntime(context.Background(), bizOptions)
 		Expect(err).Should(HaveOccurred())
 	}))
 })
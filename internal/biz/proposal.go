@@ -0,0 +1,135 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	_MainBranch           = "main"
+	_ProposalBranchPrefix = "proposal/"
+	_ProposalStateOpened  = "opened"
+)
+
+// ProposalUsecase manages the merge requests opened for changes of product resources.
+type ProposalUsecase struct {
+	log              *log.Helper
+	codeRepo         CodeRepo
+	resourcesUsecase *ResourcesUsecase
+}
+
+func NewProposalUsecase(logger log.Logger, codeRepo CodeRepo, resourcesUsecase *ResourcesUsecase) *ProposalUsecase {
+	return &ProposalUsecase{log: log.NewHelper(log.With(logger)), codeRepo: codeRepo, resourcesUsecase: resourcesUsecase}
+}
+
+func (p *ProposalUsecase) getProposer(ctx context.Context, productName string) (MergeRequestRepo, *Project, error) {
+	proposer, ok := p.codeRepo.(MergeRequestRepo)
+	if !ok {
+		return nil, nil, ErrorProposalNotSupported
+	}
+
+	_, project, err := p.resourcesUsecase.GetProductAndCodeRepo(ctx, productName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proposer, project, nil
+}
+
+// ListProposals lists the pending change proposals of the product.
+func (p *ProposalUsecase) ListProposals(ctx context.Context, productName string) ([]*MergeRequest, error) {
+	proposer, project, err := p.getProposer(ctx, productName)
+	if err != nil {
+		return nil, err
+	}
+
+	mergeRequests, err := proposer.ListMergeRequests(ctx, int(project.Id), _ProposalStateOpened)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []*MergeRequest
+	for _, mergeRequest := range mergeRequests {
+		if isProposal(mergeRequest) {
+			proposals = append(proposals, mergeRequest)
+		}
+	}
+
+	return proposals, nil
+}
+
+// GetProposal gets a change proposal of the product, merge requests not opened by the api server are not proposals.
+func (p *ProposalUsecase) GetProposal(ctx context.Context, productName string, proposalID int) (*MergeRequest, error) {
+	proposer, project, err := p.getProposer(ctx, productName)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getProposal(ctx, proposer, project, proposalID)
+}
+
+func (p *ProposalUsecase) getProposal(ctx context.Context, proposer MergeRequestRepo, project *Project, proposalID int) (*MergeRequest, error) {
+	mergeRequest, err := proposer.GetMergeRequest(ctx, int(project.Id), proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isProposal(mergeRequest) {
+		return nil, ErrorProposalNotFound
+	}
+
+	return mergeRequest, nil
+}
+
+func (p *ProposalUsecase) ApproveProposal(ctx context.Context, productName string, proposalID int) (*MergeRequest, error) {
+	proposer, project, err := p.getProposer(ctx, productName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = p.getProposal(ctx, proposer, project, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = proposer.ApproveMergeRequest(ctx, int(project.Id), proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getProposal(ctx, proposer, project, proposalID)
+}
+
+func (p *ProposalUsecase) MergeProposal(ctx context.Context, productName string, proposalID int) (*MergeRequest, error) {
+	proposer, project, err := p.getProposer(ctx, productName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = p.getProposal(ctx, proposer, project, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposer.AcceptMergeRequest(ctx, int(project.Id), proposalID)
+}
+
+func isProposal(mergeRequest *MergeRequest) bool {
+	return strings.HasPrefix(mergeRequest.SourceBranch, _ProposalBranchPrefix) && mergeRequest.TargetBranch == _MainBranch
+}
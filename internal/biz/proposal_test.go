@@ -0,0 +1,139 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type proposalCodeRepo struct {
+	*MockCodeRepo
+	*MockMergeRequestRepo
+}
+
+var _ = Describe("Change proposals", func() {
+	var (
+		codeRepo         *MockCodeRepo
+		mergeRequestRepo *MockMergeRequestRepo
+		gitRepo          *MockGitRepo
+		proposal         = &MergeRequest{
+			ID:           1,
+			Title:        "api: save Environment env1",
+			State:        _ProposalStateOpened,
+			SourceBranch: _ProposalBranchPrefix + "1",
+			TargetBranch: _MainBranch,
+		}
+		otherMergeRequest = &MergeRequest{
+			ID:           2,
+			State:        _ProposalStateOpened,
+			SourceBranch: "feature",
+			TargetBranch: _MainBranch,
+		}
+	)
+
+	newResourcesUsecase := func() *ResourcesUsecase {
+		nodestree := nodestree.NewMockNodesTree(ctl)
+		return NewResourcesUsecase(logger, &proposalCodeRepo{codeRepo, mergeRequestRepo}, nil, gitRepo, nodestree, nautesConfigs, nil)
+	}
+
+	newProposalUsecase := func() *ProposalUsecase {
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil)
+		resourcesUsecase := newResourcesUsecase()
+		return NewProposalUsecase(logger, resourcesUsecase.codeRepo, resourcesUsecase)
+	}
+
+	BeforeEach(func() {
+		codeRepo = NewMockCodeRepo(ctl)
+		mergeRequestRepo = NewMockMergeRequestRepo(ctl)
+		gitRepo = NewMockGitRepo(ctl)
+	})
+
+	It("will push the changes to a proposal branch and open a merge request", func() {
+		gitRepo.EXPECT().Commit(gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Eq(localRepositaryPath), "remotes/origin/main").Return(dryRunDiff, nil)
+		gitRepo.EXPECT().Push(gomock.Any(), gomock.Eq(localRepositaryPath), "origin", gomock.Any()).
+			DoAndReturn(func(_ interface{}, _ string, args ...string) error {
+				Expect(strings.HasPrefix(args[1], "HEAD:refs/heads/"+_ProposalBranchPrefix)).Should(BeTrue())
+				return nil
+			})
+		mergeRequestRepo.EXPECT().CreateMergeRequest(gomock.Any(), int(defautlProject.Id), gomock.Any()).
+			DoAndReturn(func(_ interface{}, _ interface{}, opt *MergeRequestOptions) (*MergeRequest, error) {
+				Expect(opt.TargetBranch).Should(Equal(_MainBranch))
+				Expect(strings.HasPrefix(opt.SourceBranch, _ProposalBranchPrefix)).Should(BeTrue())
+				return proposal, nil
+			})
+
+		result, err := newResourcesUsecase().proposeChanges(ctx, localRepositaryPath, defautlProject, proposal.Title)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Diff).Should(Equal(dryRunDiff))
+		Expect(result.Proposal).Should(Equal(proposal))
+	})
+
+	It("will not open a merge request when nothing changed", func() {
+		gitRepo.EXPECT().Commit(gomock.Eq(localRepositaryPath), gomock.Any()).Return(nil)
+		gitRepo.EXPECT().Diff(gomock.Any(), gomock.Eq(localRepositaryPath), "remotes/origin/main").Return("", nil)
+
+		result, err := newResourcesUsecase().proposeChanges(ctx, localRepositaryPath, defautlProject, proposal.Title)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Proposal).Should(BeNil())
+	})
+
+	It("is not supported when the code repo provider has no merge requests", func() {
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nil, nautesConfigs, nil)
+		_, err := resourcesUsecase.proposeChanges(ctx, localRepositaryPath, defautlProject, proposal.Title)
+		Expect(err).Should(Equal(ErrorProposalNotSupported))
+	})
+
+	It("will list only the merge requests opened as proposals", func() {
+		mergeRequestRepo.EXPECT().ListMergeRequests(gomock.Any(), int(defautlProject.Id), _ProposalStateOpened).Return([]*MergeRequest{proposal, otherMergeRequest}, nil)
+
+		proposals, err := newProposalUsecase().ListProposals(ctx, defaultGroupName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(proposals).Should(Equal([]*MergeRequest{proposal}))
+	})
+
+	It("will not get a merge request which is not a proposal", func() {
+		mergeRequestRepo.EXPECT().GetMergeRequest(gomock.Any(), int(defautlProject.Id), otherMergeRequest.ID).Return(otherMergeRequest, nil)
+
+		_, err := newProposalUsecase().GetProposal(ctx, defaultGroupName, otherMergeRequest.ID)
+		Expect(err).Should(Equal(ErrorProposalNotFound))
+	})
+
+	It("will approve the proposal", func() {
+		mergeRequestRepo.EXPECT().GetMergeRequest(gomock.Any(), int(defautlProject.Id), proposal.ID).Return(proposal, nil).Times(2)
+		mergeRequestRepo.EXPECT().ApproveMergeRequest(gomock.Any(), int(defautlProject.Id), proposal.ID).Return(nil)
+
+		result, err := newProposalUsecase().ApproveProposal(ctx, defaultGroupName, proposal.ID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).Should(Equal(proposal))
+	})
+
+	It("will merge the proposal", func() {
+		merged := *proposal
+		merged.State = "merged"
+		mergeRequestRepo.EXPECT().GetMergeRequest(gomock.Any(), int(defautlProject.Id), proposal.ID).Return(proposal, nil)
+		mergeRequestRepo.EXPECT().AcceptMergeRequest(gomock.Any(), int(defautlProject.Id), proposal.ID).Return(&merged, nil)
+
+		result, err := newProposalUsecase().MergeProposal(ctx, defaultGroupName, proposal.ID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.State).Should(Equal("merged"))
+	})
+})
@@ -16,6 +16,8 @@ package biz
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -29,40 +31,53 @@ import (
 	utilstrings "github.com/nautes-labs/api-server/util/string"
 	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
 	sjson "github.com/tidwall/sjson"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kustomize "sigs.k8s.io/kustomize/api/types"
 	"sigs.k8s.io/yaml"
 )
 
 const (
-	KustomizationFileName                 = "kustomization.yaml"
-	RretryCount           RretryCountType = "RretryCount"
-	_ProductPrefix                        = "product-"
+	KustomizationFileName                   = "kustomization.yaml"
+	RretryCount           RretryCountType   = "RretryCount"
+	_ResourceFile         resourceFileType  = "ResourceFile"
+	_Revision             revisionType      = "Revision"
+	_CommitMessage        commitMessageType = "CommitMessage"
+	_Violations           violationsType    = "Violations"
+	_ProductPrefix                          = "product-"
+	_DefaultCommitMessage                   = "api: saved configuration"
 )
 
 type RretryCountType string
+type resourceFileType string
+type revisionType string
+type commitMessageType string
+type violationsType string
 type getResouceName func(nodes nodestree.Node) (string, error)
 type isDeleteAllowed func(nodes nodestree.Node, resourceName string) (bool, error)
 
 type ResourcesUsecase struct {
-	log        log.Logger
-	codeRepo   CodeRepo
-	secretRepo Secretrepo
-	gitRepo    GitRepo
-	nodestree  nodestree.NodesTree
-	configs    *nautesconfigs.Config
+	log            log.Logger
+	codeRepo       CodeRepo
+	secretRepo     Secretrepo
+	gitRepo        GitRepo
+	nodestree      nodestree.NodesTree
+	configs        *nautesconfigs.Config
+	proposalPolicy *ProposalPolicy
 }
 
-func NewResourcesUsecase(log log.Logger, codeRepo CodeRepo, secretRepo Secretrepo, gitRepo GitRepo, nodestree nodestree.NodesTree, configs *nautesconfigs.Config) *ResourcesUsecase {
+func NewResourcesUsecase(log log.Logger, codeRepo CodeRepo, secretRepo Secretrepo, gitRepo GitRepo, nodestree nodestree.NodesTree, configs *nautesconfigs.Config, proposalPolicy *ProposalPolicy) *ResourcesUsecase {
 	return &ResourcesUsecase{
-		log:        log,
-		codeRepo:   codeRepo,
-		secretRepo: secretRepo,
-		gitRepo:    gitRepo,
-		nodestree:  nodestree,
-		configs:    configs,
+		log:            log,
+		codeRepo:       codeRepo,
+		secretRepo:     secretRepo,
+		gitRepo:        gitRepo,
+		nodestree:      nodestree,
+		configs:        configs,
+		proposalPolicy: proposalPolicy,
 	}
 }
 
+// Get gets the resource from main, or from the revision set by WithRevision.
 func (r *ResourcesUsecase) Get(ctx context.Context, resourceKind, productName string, operator nodestree.NodesOperator, getResourceName getResouceName) (*nodestree.Node, error) {
 	product, project, err := r.GetProductAndCodeRepo(ctx, productName)
 	if err != nil {
@@ -78,18 +93,7 @@ func (r *ResourcesUsecase) Get(ctx context.Context, resourceKind, productName st
 		cleanCodeRepo(path)
 	}(localPath)
 
-	nodes, err := r.nodestree.Load(localPath)
-	if err != nil {
-		return nil, err
-	}
-
-	options := nodestree.CompareOptions{
-		Nodes:            nodes,
-		ProductName:      fmt.Sprintf("%s%d", _ProductPrefix, int(product.Id)),
-		LocalProjectPath: localPath,
-	}
-
-	err = r.nodestree.Compare(options)
+	nodes, err := r.loadRevision(ctx, localPath, product)
 	if err != nil {
 		return nil, err
 	}
@@ -104,9 +108,12 @@ func (r *ResourcesUsecase) Get(ctx context.Context, resourceKind, productName st
 		return nil, ErrorResourceNoFound
 	}
 
+	setResourceVersion(resourceNode)
+
 	return resourceNode, nil
 }
 
+// List lists the resources of main, or of the revision set by WithRevision.
 func (r *ResourcesUsecase) List(ctx context.Context, productName string, operator nodestree.NodesOperator) (*nodestree.Node, error) {
 	product, project, err := r.GetProductAndCodeRepo(ctx, productName)
 	if err != nil {
@@ -122,11 +129,45 @@ func (r *ResourcesUsecase) List(ctx context.Context, productName string, operato
 		cleanCodeRepo(path)
 	}(localPath)
 
-	nodes, err := r.nodestree.Load(localPath)
+	nodes, err := r.loadRevision(ctx, localPath, product)
 	if err != nil {
 		return nil, err
 	}
 
+	setResourceVersions(&nodes)
+
+	return &nodes, nil
+}
+
+// loadRevision loads the resources of the clone, after checking out the revision set by WithRevision.
+// The resources of main are validated, those of an older revision are not,
+// because the resources they refer to may have been changed or removed since.
+// With WithViolations, the files that fail to load or validate are left out instead of failing the read.
+func (r *ResourcesUsecase) loadRevision(ctx context.Context, localPath string, product *Group) (nodestree.Node, error) {
+	revision := getRevision(ctx)
+	if revision != "" {
+		err := r.gitRepo.Checkout(ctx, localPath, revision)
+		if err != nil {
+			return nodestree.Node{}, err
+		}
+	}
+
+	violations := getViolations(ctx)
+
+	nodes, err := r.nodestree.Load(localPath)
+	if err != nil && violations != nil {
+		var parseViolations []nodestree.Violation
+		nodes, parseViolations, err = r.nodestree.LoadWithViolations(localPath)
+		*violations = append(*violations, parseViolations...)
+	}
+	if err != nil {
+		return nodestree.Node{}, err
+	}
+
+	if revision != "" {
+		return nodes, nil
+	}
+
 	options := nodestree.CompareOptions{
 		Nodes:            nodes,
 		ProductName:      fmt.Sprintf("%s%d", _ProductPrefix, int(product.Id)),
@@ -134,11 +175,146 @@ func (r *ResourcesUsecase) List(ctx context.Context, productName string, operato
 	}
 
 	err = r.nodestree.Compare(options)
+	if err != nil && violations != nil {
+		found, validateErr := r.nodestree.Validate(options)
+		if validateErr == nil && len(found) > 0 {
+			nodestree.ExcludeViolations(&nodes, found)
+			*violations = append(*violations, found...)
+			err = nil
+		}
+	}
+	if err != nil {
+		return nodestree.Node{}, err
+	}
+
+	return nodes, nil
+}
+
+// History lists the commits of the product repository that touched the resource file, the newest first.
+func (r *ResourcesUsecase) History(ctx context.Context, resourceKind, productName string, getResourceName getResouceName) ([]*Commit, error) {
+	var commits []*Commit
+	err := r.withResourceFile(ctx, resourceKind, productName, getResourceName, "", func(localPath, file string, resourceNode *nodestree.Node) (err error) {
+		commits, err = r.gitRepo.Log(ctx, localPath, file)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &nodes, nil
+	return commits, nil
+}
+
+// GetRevision gets the resource as its file was in the revision of the product repository,
+// the resource may have been deleted since.
+func (r *ResourcesUsecase) GetRevision(ctx context.Context, resourceKind, productName string, operator nodestree.NodesOperator, getResourceName getResouceName, revision string) (*nodestree.Node, error) {
+	var node *nodestree.Node
+	err := r.withResourceFile(ctx, resourceKind, productName, getResourceName, revision, func(localPath, file string, resourceNode *nodestree.Node) error {
+		content, err := r.gitRepo.Show(ctx, localPath, revision, file)
+		if err != nil {
+			return err
+		}
+
+		jsonData, err := yaml.YAMLToJSON(content)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s in revision %s to json, err: %w", file, revision, err)
+		}
+
+		resource := operator.CreateResource(resourceKind)
+		if resource == nil {
+			return fmt.Errorf("unable to create %s type instance resource", resourceKind)
+		}
+
+		err = json.Unmarshal(jsonData, resource)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal %s in revision %s, err: %w", file, revision, err)
+		}
+
+		node = &nodestree.Node{
+			Name:    resourceNode.Name,
+			Path:    resourceNode.Path,
+			Kind:    resourceNode.Kind,
+			Level:   resourceNode.Level,
+			Content: resource,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// withResourceFile clones the product repository and calls fn with the path of the resource file relative to the clone.
+// The resource is looked up in main, or in the revision when it does not exist in main any more.
+func (r *ResourcesUsecase) withResourceFile(ctx context.Context, resourceKind, productName string, getResourceName getResouceName, revision string, fn func(localPath, file string, resourceNode *nodestree.Node) error) error {
+	_, project, err := r.GetProductAndCodeRepo(ctx, productName)
+	if err != nil {
+		return err
+	}
+
+	localPath, err := r.CloneCodeRepo(ctx, project.HttpUrlToRepo)
+	if err != nil {
+		return err
+	}
+
+	defer func(path string) {
+		cleanCodeRepo(path)
+	}(localPath)
+
+	nodes, err := r.nodestree.Load(localPath)
+	if err != nil {
+		return err
+	}
+
+	resourceName, err := getResourceName(nodes)
+	if err != nil {
+		return err
+	}
+
+	resourceNode := r.GetNode(&nodes, resourceKind, resourceName)
+	if resourceNode == nil && revision != "" {
+		resourceNode, err = r.getRevisionNode(ctx, localPath, resourceKind, getResourceName, revision)
+		if err != nil {
+			return err
+		}
+	}
+	if resourceNode == nil {
+		return ErrorResourceNoFound
+	}
+
+	file, err := filepath.Rel(localPath, resourceNode.Path)
+	if err != nil {
+		return err
+	}
+
+	return fn(localPath, filepath.ToSlash(file), resourceNode)
+}
+
+// getRevisionNode checks out the revision in the clone and finds the resource in it.
+func (r *ResourcesUsecase) getRevisionNode(ctx context.Context, localPath, resourceKind string, getResourceName getResouceName, revision string) (*nodestree.Node, error) {
+	err := r.gitRepo.Checkout(ctx, localPath, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := r.nodestree.Load(localPath)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceName, err := getResourceName(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceNode := r.GetNode(&nodes, resourceKind, resourceName)
+	if resourceNode == nil {
+		return nil, ErrorRevisionNotFound
+	}
+
+	return resourceNode, nil
 }
 
 type resourceOptions struct {
@@ -147,22 +323,60 @@ type resourceOptions struct {
 	productName       string
 	insecureSkipCheck bool
 	operator          nodestree.NodesOperator
+	resourceVersion   string
+	dryRun            bool
+	proposal          bool
+	commitMessage     string
+}
+
+// ChangeResult is the result of saving or deleting a resource.
+type ChangeResult struct {
+	// DryRun is true when the changes were checked and rendered but not pushed.
+	DryRun bool
+	// Yaml is the resource file after the change, it is empty when the resource is deleted.
+	Yaml string
+	// Diff is the unified diff of the changes against the remote repository.
+	Diff string
+	// Proposal is the merge request opened for the changes, it is only set in proposal mode.
+	Proposal *MergeRequest
+	// Commit is the SHA of the commit pushed to the branch, it is empty in dry run and proposal mode.
+	Commit string
+	// Branch is the branch the commit was pushed to.
+	Branch string
+	// CommitURL is the web URL of the commit on the git platform.
+	CommitURL string
+	// AutoMerged is true when the remote branch had moved on and the changes were merged into it automatically.
+	AutoMerged bool
+	// Warnings are the steps after the push that failed, the changes are pushed but the steps have to be retried.
+	Warnings []string
 }
 
 // Save create or update config to git platform
-func (r *ResourcesUsecase) Save(ctx context.Context, resourceOptions *resourceOptions, data interface{}) error {
+func (r *ResourcesUsecase) Save(ctx context.Context, resourceOptions *resourceOptions, data interface{}) (*ChangeResult, error) {
 	var resourceNode *nodestree.Node
 
+	err := r.checkProposal(resourceOptions.productName, resourceOptions.dryRun, resourceOptions.proposal)
+	if err != nil {
+		return nil, err
+	}
+
 	product, project, err := r.GetProductAndCodeRepo(ctx, resourceOptions.productName)
 	if err != nil {
 		r.log.Log(-1, "msg", "failed to get product and coderepo data", "err", err)
-		return err
+		return nil, err
+	}
+
+	unlock, err := r.LockCodeRepo(ctx, project.HttpUrlToRepo)
+	if err != nil {
+		r.log.Log(-1, "msg", "failed to lock coderepo", "url", project.HttpUrlToRepo)
+		return nil, err
 	}
+	defer unlock()
 
 	localPath, err := r.CloneCodeRepo(ctx, project.HttpUrlToRepo)
 	if err != nil {
 		r.log.Log(-1, "msg", "failed to clone coderepo", "url", project.HttpUrlToRepo)
-		return err
+		return nil, err
 	}
 
 	defer func(path string) {
@@ -172,7 +386,7 @@ func (r *ResourcesUsecase) Save(ctx context.Context, resourceOptions *resourceOp
 	nodes, err := r.nodestree.Load(localPath)
 	if err != nil {
 		r.log.Log(-1, "msg", "first load n failed", "err", err)
-		return err
+		return nil, err
 	}
 
 	options := nodestree.CompareOptions{
@@ -186,20 +400,28 @@ func (r *ResourcesUsecase) Save(ctx context.Context, resourceOptions *resourceOp
 		resourceNode, err = resourceOptions.operator.CreateNode(localPath, data)
 		if err != nil {
 			r.log.Log(-1, "msg", "failed to create node", "err", err)
-			return err
+			return nil, err
 		}
 	} else {
 		resourceNode, err = resourceOptions.operator.UpdateNode(resourceNode, data)
 		if err != nil {
 			r.log.Log(-1, "failed to update node", "err", err)
-			return err
+			return nil, err
 		}
 	}
 
+	ctx, err = withResourcePrecondition(ctx, localPath, resourceNode.Path, resourceOptions.resourceVersion)
+	if err != nil {
+		r.log.Log(-1, "msg", "the resource version does not match", "err", err)
+		return nil, err
+	}
+
+	operation := changeOperation(resourceNode.Path)
+
 	newNodes, err := r.InsertNodes(r.nodestree, &nodes, resourceNode)
 	if err != nil {
 		r.log.Log(-1, "msg", "failed to insert node", "err", err)
-		return err
+		return nil, err
 	}
 
 	if !resourceOptions.insecureSkipCheck {
@@ -207,40 +429,177 @@ func (r *ResourcesUsecase) Save(ctx context.Context, resourceOptions *resourceOp
 		err = r.nodestree.Compare(options)
 		if err != nil {
 			r.log.Log(-1, "msg", "recheck failed", "err", err)
-			return err
+			return nil, err
 		}
 	}
 
 	err = r.WriteResource(resourceNode)
 	if err != nil {
 		r.log.Log(-1, "msg", "failed to write resource", "err", err)
-		return err
+		return nil, err
 	}
 
 	err = r.SaveDeployConfig(&nodes, localPath)
 	if err != nil {
 		r.log.Log(-1, "msg", "failed to saved deploy config", "err", err)
-		return err
+		return nil, err
 	}
 
-	err = r.SaveConfig(ctx, localPath)
+	if resourceOptions.dryRun {
+		return r.dryRun(ctx, localPath, resourceNode.Path)
+	}
+
+	message := commitMessage(operation, resourceOptions.resourceKind, resourceNode.Name, resourceOptions.productName, resourceOptions.commitMessage)
+	if resourceOptions.proposal {
+		return r.proposeChanges(ctx, localPath, project, message)
+	}
+
+	result, err := r.SaveConfig(withCommitMessage(ctx, message), localPath)
 	if err != nil {
 		r.log.Log(-1, "msg", "failed to git submission", "err", err)
-		return err
+		return nil, err
 	}
+	result.CommitURL = r.commitURL(project, result.Commit)
 
-	return nil
+	return result, nil
+}
+
+// applyNode is a resource to apply with the operator of its kind.
+type applyNode struct {
+	kind     string
+	name     string
+	data     interface{}
+	operator nodestree.NodesOperator
 }
 
-func (r *ResourcesUsecase) Delete(ctx context.Context, resourceOptions *resourceOptions, getResourceName getResouceName) error {
+// Apply creates or updates all the resources in one commit, the resources are checked together and nothing is written if any of them fails.
+func (r *ResourcesUsecase) Apply(ctx context.Context, options *BizOptions, resources []*applyNode) (*ChangeResult, error) {
+	err := r.checkProposal(options.ProductName, options.DryRun, options.Proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	product, project, err := r.GetProductAndCodeRepo(ctx, options.ProductName)
+	if err != nil {
+		r.log.Log(-1, "msg", "failed to get product and coderepo data", "err", err)
+		return nil, err
+	}
+
+	unlock, err := r.LockCodeRepo(ctx, project.HttpUrlToRepo)
+	if err != nil {
+		r.log.Log(-1, "msg", "failed to lock coderepo", "url", project.HttpUrlToRepo)
+		return nil, err
+	}
+	defer unlock()
+
+	localPath, err := r.CloneCodeRepo(ctx, project.HttpUrlToRepo)
+	if err != nil {
+		r.log.Log(-1, "msg", "failed to clone coderepo", "url", project.HttpUrlToRepo)
+		return nil, err
+	}
+
+	defer func(path string) {
+		cleanCodeRepo(path)
+	}(localPath)
+
+	nodes, err := r.nodestree.Load(localPath)
+	if err != nil {
+		r.log.Log(-1, "msg", "first load n failed", "err", err)
+		return nil, err
+	}
+
+	resourceNodes := make([]*nodestree.Node, 0, len(resources))
+	changes := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		resourceNode := r.GetNode(&nodes, resource.kind, resource.name)
+		if resourceNode == nil {
+			resourceNode, err = resource.operator.CreateNode(localPath, resource.data)
+		} else {
+			resourceNode, err = resource.operator.UpdateNode(resourceNode, resource.data)
+		}
+		if err != nil {
+			r.log.Log(-1, "msg", "failed to apply node", "kind", resource.kind, "name", resource.name, "err", err)
+			return nil, err
+		}
+
+		changes = append(changes, fmt.Sprintf("- %s %s %s", changeOperation(resourceNode.Path), resource.kind, resourceNode.Name))
+
+		newNodes, err := r.InsertNodes(r.nodestree, &nodes, resourceNode)
+		if err != nil {
+			r.log.Log(-1, "msg", "failed to insert node", "err", err)
+			return nil, err
+		}
+		nodes = *newNodes
+		resourceNodes = append(resourceNodes, resourceNode)
+	}
+
+	if !options.InsecureSkipCheck {
+		err = r.nodestree.Compare(nodestree.CompareOptions{
+			Nodes:            nodes,
+			ProductName:      fmt.Sprintf("%s%d", _ProductPrefix, int(product.Id)),
+			LocalProjectPath: localPath,
+		})
+		if err != nil {
+			r.log.Log(-1, "msg", "recheck failed", "err", err)
+			return nil, err
+		}
+	}
+
+	resourcePaths := make([]string, 0, len(resourceNodes))
+	for _, resourceNode := range resourceNodes {
+		err = r.WriteResource(resourceNode)
+		if err != nil {
+			r.log.Log(-1, "msg", "failed to write resource", "err", err)
+			return nil, err
+		}
+		resourcePaths = append(resourcePaths, resourceNode.Path)
+	}
+
+	err = r.SaveDeployConfig(&nodes, localPath)
+	if err != nil {
+		r.log.Log(-1, "msg", "failed to saved deploy config", "err", err)
+		return nil, err
+	}
+
+	if options.DryRun {
+		return r.dryRun(ctx, localPath, resourcePaths...)
+	}
+
+	message := applyMessage(options.ProductName, changes, options.CommitMessage)
+	if options.Proposal {
+		return r.proposeChanges(ctx, localPath, project, message)
+	}
+
+	result, err := r.SaveConfig(withCommitMessage(ctx, message), localPath)
+	if err != nil {
+		r.log.Log(-1, "msg", "failed to git submission", "err", err)
+		return nil, err
+	}
+	result.CommitURL = r.commitURL(project, result.Commit)
+
+	return result, nil
+}
+
+func (r *ResourcesUsecase) Delete(ctx context.Context, resourceOptions *resourceOptions, getResourceName getResouceName) (*ChangeResult, error) {
+	err := r.checkProposal(resourceOptions.productName, resourceOptions.dryRun, resourceOptions.proposal)
+	if err != nil {
+		return nil, err
+	}
+
 	product, project, err := r.GetProductAndCodeRepo(ctx, resourceOptions.productName)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	unlock, err := r.LockCodeRepo(ctx, project.HttpUrlToRepo)
+	if err != nil {
+		return nil, err
 	}
+	defer unlock()
 
 	localPath, err := r.CloneCodeRepo(ctx, project.HttpUrlToRepo)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	defer func(path string) {
@@ -249,7 +608,7 @@ func (r *ResourcesUsecase) Delete(ctx context.Context, resourceOptions *resource
 
 	nodes, err := r.nodestree.Load(localPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	options := nodestree.CompareOptions{
@@ -260,43 +619,58 @@ func (r *ResourcesUsecase) Delete(ctx context.Context, resourceOptions *resource
 
 	resourceName, err := getResourceName(nodes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	resourceNode := r.GetNode(&nodes, resourceOptions.resourceKind, resourceName)
 	if resourceNode == nil {
-		return fmt.Errorf("the resource %s of type %s was not found", resourceName, resourceOptions.resourceKind)
+		return nil, fmt.Errorf("the resource %s of type %s was not found", resourceName, resourceOptions.resourceKind)
+	}
+
+	ctx, err = withResourcePrecondition(ctx, localPath, resourceNode.Path, resourceOptions.resourceVersion)
+	if err != nil {
+		return nil, err
 	}
 
 	newNodes, err := r.RemoveNode(&nodes, resourceNode)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !resourceOptions.insecureSkipCheck {
 		options.Nodes = *newNodes
 		err = r.nodestree.Compare(options)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	err = deleteResource(resourceNode)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = r.SaveDeployConfig(&nodes, localPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = r.SaveConfig(ctx, localPath)
+	if resourceOptions.dryRun {
+		return r.dryRun(ctx, localPath, resourceNode.Path)
+	}
+
+	message := commitMessage("delete", resourceOptions.resourceKind, resourceNode.Name, resourceOptions.productName, resourceOptions.commitMessage)
+	if resourceOptions.proposal {
+		return r.proposeChanges(ctx, localPath, project, message)
+	}
+
+	result, err := r.SaveConfig(withCommitMessage(ctx, message), localPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	result.CommitURL = r.commitURL(project, result.Commit)
 
-	return nil
+	return result, nil
 }
 
 func (r *ResourcesUsecase) InsertNodes(nodestree nodestree.NodesTree, nodes, resource *nodestree.Node) (*nodestree.Node, error) {
@@ -368,6 +742,21 @@ func (r *ResourcesUsecase) CloneCodeRepo(ctx context.Context, url string) (path
 	return localCodeRepoPath, nil
 }
 
+// LockCodeRepo queues changes to the same repository, so that a clone is not pushed over by a concurrent save
+func (r *ResourcesUsecase) LockCodeRepo(ctx context.Context, url string) (unlock func(), err error) {
+	locker, ok := r.gitRepo.(RepositoryLocker)
+	if !ok {
+		return func() {}, nil
+	}
+
+	unlock, err = locker.Lock(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock repository, the repository url: %s, err: %w", url, err)
+	}
+
+	return unlock, nil
+}
+
 // WriteResource Write project resource content to a file
 func (r *ResourcesUsecase) WriteResource(node *nodestree.Node) (err error) {
 	jsonBytes, err := json.Marshal(node.Content)
@@ -467,7 +856,7 @@ func writeKustomize(path string, bytes []byte) error {
 
 // SaveConfig Save project resource config to git platform
 // If automatic merge fails will retry three times
-func (r *ResourcesUsecase) SaveConfig(ctx context.Context, path string) error {
+func (r *ResourcesUsecase) SaveConfig(ctx context.Context, path string) (*ChangeResult, error) {
 	count := getCount(ctx)
 	if count == nil {
 		ctx = withCount(ctx, 1)
@@ -475,27 +864,53 @@ func (r *ResourcesUsecase) SaveConfig(ctx context.Context, path string) error {
 
 	_, err := r.gitRepo.Fetch(ctx, path, "origin")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	data, err := r.gitRepo.Diff(ctx, path, "main", "remotes/origin/main")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	// Only the first attempt compares the resource with the remote changes,
+	// a retry has already committed the change and the diff also carries it.
+	if count == nil && isResourceChanged(data, getResourceFile(ctx)) {
+		return nil, ErrorResourceConflict
+	}
+
+	result := &ChangeResult{Branch: _MainBranch}
 	if data == "" {
-		err = r.gitRepo.SaveConfig(ctx, path)
+		err = r.gitRepo.SaveConfig(ctx, path, getCommitMessage(ctx))
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
+		result.AutoMerged = true
 		err = r.retryAutoMerge(ctx, path)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return nil
+	result.Commit, err = r.gitRepo.Head(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// commitURL returns the web URL of the commit in the project.
+func (r *ResourcesUsecase) commitURL(project *Project, sha string) string {
+	if project == nil || project.WebUrl == "" || sha == "" {
+		return ""
+	}
+
+	if r.configs != nil && r.configs.Git.GitType == nautesconfigs.GIT_TYPE_GITHUB {
+		return fmt.Sprintf("%s/commit/%s", project.WebUrl, sha)
+	}
+
+	return fmt.Sprintf("%s/-/commit/%s", project.WebUrl, sha)
 }
 
 func (r *ResourcesUsecase) convertCodeRepoToRepoName(ctx context.Context, codeRepoName string) (string, error) {
@@ -529,17 +944,17 @@ func (r *ResourcesUsecase) convertProductToGroupName(ctx context.Context, produc
 func (r *ResourcesUsecase) retryAutoMerge(ctx context.Context, path string) error {
 	_, err := r.gitRepo.Fetch(ctx, path)
 	if err != nil {
-		return fmt.Errorf("when the save configuration cannot be fetch remote branch, err: %v", err)
+		return fmt.Errorf("when the save configuration cannot be fetch remote branch, err: %w", err)
 	}
 
-	err = r.gitRepo.Commit(path, "api: saved configuration")
+	err = r.gitRepo.Commit(path, getCommitMessage(ctx))
 	if err != nil {
 		return err
 	}
 
 	_, err = r.gitRepo.Merge(ctx, path)
 	if err != nil {
-		return fmt.Errorf("when the save configuration cannot be merge automatically, manual approval may be required, err: %v", err)
+		return fmt.Errorf("when the save configuration cannot be merge automatically, manual approval may be required, err: %w", err)
 	}
 
 	err = r.gitRepo.Push(ctx, path)
@@ -553,10 +968,11 @@ func (r *ResourcesUsecase) retryAutoMerge(ctx context.Context, path string) erro
 			count += 1
 			ctx = withCount(ctx, count)
 			time.Sleep(3 * time.Second)
-			return r.SaveConfig(ctx, path)
+			_, err = r.SaveConfig(ctx, path)
+			return err
 		}
 
-		err = fmt.Errorf("failed to save config, err: %v", err)
+		err = fmt.Errorf("failed to save config, err: %w", err)
 		return err
 	}
 
@@ -577,13 +993,21 @@ func isMergeExceededTimes(ctx context.Context, exceed int) (bool, int, error) {
 	return false, val, nil
 }
 
+// cleanCodeRepo removes the cloned repository, and the directory it was cloned into once that is left empty.
 func cleanCodeRepo(filename string) error {
 	if _, err := os.Stat(filename); os.IsNotExist(err) {
 		return nil
 	}
 
 	err := os.RemoveAll(filename)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Removing a directory that still has content fails, which keeps directories shared with other files.
+	_ = os.Remove(filepath.Dir(filename))
+
+	return nil
 }
 
 func withCount(ctx context.Context, val interface{}) context.Context {
@@ -615,3 +1039,258 @@ func deleteResource(node *nodestree.Node) (err error) {
 
 	return
 }
+
+// resourceVersion returns the git blob SHA of the resource file, which changes whenever the file changes.
+func resourceVersion(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha1.New()
+	fmt.Fprintf(hash, "blob %d\x00", len(content))
+	hash.Write(content)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// setResourceVersion fills the resource version of the resource from the file it was loaded from.
+func setResourceVersion(node *nodestree.Node) {
+	obj, ok := node.Content.(v1.Object)
+	if !ok {
+		return
+	}
+
+	version, err := resourceVersion(node.Path)
+	if err != nil {
+		return
+	}
+
+	obj.SetResourceVersion(version)
+}
+
+func setResourceVersions(nodes *nodestree.Node) {
+	if !nodes.IsDir {
+		setResourceVersion(nodes)
+	}
+
+	for _, child := range nodes.Children {
+		setResourceVersions(child)
+	}
+}
+
+// withResourcePrecondition checks that the resource file is still at the version the caller read,
+// and remembers the file so that a change pushed to the remote meanwhile is not merged over.
+func withResourcePrecondition(ctx context.Context, localPath, path, version string) (context.Context, error) {
+	if version == "" {
+		return ctx, nil
+	}
+
+	current, err := resourceVersion(path)
+	if os.IsNotExist(err) {
+		return ctx, ErrorResourceConflict
+	}
+	if err != nil {
+		return ctx, err
+	}
+
+	if current != version {
+		return ctx, ErrorResourceConflict
+	}
+
+	file, err := filepath.Rel(localPath, path)
+	if err != nil {
+		return ctx, err
+	}
+
+	return context.WithValue(ctx, _ResourceFile, filepath.ToSlash(file)), nil
+}
+
+// WithRevision makes Get and List read the resources of the revision instead of main,
+// the revision is a commit SHA, a tag or an RFC 3339 timestamp.
+func WithRevision(ctx context.Context, revision string) context.Context {
+	if revision == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, _Revision, revision)
+}
+
+func getRevision(ctx context.Context) string {
+	revision, _ := ctx.Value(_Revision).(string)
+	return revision
+}
+
+// WithViolations makes Get and List leave out the files that cannot be parsed or break the layout instead of failing,
+// the violations of the files left out are appended to violations.
+func WithViolations(ctx context.Context, violations *[]nodestree.Violation) context.Context {
+	return context.WithValue(ctx, _Violations, violations)
+}
+
+func getViolations(ctx context.Context) *[]nodestree.Violation {
+	violations, _ := ctx.Value(_Violations).(*[]nodestree.Violation)
+	return violations
+}
+
+// changeOperation tells a create from an update by the resource file, most kinds are saved through CreateNode
+// and replace the existing node of the same path.
+func changeOperation(resourcePath string) string {
+	if _, err := os.Stat(resourcePath); err == nil {
+		return "update"
+	}
+
+	return "create"
+}
+
+// withCommitMessage sets the message of the commit SaveConfig makes.
+func withCommitMessage(ctx context.Context, message string) context.Context {
+	return context.WithValue(ctx, _CommitMessage, message)
+}
+
+func getCommitMessage(ctx context.Context) string {
+	message, ok := ctx.Value(_CommitMessage).(string)
+	if !ok || message == "" {
+		return _DefaultCommitMessage
+	}
+
+	return message
+}
+
+// commitMessage describes a change of a resource, the message of the user is appended as the body.
+func commitMessage(operation, kind, name, productName, userMessage string) string {
+	message := fmt.Sprintf("api: %s %s %s of product %s", operation, kind, name, productName)
+	if userMessage != "" {
+		message = fmt.Sprintf("%s\n\n%s", message, strings.TrimSpace(userMessage))
+	}
+
+	return message
+}
+
+// applyMessage returns the commit message of applying resources, the body lists every change before the message of the user.
+func applyMessage(productName string, changes []string, userMessage string) string {
+	message := fmt.Sprintf("api: apply %d resources of product %s\n\n%s", len(changes), productName, strings.Join(changes, "\n"))
+	if userMessage != "" {
+		message = fmt.Sprintf("%s\n\n%s", message, strings.TrimSpace(userMessage))
+	}
+
+	return message
+}
+
+// rollbackMessage records the revision restored by a rollback before the message of the user.
+func rollbackMessage(revision, userMessage string) string {
+	message := fmt.Sprintf("Rolled back to %s", revision)
+	if userMessage != "" {
+		message = fmt.Sprintf("%s\n\n%s", message, userMessage)
+	}
+
+	return message
+}
+
+func getResourceFile(ctx context.Context) string {
+	file, _ := ctx.Value(_ResourceFile).(string)
+	return file
+}
+
+// isResourceChanged reports whether the patch touches the file.
+func isResourceChanged(patch, file string) bool {
+	if patch == "" || file == "" {
+		return false
+	}
+
+	for _, line := range strings.Split(patch, "\n") {
+		if !strings.HasPrefix(line, "diff --git ") {
+			continue
+		}
+
+		for _, field := range strings.Fields(strings.TrimPrefix(line, "diff --git ")) {
+			if field == "a/"+file || field == "b/"+file {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// dryRun commits the changes to the local clone only, and returns them instead of pushing.
+func (r *ResourcesUsecase) dryRun(ctx context.Context, localPath string, resourcePaths ...string) (*ChangeResult, error) {
+	result := &ChangeResult{DryRun: true}
+
+	documents := make([]string, 0, len(resourcePaths))
+	for _, resourcePath := range resourcePaths {
+		content, err := ioutil.ReadFile(resourcePath)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if len(content) > 0 {
+			documents = append(documents, string(content))
+		}
+	}
+	result.Yaml = strings.Join(documents, "---\n")
+
+	err := r.gitRepo.Commit(localPath, "api: dry run")
+	if err != nil {
+		return nil, err
+	}
+
+	result.Diff, err = r.gitRepo.Diff(ctx, localPath, "remotes/origin/main")
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// checkProposal rejects the changes pushed directly to main when the product requires proposals, dry runs are always allowed.
+func (r *ResourcesUsecase) checkProposal(productName string, dryRun, proposal bool) error {
+	if dryRun || proposal || !r.proposalPolicy.isRequired(productName) {
+		return nil
+	}
+
+	return ErrorProposalRequired
+}
+
+// proposeChanges pushes the changes to a new branch and opens a merge request to main for them.
+func (r *ResourcesUsecase) proposeChanges(ctx context.Context, localPath string, project *Project, message string) (*ChangeResult, error) {
+	proposer, ok := r.codeRepo.(MergeRequestRepo)
+	if !ok {
+		return nil, ErrorProposalNotSupported
+	}
+
+	err := r.gitRepo.Commit(localPath, message)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := r.gitRepo.Diff(ctx, localPath, "remotes/origin/main")
+	if err != nil {
+		return nil, err
+	}
+
+	if diff == "" {
+		return &ChangeResult{}, nil
+	}
+
+	branch := fmt.Sprintf("%s%d", _ProposalBranchPrefix, time.Now().UnixNano())
+	err = r.gitRepo.Push(ctx, localPath, "origin", fmt.Sprintf("HEAD:refs/heads/%s", branch))
+	if err != nil {
+		return nil, err
+	}
+
+	title, description := message, ""
+	if i := strings.Index(message, "\n"); i >= 0 {
+		title, description = message[:i], strings.TrimSpace(message[i:])
+	}
+
+	mergeRequest, err := proposer.CreateMergeRequest(ctx, int(project.Id), &MergeRequestOptions{
+		Title:        title,
+		Description:  description,
+		SourceBranch: branch,
+		TargetBranch: _MainBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open merge request for branch %s, err: %w", branch, err)
+	}
+
+	return &ChangeResult{Diff: diff, Proposal: mergeRequest}, nil
+}
@@ -0,0 +1,304 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resource version", func() {
+	var (
+		localPath    string
+		resourcePath string
+		// the result of `printf 'hello' | git hash-object --stdin`
+		helloVersion = "b6fc4c620b67d95f953a5c1c1230aaab5db5a1b0"
+	)
+
+	BeforeEach(func() {
+		var err error
+		localPath, err = os.MkdirTemp("", "resource-version")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		resourcePath = filepath.Join(localPath, _EnvSubDir, "env1.yaml")
+		err = os.MkdirAll(filepath.Dir(resourcePath), 0755)
+		Expect(err).ShouldNot(HaveOccurred())
+		err = os.WriteFile(resourcePath, []byte("hello"), 0644)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(localPath)
+	})
+
+	It("is the git blob sha of the resource file", func() {
+		version, err := resourceVersion(resourcePath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(version).Should(Equal(helloVersion))
+
+		resource := createEnvironmentResource("env1")
+		setResourceVersion(&nodestree.Node{Path: resourcePath, Content: resource})
+		Expect(resource.ResourceVersion).Should(Equal(helloVersion))
+	})
+
+	It("will pass the precondition when the version matches", func() {
+		newCtx, err := withResourcePrecondition(ctx, localPath, resourcePath, helloVersion)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(getResourceFile(newCtx)).Should(Equal(_EnvSubDir + "/env1.yaml"))
+
+		newCtx, err = withResourcePrecondition(ctx, localPath, resourcePath, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(getResourceFile(newCtx)).Should(BeEmpty())
+	})
+
+	It("will fail with conflict when the resource has been changed", func() {
+		_, err := withResourcePrecondition(ctx, localPath, resourcePath, "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391")
+		Expect(err).Should(Equal(ErrorResourceConflict))
+	})
+
+	It("will fail with conflict when the resource has been deleted", func() {
+		_, err := withResourcePrecondition(ctx, localPath, filepath.Join(localPath, _EnvSubDir, "env2.yaml"), helloVersion)
+		Expect(err).Should(Equal(ErrorResourceConflict))
+	})
+
+	It("is an update when the resource file exists", func() {
+		Expect(changeOperation(resourcePath)).Should(Equal("update"))
+		Expect(changeOperation(filepath.Join(localPath, _EnvSubDir, "env2.yaml"))).Should(Equal("create"))
+	})
+
+	It("will find the resource file in the patch of the remote changes", func() {
+		patch := "diff --git a/envs/env1.yaml b/envs/env1.yaml\nindex 1..2 100644\n--- a/envs/env1.yaml\n+++ b/envs/env1.yaml\n"
+		Expect(isResourceChanged(patch, "envs/env1.yaml")).Should(BeTrue())
+		Expect(isResourceChanged(patch, "envs/env2.yaml")).Should(BeFalse())
+		Expect(isResourceChanged("", "envs/env1.yaml")).Should(BeFalse())
+		Expect(isResourceChanged(patch, "")).Should(BeFalse())
+	})
+})
+
+var _ = Describe("Save config", func() {
+	var (
+		gitRepo   *MockGitRepo
+		resources *ResourcesUsecase
+		// the local change of the resource, committed before the first push
+		patch = "diff --git a/envs/env1.yaml b/envs/env1.yaml\nindex 1..2 100644\n--- a/envs/env1.yaml\n+++ b/envs/env1.yaml\n"
+	)
+
+	BeforeEach(func() {
+		gitRepo = NewMockGitRepo(ctl)
+		resources = NewResourcesUsecase(logger, nil, nil, gitRepo, nil, nautesConfigs, nil)
+	})
+
+	It("retries the push that was rejected once without reporting a conflict", func() {
+		newCtx := context.WithValue(ctx, _ResourceFile, "envs/env1.yaml")
+		firstFetch := gitRepo.EXPECT().Fetch(gomock.Any(), localRepositaryPath, "origin").Return("any", nil)
+		secondFetch := gitRepo.EXPECT().Fetch(gomock.Any(), localRepositaryPath).Return("any", nil).After(firstFetch)
+		thirdFetch := gitRepo.EXPECT().Fetch(gomock.Any(), localRepositaryPath, "origin").Return("any", nil).After(secondFetch)
+		gitRepo.EXPECT().Fetch(gomock.Any(), localRepositaryPath).Return("any", nil).After(thirdFetch)
+		firstDiff := gitRepo.EXPECT().Diff(gomock.Any(), localRepositaryPath, "main", "remotes/origin/main").Return("diff --git a/envs/env2.yaml b/envs/env2.yaml\n", nil)
+		gitRepo.EXPECT().Diff(gomock.Any(), localRepositaryPath, "main", "remotes/origin/main").Return(patch, nil).After(firstDiff)
+		gitRepo.EXPECT().Commit(localRepositaryPath, gomock.Any()).Times(2)
+		gitRepo.EXPECT().Merge(gomock.Any(), localRepositaryPath).Return("any", nil).Times(2)
+		rejected := gitRepo.EXPECT().Push(gomock.Any(), localRepositaryPath).Return(fmt.Errorf("non-fast-forward update"))
+		gitRepo.EXPECT().Push(gomock.Any(), localRepositaryPath).Return(nil).After(rejected)
+		gitRepo.EXPECT().Head(localRepositaryPath).Return(headCommit, nil).Times(2)
+
+		result, err := resources.SaveConfig(newCtx, localRepositaryPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Commit).Should(Equal(headCommit))
+		Expect(result.AutoMerged).Should(BeTrue())
+	})
+
+	It("fails with conflict when the remote has changed the resource", func() {
+		newCtx := context.WithValue(ctx, _ResourceFile, "envs/env1.yaml")
+		gitRepo.EXPECT().Fetch(gomock.Any(), localRepositaryPath, "origin").Return("any", nil)
+		gitRepo.EXPECT().Diff(gomock.Any(), localRepositaryPath, "main", "remotes/origin/main").Return(patch, nil)
+
+		_, err := resources.SaveConfig(newCtx, localRepositaryPath)
+		Expect(err).Should(Equal(ErrorResourceConflict))
+	})
+})
+
+var _ = Describe("Proposal policy", func() {
+	var resources *ResourcesUsecase
+
+	BeforeEach(func() {
+		policy := &ProposalPolicy{Products: []string{defaultGroupName}}
+		resources = NewResourcesUsecase(logger, nil, nil, NewMockGitRepo(ctl), nil, nautesConfigs, policy)
+	})
+
+	It("requires proposals for the listed products or all of them", func() {
+		Expect((&ProposalPolicy{Products: []string{"shop"}}).isRequired("shop")).Should(BeTrue())
+		Expect((&ProposalPolicy{Products: []string{"shop"}}).isRequired("mall")).Should(BeFalse())
+		Expect((&ProposalPolicy{Required: true}).isRequired("mall")).Should(BeTrue())
+
+		var policy *ProposalPolicy
+		Expect(policy.isRequired("shop")).Should(BeFalse())
+	})
+
+	It("rejects the changes pushed directly to main", func() {
+		_, err := resources.Save(ctx, &resourceOptions{productName: defaultGroupName}, nil)
+		Expect(err).Should(Equal(ErrorProposalRequired))
+
+		_, err = resources.Delete(ctx, &resourceOptions{productName: defaultGroupName}, nil)
+		Expect(err).Should(Equal(ErrorProposalRequired))
+
+		_, err = resources.Apply(ctx, &BizOptions{ProductName: defaultGroupName}, nil)
+		Expect(err).Should(Equal(ErrorProposalRequired))
+	})
+
+	It("allows proposals, dry runs and the products not requiring proposals", func() {
+		Expect(resources.checkProposal(defaultGroupName, false, true)).Should(Succeed())
+		Expect(resources.checkProposal(defaultGroupName, true, false)).Should(Succeed())
+		Expect(resources.checkProposal("mall", false, false)).Should(Succeed())
+	})
+})
+
+var _ = Describe("Commit message", func() {
+	It("describes the change of the resource", func() {
+		message := commitMessage("update", nodestree.Enviroment, "env1", defaultGroupName, "")
+		Expect(message).Should(Equal("api: update Environment env1 of product " + defaultGroupName))
+	})
+
+	It("appends the message of the user as the body", func() {
+		message := commitMessage("delete", nodestree.Enviroment, "env1", defaultGroupName, "retire the staging environment\n")
+		Expect(message).Should(Equal("api: delete Environment env1 of product " + defaultGroupName + "\n\nretire the staging environment"))
+	})
+
+	It("is the default message when none was set", func() {
+		Expect(getCommitMessage(ctx)).Should(Equal(_DefaultCommitMessage))
+		Expect(getCommitMessage(withCommitMessage(ctx, "api: delete Cluster c1"))).Should(Equal("api: delete Cluster c1"))
+	})
+})
+
+var _ = Describe("Degraded reads", func() {
+	var (
+		codeRepo  *MockCodeRepo
+		gitRepo   *MockGitRepo
+		nodesTree *nodestree.MockNodesTree
+		violation = nodestree.Violation{
+			Path:    fmt.Sprintf("%s/env2.yaml", _EnvSubDir),
+			Rule:    nodestree.RuleReference,
+			Message: "cluster is not found",
+		}
+	)
+
+	newNodes := func() nodestree.Node {
+		return nodestree.Node{
+			Path:  localRepositaryPath,
+			IsDir: true,
+			Children: []*nodestree.Node{
+				{
+					Name:  _EnvSubDir,
+					Path:  fmt.Sprintf("%s/%s", localRepositaryPath, _EnvSubDir),
+					IsDir: true,
+					Children: []*nodestree.Node{
+						createEnvironmentNode(createEnvironmentResource("env1")),
+						createEnvironmentNode(createEnvironmentResource("env2")),
+					},
+				},
+			},
+		}
+	}
+
+	listEnvironments := func(ctx context.Context) ([]string, error) {
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodesTree, nautesConfigs, nil)
+		envs, err := NewEnviromentUsecase(logger, nautesConfigs, codeRepo, nodesTree, resourcesUsecase).ListEnvironments(ctx, defaultGroupName)
+		if err != nil {
+			return nil, err
+		}
+
+		var names []string
+		for _, env := range envs {
+			names = append(names, env.Name)
+		}
+
+		return names, nil
+	}
+
+	BeforeEach(func() {
+		codeRepo = NewMockCodeRepo(ctl)
+		gitRepo = NewMockGitRepo(ctl)
+		nodesTree = nodestree.NewMockNodesTree(ctl)
+		nodesTree.EXPECT().AppendOperators(gomock.Any()).AnyTimes()
+
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Any()).Return(defaultProductGroup, nil).AnyTimes()
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil)
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil)
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
+	})
+
+	It("leaves out the resources that break the layout", func() {
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(newNodes(), nil)
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(ErrorResourceNoMatch)
+		nodesTree.EXPECT().Validate(gomock.Any()).Return([]nodestree.Violation{violation}, nil)
+
+		var violations []nodestree.Violation
+		names, err := listEnvironments(WithViolations(context.Background(), &violations))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(names).Should(Equal([]string{"env1"}))
+		Expect(violations).Should(Equal([]nodestree.Violation{violation}))
+	})
+
+	It("leaves out the files that cannot be parsed", func() {
+		parseViolation := nodestree.Violation{Path: "envs/env3.yaml", Rule: nodestree.RuleParse, Message: "node env3 kind is empty"}
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(nodestree.Node{}, fmt.Errorf("node env3 kind is empty"))
+		nodesTree.EXPECT().LoadWithViolations(gomock.Eq(localRepositaryPath)).Return(newNodes(), []nodestree.Violation{parseViolation}, nil)
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(nil)
+
+		var violations []nodestree.Violation
+		names, err := listEnvironments(WithViolations(context.Background(), &violations))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(names).Should(Equal([]string{"env1", "env2"}))
+		Expect(violations).Should(Equal([]nodestree.Violation{parseViolation}))
+	})
+
+	It("fails the read when violations are not asked for", func() {
+		nodesTree.EXPECT().Load(gomock.Eq(localRepositaryPath)).Return(newNodes(), nil)
+		nodesTree.EXPECT().Compare(gomock.Any()).Return(ErrorResourceNoMatch)
+
+		_, err := listEnvironments(context.Background())
+		Expect(err).Should(HaveOccurred())
+	})
+})
+
+var _ = Describe("Clean code repo", func() {
+	It("removes the directory the repository was cloned into", func() {
+		dir, err := os.MkdirTemp("", "product")
+		Expect(err).ShouldNot(HaveOccurred())
+		path := filepath.Join(dir, "default.project")
+		Expect(os.MkdirAll(filepath.Join(path, ".git"), 0700)).To(Succeed())
+
+		Expect(cleanCodeRepo(path)).To(Succeed())
+		Expect(dir).NotTo(BeADirectory())
+	})
+
+	It("keeps the directory when it holds other files", func() {
+		dir := GinkgoT().TempDir()
+		path := filepath.Join(dir, "default.project")
+		Expect(os.MkdirAll(path, 0700)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "other.yaml"), []byte("kind: Environment"), 0600)).To(Succeed())
+
+		Expect(cleanCodeRepo(path)).To(Succeed())
+		Expect(path).NotTo(BeADirectory())
+		Expect(filepath.Join(dir, "other.yaml")).To(BeAnExistingFile())
+	})
+})
@@ -0,0 +1,177 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResourceStatus is the status the operators report for a resource synced to the cluster.
+// It is read from the cluster and is unrelated to the desired spec read from git.
+type ResourceStatus struct {
+	// Synced is false when the resource is not in the cluster yet, the other fields are empty then.
+	Synced bool
+	// Generation is the generation of the resource in the cluster.
+	Generation int64
+	// ObservedGeneration is the latest generation the conditions were reported for.
+	ObservedGeneration int64
+	Conditions         []metav1.Condition
+	// LastError is the message of the latest condition that is not true.
+	LastError string
+}
+
+// statusKind reads the resources of a kind from the cluster.
+type statusKind struct {
+	newObject  func() client.Object
+	newList    func() client.ObjectList
+	conditions func(obj client.Object) []metav1.Condition
+}
+
+var statusKinds = map[string]statusKind{
+	nodestree.CodeRepo: {
+		newObject: func() client.Object { return &resourcev1alpha1.CodeRepo{} },
+		newList:   func() client.ObjectList { return &resourcev1alpha1.CodeRepoList{} },
+		conditions: func(obj client.Object) []metav1.Condition {
+			return obj.(*resourcev1alpha1.CodeRepo).Status.Conditions
+		},
+	},
+	nodestree.Enviroment: {
+		newObject:  func() client.Object { return &resourcev1alpha1.Environment{} },
+		newList:    func() client.ObjectList { return &resourcev1alpha1.EnvironmentList{} },
+		conditions: func(obj client.Object) []metav1.Condition { return nil },
+	},
+	nodestree.DeploymentRuntime: {
+		newObject: func() client.Object { return &resourcev1alpha1.DeploymentRuntime{} },
+		newList:   func() client.ObjectList { return &resourcev1alpha1.DeploymentRuntimeList{} },
+		conditions: func(obj client.Object) []metav1.Condition {
+			return obj.(*resourcev1alpha1.DeploymentRuntime).Status.Conditions
+		},
+	},
+}
+
+// StatusUsecase reads the status of the resources of the products from the cluster.
+type StatusUsecase struct {
+	log      *log.Helper
+	codeRepo CodeRepo
+	client   client.Client
+}
+
+func NewStatusUsecase(logger log.Logger, codeRepo CodeRepo, client client.Client) *StatusUsecase {
+	return &StatusUsecase{log: log.NewHelper(log.With(logger)), codeRepo: codeRepo, client: client}
+}
+
+// GetResourceStatus reads the status of the resource, name is the name of the resource file, e.g. repo-1 for code repos.
+func (s *StatusUsecase) GetResourceStatus(ctx context.Context, productName, kind, name string) (*ResourceStatus, error) {
+	statusKind, namespace, err := s.prepare(ctx, productName, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := statusKind.newObject()
+	err = s.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj)
+	if apierrors.IsNotFound(err) {
+		return &ResourceStatus{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the status of %s %s, err: %w", kind, name, err)
+	}
+
+	return resourceStatus(obj, statusKind.conditions(obj)), nil
+}
+
+// ListResourceStatuses reads the status of all the resources of the kind in the product, by the names of the resources.
+// The resources that are not in the cluster are left out.
+func (s *StatusUsecase) ListResourceStatuses(ctx context.Context, productName, kind string) (map[string]*ResourceStatus, error) {
+	statusKind, namespace, err := s.prepare(ctx, productName, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	list := statusKind.newList()
+	err = s.client.List(ctx, list, client.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the status of %s of product %s, err: %w", kind, productName, err)
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]*ResourceStatus, len(items))
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		statuses[obj.GetName()] = resourceStatus(obj, statusKind.conditions(obj))
+	}
+
+	return statuses, nil
+}
+
+// prepare checks that the product can be read by the user, the resources of the product are in the namespace named after its ID.
+func (s *StatusUsecase) prepare(ctx context.Context, productName, kind string) (statusKind, string, error) {
+	statusKind, ok := statusKinds[kind]
+	if !ok {
+		return statusKind, "", fmt.Errorf("the status of %s is not supported", kind)
+	}
+
+	group, err := s.codeRepo.GetGroup(ctx, productName)
+	if err != nil {
+		return statusKind, "", err
+	}
+
+	return statusKind, fmt.Sprintf("%s%d", _ProductPrefix, int(group.Id)), nil
+}
+
+func resourceStatus(obj client.Object, conditions []metav1.Condition) *ResourceStatus {
+	status := &ResourceStatus{
+		Synced:     true,
+		Generation: obj.GetGeneration(),
+		Conditions: conditions,
+	}
+
+	for _, condition := range conditions {
+		if condition.ObservedGeneration > status.ObservedGeneration {
+			status.ObservedGeneration = condition.ObservedGeneration
+		}
+	}
+
+	failed := make([]metav1.Condition, 0, len(conditions))
+	for _, condition := range conditions {
+		if condition.Status != metav1.ConditionTrue {
+			failed = append(failed, condition)
+		}
+	}
+	sort.SliceStable(failed, func(i, j int) bool {
+		return failed[i].LastTransitionTime.After(failed[j].LastTransitionTime.Time)
+	})
+	if len(failed) > 0 {
+		status.LastError = failed[0].Message
+	}
+
+	return status
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nautes-labs/api-server/pkg/kubernetes"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Resource status", func() {
+	var (
+		codeRepo  *MockCodeRepo
+		k8sClient *kubernetes.MockClient
+		namespace = fmt.Sprintf("%s%d", _ProductPrefix, int(defaultProductGroup.Id))
+		repoName  = fmt.Sprintf("%s%d", _RepoPrefix, 1)
+		now       = time.Now()
+	)
+
+	newCodeRepo := func(name string, conditions ...v1.Condition) *resourcev1alpha1.CodeRepo {
+		return &resourcev1alpha1.CodeRepo{
+			ObjectMeta: v1.ObjectMeta{Name: name, Namespace: namespace, Generation: 3},
+			Status:     resourcev1alpha1.CodeRepoStatus{Conditions: conditions},
+		}
+	}
+
+	BeforeEach(func() {
+		codeRepo = NewMockCodeRepo(ctl)
+		k8sClient = kubernetes.NewMockClient(ctl)
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil).AnyTimes()
+	})
+
+	It("reads the conditions and the latest error of a resource in the product namespace", func() {
+		key := client.ObjectKey{Namespace: namespace, Name: repoName}
+		k8sClient.EXPECT().Get(gomock.Any(), key, gomock.Any()).DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+			*obj.(*resourcev1alpha1.CodeRepo) = *newCodeRepo(repoName,
+				v1.Condition{Type: "Ready", Status: v1.ConditionTrue, ObservedGeneration: 3, LastTransitionTime: v1.NewTime(now)},
+				v1.Condition{Type: "Synced", Status: v1.ConditionFalse, ObservedGeneration: 2, Message: "old error", LastTransitionTime: v1.NewTime(now.Add(-time.Hour))},
+				v1.Condition{Type: "Webhook", Status: v1.ConditionFalse, ObservedGeneration: 2, Message: "webhook failed", LastTransitionTime: v1.NewTime(now)},
+			)
+			return nil
+		})
+
+		status, err := NewStatusUsecase(logger, codeRepo, k8sClient).GetResourceStatus(context.Background(), defaultGroupName, nodestree.CodeRepo, repoName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(status.Synced).Should(BeTrue())
+		Expect(status.Generation).Should(Equal(int64(3)))
+		Expect(status.ObservedGeneration).Should(Equal(int64(3)))
+		Expect(status.Conditions).Should(HaveLen(3))
+		Expect(status.LastError).Should(Equal("webhook failed"))
+	})
+
+	It("reports a resource that is not in the cluster as not synced", func() {
+		notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "coderepos"}, repoName)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+
+		status, err := NewStatusUsecase(logger, codeRepo, k8sClient).GetResourceStatus(context.Background(), defaultGroupName, nodestree.CodeRepo, repoName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(status).Should(Equal(&ResourceStatus{}))
+	})
+
+	It("lists the statuses of a kind by name", func() {
+		k8sClient.EXPECT().List(gomock.Any(), gomock.Any(), client.InNamespace(namespace)).DoAndReturn(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+			list.(*resourcev1alpha1.CodeRepoList).Items = []resourcev1alpha1.CodeRepo{*newCodeRepo(repoName)}
+			return nil
+		})
+
+		statuses, err := NewStatusUsecase(logger, codeRepo, k8sClient).ListResourceStatuses(context.Background(), defaultGroupName, nodestree.CodeRepo)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(statuses).Should(HaveLen(1))
+		Expect(statuses[repoName].Synced).Should(BeTrue())
+	})
+
+	It("rejects the kinds without status", func() {
+		_, err := NewStatusUsecase(logger, codeRepo, k8sClient).GetResourceStatus(context.Background(), defaultGroupName, nodestree.Project, "project1")
+		Expect(err).Should(HaveOccurred())
+	})
+})
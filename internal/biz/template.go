@@ -0,0 +1,234 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	errors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
+)
+
+// ProductTemplateOptions are the options of creating the resources of a product from a template product.
+type ProductTemplateOptions struct {
+	// Template is the name of the product whose resources are copied.
+	Template string
+	// RepoNames renames the code repos of the template, the repos not in it are renamed like the other resources:
+	// a name equal to the name of the template becomes the name of the product, and a "<template>-" prefix
+	// becomes "<product>-", other names are kept.
+	RepoNames map[string]string
+	// CommitMessage is added to the body of the commit.
+	CommitMessage string
+}
+
+// ApplyTemplate copies the resources of the template product to the product in one commit,
+// new repositories are created for the code repos of the template, their content is not copied.
+func (a *ApplyUsecase) ApplyTemplate(ctx context.Context, productName string, options *ProductTemplateOptions) (*ChangeResult, error) {
+	if options.Template == productName {
+		return nil, ErrorInvalidApply.WithCause(fmt.Errorf("the product %s cannot be its own template", productName))
+	}
+
+	templateResources, err := a.ExportProduct(ctx, options.Template)
+	if err != nil {
+		return nil, errors.FromError(err).WithCause(fmt.Errorf("failed to read template product %s, err: %w", options.Template, err))
+	}
+
+	renamer := &templateRenamer{
+		template:  options.Template,
+		product:   productName,
+		repoNames: options.RepoNames,
+	}
+	resources := renamer.resources(templateResources, a.codeRepoUsecase.config.Git.GitType)
+	if len(resources) == 0 {
+		return &ChangeResult{}, nil
+	}
+
+	bizOptions := &BizOptions{
+		ProductName:   productName,
+		CommitMessage: fmt.Sprintf("Created from template product %s", options.Template),
+	}
+	if options.CommitMessage != "" {
+		bizOptions.CommitMessage = fmt.Sprintf("%s\n\n%s", bizOptions.CommitMessage, options.CommitMessage)
+	}
+
+	return a.Apply(ctx, bizOptions, resources)
+}
+
+// templateRenamer parameterizes the resources of a template product for another product.
+type templateRenamer struct {
+	template  string
+	product   string
+	repoNames map[string]string
+}
+
+func (t *templateRenamer) name(name string) string {
+	if name == t.template {
+		return t.product
+	}
+
+	if prefix := t.template + "-"; strings.HasPrefix(name, prefix) {
+		return fmt.Sprintf("%s-%s", t.product, strings.TrimPrefix(name, prefix))
+	}
+
+	return name
+}
+
+func (t *templateRenamer) names(names []string) []string {
+	if names == nil {
+		return nil
+	}
+
+	renamed := make([]string, 0, len(names))
+	for _, name := range names {
+		renamed = append(renamed, t.name(name))
+	}
+
+	return renamed
+}
+
+func (t *templateRenamer) repoName(name string) string {
+	if repoName, ok := t.repoNames[name]; ok {
+		return repoName
+	}
+
+	return t.name(name)
+}
+
+func (t *templateRenamer) repoNamesOf(names []string) []string {
+	if names == nil {
+		return nil
+	}
+
+	renamed := make([]string, 0, len(names))
+	for _, name := range names {
+		renamed = append(renamed, t.repoName(name))
+	}
+
+	return renamed
+}
+
+// resources converts the resources of the template to the resources to apply to the product.
+func (t *templateRenamer) resources(template *ProductResources, gitType nautesconfigs.GitType) []*ApplyResource {
+	var resources []*ApplyResource
+
+	for _, project := range template.Projects {
+		name := t.name(project.Name)
+		resources = append(resources, &ApplyResource{
+			Kind: nodestree.Project,
+			Name: name,
+			Data: &ProjectData{ProjectName: name, Language: project.Spec.Language},
+		})
+	}
+
+	for _, codeRepo := range template.CodeRepos {
+		name := t.repoName(codeRepo.Project.Path)
+		webhook := &resourcev1alpha1.Webhook{Events: []string{}}
+		if codeRepo.CodeRepo.Spec.Webhook != nil {
+			webhook.Events = codeRepo.CodeRepo.Spec.Webhook.Events
+		}
+
+		resources = append(resources, &ApplyResource{
+			Kind: nodestree.CodeRepo,
+			Name: name,
+			Data: &CodeRepoData{
+				Spec: resourcev1alpha1.CodeRepoSpec{
+					Project:           t.name(codeRepo.CodeRepo.Spec.Project),
+					RepoName:          name,
+					DeploymentRuntime: codeRepo.CodeRepo.Spec.DeploymentRuntime,
+					PipelineRuntime:   codeRepo.CodeRepo.Spec.PipelineRuntime,
+					Webhook:           webhook,
+				},
+			},
+			GitOptions: templateGitOptions(gitType, name, codeRepo.Project),
+		})
+	}
+
+	for _, artifactRepo := range template.ArtifactRepos {
+		name := t.name(artifactRepo.Name)
+		spec := artifactRepo.Spec
+		spec.RepoName = name
+		spec.Projects = t.names(spec.Projects)
+		resources = append(resources, &ApplyResource{
+			Kind: nodestree.ArtifactRepo,
+			Name: name,
+			Data: &ArtifactRepoData{Name: name, Spec: spec},
+		})
+	}
+
+	for _, env := range template.Environments {
+		name := t.name(env.Name)
+		resources = append(resources, &ApplyResource{
+			Kind: nodestree.Enviroment,
+			Name: name,
+			Data: &EnviromentData{Name: name, Spec: env.Spec},
+		})
+	}
+
+	for _, runtime := range template.DeploymentRuntimes {
+		name := t.name(runtime.Name)
+		spec := runtime.Spec
+		spec.ProjectsRef = t.names(spec.ProjectsRef)
+		spec.Destination = t.name(spec.Destination)
+		spec.ManifestSource.CodeRepo = t.repoName(spec.ManifestSource.CodeRepo)
+		resources = append(resources, &ApplyResource{
+			Kind: nodestree.DeploymentRuntime,
+			Name: name,
+			Data: &DeploymentRuntimeData{Name: name, Spec: spec},
+		})
+	}
+
+	for _, runtime := range template.ProjectPipelineRuntimes {
+		name := t.name(runtime.Name)
+		spec := runtime.Spec
+		spec.Project = t.name(spec.Project)
+		spec.Destination = t.name(spec.Destination)
+		spec.PipelineSource = t.repoName(spec.PipelineSource)
+		spec.CodeSources = t.repoNamesOf(spec.CodeSources)
+		resources = append(resources, &ApplyResource{
+			Kind: nodestree.ProjectPipelineRuntime,
+			Name: name,
+			Data: &ProjectPipelineRuntimeData{Name: name, Spec: spec},
+		})
+	}
+
+	return resources
+}
+
+func templateGitOptions(gitType nautesconfigs.GitType, name string, project *Project) *GitCodeRepoOptions {
+	if gitType == nautesconfigs.GIT_TYPE_GITLAB {
+		return &GitCodeRepoOptions{
+			Gitlab: &GitlabCodeRepoOptions{
+				Name:        name,
+				Path:        name,
+				Visibility:  project.Visibility,
+				Description: project.Description,
+			},
+		}
+	}
+
+	return &GitCodeRepoOptions{
+		Gitlab: &GitlabCodeRepoOptions{},
+		Github: &GithubCodeRepoOptions{
+			Name:        name,
+			Path:        name,
+			Visibility:  project.Visibility,
+			Description: project.Description,
+		},
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Product template", func() {
+	var (
+		template = &ProductResources{
+			Projects: []*resourcev1alpha1.Project{
+				{
+					ObjectMeta: v1.ObjectMeta{Name: "tpl-api"},
+					Spec:       resourcev1alpha1.ProjectSpec{Product: "tpl", Language: "go"},
+				},
+			},
+			CodeRepos: []*CodeRepoAndProject{
+				{
+					CodeRepo: &resourcev1alpha1.CodeRepo{
+						Spec: resourcev1alpha1.CodeRepoSpec{
+							Project:         "tpl-api",
+							RepoName:        "tpl-api-code",
+							PipelineRuntime: true,
+							Webhook:         &resourcev1alpha1.Webhook{Events: []string{"push_events"}},
+						},
+					},
+					Project: &Project{Path: "tpl-api-code", Visibility: "private", Description: "api code"},
+				},
+				{
+					CodeRepo: &resourcev1alpha1.CodeRepo{
+						Spec: resourcev1alpha1.CodeRepoSpec{Project: "tpl-api", RepoName: "manifests", DeploymentRuntime: true},
+					},
+					Project: &Project{Path: "manifests"},
+				},
+			},
+			Environments: []*resourcev1alpha1.Environment{
+				{
+					ObjectMeta: v1.ObjectMeta{Name: "dev"},
+					Spec:       resourcev1alpha1.EnvironmentSpec{Product: "tpl", Cluster: "cluster1", EnvType: "host"},
+				},
+			},
+			DeploymentRuntimes: []*resourcev1alpha1.DeploymentRuntime{
+				{
+					ObjectMeta: v1.ObjectMeta{Name: "tpl-dr"},
+					Spec: resourcev1alpha1.DeploymentRuntimeSpec{
+						ProjectsRef:    []string{"tpl-api"},
+						ManifestSource: resourcev1alpha1.ManifestSource{CodeRepo: "manifests", TargetRevision: "main", Path: "dev"},
+						Destination:    "dev",
+					},
+				},
+			},
+			ProjectPipelineRuntimes: []*resourcev1alpha1.ProjectPipelineRuntime{
+				{
+					ObjectMeta: v1.ObjectMeta{Name: "tpl-pipeline"},
+					Spec: resourcev1alpha1.ProjectPipelineRuntimeSpec{
+						Project:        "tpl-api",
+						PipelineSource: "tpl-api-code",
+						CodeSources:    []string{"manifests"},
+						Destination:    "dev",
+					},
+				},
+			},
+		}
+	)
+
+	newRenamer := func() *templateRenamer {
+		return &templateRenamer{
+			template:  "tpl",
+			product:   "shop",
+			repoNames: map[string]string{"manifests": "shop-manifests"},
+		}
+	}
+
+	It("names the resources after the product", func() {
+		resources := newRenamer().resources(template, nautesconfigs.GIT_TYPE_GITLAB)
+
+		var names []string
+		for _, resource := range resources {
+			names = append(names, resource.Kind+"/"+resource.Name)
+		}
+		Expect(names).Should(Equal([]string{
+			nodestree.Project + "/shop-api",
+			nodestree.CodeRepo + "/shop-api-code",
+			nodestree.CodeRepo + "/shop-manifests",
+			nodestree.Enviroment + "/dev",
+			nodestree.DeploymentRuntime + "/shop-dr",
+			nodestree.ProjectPipelineRuntime + "/shop-pipeline",
+		}))
+	})
+
+	It("renames the references to the resources and repos", func() {
+		resources := newRenamer().resources(template, nautesconfigs.GIT_TYPE_GITLAB)
+
+		codeRepo := resources[1].Data.(*CodeRepoData)
+		Expect(codeRepo.Spec.Project).Should(Equal("shop-api"))
+		Expect(codeRepo.Spec.RepoName).Should(Equal("shop-api-code"))
+		Expect(codeRepo.Spec.Webhook.Events).Should(Equal([]string{"push_events"}))
+		Expect(resources[1].GitOptions.Gitlab).Should(Equal(&GitlabCodeRepoOptions{
+			Name:        "shop-api-code",
+			Path:        "shop-api-code",
+			Visibility:  "private",
+			Description: "api code",
+		}))
+		Expect(resources[2].Data.(*CodeRepoData).Spec.Webhook.Events).ShouldNot(BeNil())
+
+		deploymentRuntime := resources[4].Data.(*DeploymentRuntimeData)
+		Expect(deploymentRuntime.Spec.ProjectsRef).Should(Equal([]string{"shop-api"}))
+		Expect(deploymentRuntime.Spec.ManifestSource.CodeRepo).Should(Equal("shop-manifests"))
+		Expect(deploymentRuntime.Spec.Destination).Should(Equal("dev"))
+
+		pipelineRuntime := resources[5].Data.(*ProjectPipelineRuntimeData)
+		Expect(pipelineRuntime.Spec.Project).Should(Equal("shop-api"))
+		Expect(pipelineRuntime.Spec.PipelineSource).Should(Equal("shop-api-code"))
+		Expect(pipelineRuntime.Spec.CodeSources).Should(Equal([]string{"shop-manifests"}))
+	})
+
+	It("leaves the template unchanged", func() {
+		_ = newRenamer().resources(template, nautesconfigs.GIT_TYPE_GITLAB)
+
+		Expect(template.DeploymentRuntimes[0].Spec.ManifestSource.CodeRepo).Should(Equal("manifests"))
+		Expect(template.ProjectPipelineRuntimes[0].Spec.CodeSources).Should(Equal([]string{"manifests"}))
+	})
+
+	It("creates github repositories when the git type is github", func() {
+		resources := newRenamer().resources(template, nautesconfigs.GIT_TYPE_GITHUB)
+
+		Expect(resources[1].GitOptions.Github.Path).Should(Equal("shop-api-code"))
+	})
+
+	It("only renames the whole name or the prefix of the template", func() {
+		renamer := newRenamer()
+
+		Expect(renamer.name("tpl")).Should(Equal("shop"))
+		Expect(renamer.name("tpl-api")).Should(Equal("shop-api"))
+		Expect(renamer.name("tpl-tpl")).Should(Equal("shop-tpl"))
+		Expect(renamer.name("api-tpl")).Should(Equal("api-tpl"))
+		Expect(renamer.name("tplx-api")).Should(Equal("tplx-api"))
+		Expect(renamer.name("dev")).Should(Equal("dev"))
+	})
+
+	It("rejects a product as its own template", func() {
+		usecase := &ApplyUsecase{}
+		_, err := usecase.ApplyTemplate(context.Background(), "shop", &ProductTemplateOptions{Template: "shop"})
+		Expect(ErrorInvalidApply.Is(err)).Should(BeTrue())
+	})
+})
@@ -83,16 +83,16 @@ var (
 	// git platform group
 	defaultGroupName    = "API_SERVER_TEST_GROUP"
 	defaultProductGroup = &Group{
-		Id:       int32(560),
+		Id:       int64(560),
 		Name:     defaultGroupName,
 		Path:     defaultGroupName,
 		WebUrl:   "https://github.com/groups/" + defaultGroupName,
-		ParentId: int32(0),
+		ParentId: int64(0),
 	}
 	// git platform default project
 	defaultProjectName = nautesConfigs.Git.DefaultProductName
 	defautlProject     = &Project{
-		Id:                int32(297),
+		Id:                int64(297),
 		Name:              defaultProjectName,
 		Path:              defaultProjectName,
 		WebUrl:            fmt.Sprintf("https://github.com/test-2/%v", defaultProjectName),
@@ -34,12 +34,19 @@ type GitlabCodeRepoOptions struct {
 	Path        string `json:"path,omitempty"`
 	Visibility  string `json:"visibility,omitempty"`
 	Description string `json:"description,omitempty"`
-	NamespaceID int32  `json:"namespace_id,omitempty"`
+	NamespaceID int64  `json:"namespace_id,omitempty"`
+}
+
+type GithubCodeRepoOptions struct {
+	Name        string `json:"name,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Visibility  string `json:"visibility,omitempty"`
+	Description string `json:"description,omitempty"`
 }
 
 type GitCodeRepoOptions struct {
 	Gitlab *GitlabCodeRepoOptions
-	Github interface{}
+	Github *GithubCodeRepoOptions
 }
 
 type CloneRepositoryParam struct {
@@ -72,6 +79,36 @@ type DeployKeySecretData struct {
 	Fingerprint string
 }
 
+type MergeRequestOptions struct {
+	Title        string
+	Description  string
+	SourceBranch string
+	TargetBranch string
+}
+
+type MergeRequest struct {
+	// ID is the internal ID of the merge request in its project.
+	ID           int
+	Title        string
+	Description  string
+	State        string
+	MergeStatus  string
+	SourceBranch string
+	TargetBranch string
+	Author       string
+	WebUrl       string
+	CreatedAt    *time.Time
+}
+
+// Commit is a commit in the history of a resource file.
+type Commit struct {
+	SHA         string
+	Author      string
+	AuthorEmail string
+	Date        time.Time
+	Message     string
+}
+
 type ListOptions struct {
 	Page    int `url:"page,omitempty" json:"page,omitempty"`
 	PerPage int `url:"per_page,omitempty" json:"per_page,omitempty"`
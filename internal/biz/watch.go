@@ -0,0 +1,341 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceCreated = "created"
+	ResourceUpdated = "updated"
+	ResourceDeleted = "deleted"
+
+	// _ZeroCommit is the SHA GitLab sends for the missing side of a push that creates or deletes a branch.
+	_ZeroCommit = "0000000000000000000000000000000000000000"
+	// _WatchBufferSize is the number of events kept for a watcher that is not reading, later events are dropped.
+	_WatchBufferSize = 64
+	// _PushWorkers is the number of pushes handled at the same time.
+	_PushWorkers = 4
+	// _PushQueueSize is the number of pushes waiting for a worker, later pushes are dropped.
+	_PushQueueSize = 64
+)
+
+// ResourceEvent is a change of a resource pushed to the default project of a product.
+type ResourceEvent struct {
+	// Type is one of ResourceCreated, ResourceUpdated and ResourceDeleted.
+	Type    string `json:"type"`
+	Product string `json:"product"`
+	Kind    string `json:"kind"`
+	// Name is the name of the resource, code repos are named by their repository names.
+	Name string `json:"name"`
+	// Commit is the SHA of the pushed commit.
+	Commit string `json:"commit"`
+}
+
+// PushEvent is a push to the default project of a product.
+type PushEvent struct {
+	ProductName string
+	ProjectPath string
+	Ref         string
+	Before      string
+	After       string
+}
+
+// queuedPush is a push waiting for a worker, with the context it was received with.
+type queuedPush struct {
+	ctx  context.Context
+	push *PushEvent
+}
+
+// pushContext is cancelled when the usecase is closed, and carries the values of the context the push was received with.
+type pushContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c pushContext) Value(key interface{}) interface{} {
+	return c.values.Value(key)
+}
+
+// WatchUsecase publishes the changes of the resources pushed to the default projects to the watchers of the products.
+type WatchUsecase struct {
+	log              *log.Helper
+	gitRepo          GitRepo
+	nodestree        nodestree.NodesTree
+	resourcesUsecase *ResourcesUsecase
+	configs          *nautesconfigs.Config
+	lock             sync.Mutex
+	watchers         map[string]map[chan *ResourceEvent]struct{}
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	workers  sync.WaitGroup
+	pushes   chan *queuedPush
+	pushLock sync.Mutex
+	queued   map[string]struct{}
+}
+
+// NewWatchUsecase starts the workers handling the received pushes, the returned cleanup stops them.
+func NewWatchUsecase(logger log.Logger, gitRepo GitRepo, nodestree nodestree.NodesTree, resourcesUsecase *ResourcesUsecase, configs *nautesconfigs.Config) (*WatchUsecase, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &WatchUsecase{
+		log:              log.NewHelper(log.With(logger)),
+		gitRepo:          gitRepo,
+		nodestree:        nodestree,
+		resourcesUsecase: resourcesUsecase,
+		configs:          configs,
+		watchers:         make(map[string]map[chan *ResourceEvent]struct{}),
+		ctx:              ctx,
+		cancel:           cancel,
+		pushes:           make(chan *queuedPush, _PushQueueSize),
+		queued:           make(map[string]struct{}),
+	}
+
+	for i := 0; i < _PushWorkers; i++ {
+		w.workers.Add(1)
+		go w.handlePushes()
+	}
+
+	return w, w.close
+}
+
+// close cancels the pushes being handled and waits for the workers to stop, the pushes still queued are dropped.
+func (w *WatchUsecase) close() {
+	w.cancel()
+	w.workers.Wait()
+}
+
+// Watch subscribes to the resource events of the product, stop must be called when the events are no longer read.
+func (w *WatchUsecase) Watch(productName string) (events <-chan *ResourceEvent, stop func()) {
+	ch := make(chan *ResourceEvent, _WatchBufferSize)
+
+	w.lock.Lock()
+	if w.watchers[productName] == nil {
+		w.watchers[productName] = make(map[chan *ResourceEvent]struct{})
+	}
+	w.watchers[productName][ch] = struct{}{}
+	w.lock.Unlock()
+
+	var once sync.Once
+	stop = func() {
+		once.Do(func() {
+			w.lock.Lock()
+			defer w.lock.Unlock()
+
+			delete(w.watchers[productName], ch)
+			if len(w.watchers[productName]) == 0 {
+				delete(w.watchers, productName)
+			}
+		})
+	}
+
+	return ch, stop
+}
+
+func (w *WatchUsecase) publish(productName string, events []*ResourceEvent) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for ch := range w.watchers[productName] {
+		for _, event := range events {
+			select {
+			case ch <- event:
+			default:
+				w.log.Warnf("a watcher of product %s is not reading, the %s event of %s %s is dropped", productName, event.Type, event.Kind, event.Name)
+			}
+		}
+	}
+}
+
+// CheckWatch checks that the current user can read the product before its events are watched.
+func (w *WatchUsecase) CheckWatch(ctx context.Context, productName string) error {
+	_, _, err := w.resourcesUsecase.GetProductAndCodeRepo(ctx, productName)
+	return err
+}
+
+// ReceivePush queues the push to be handled in the background, so that the webhook is answered before the repository is cloned.
+// A push of the same commit to the product that is already queued or being handled is dropped,
+// so is a push received while the queue is full or after the usecase is closed.
+func (w *WatchUsecase) ReceivePush(ctx context.Context, push *PushEvent) {
+	if !w.isWatchedPush(push) {
+		return
+	}
+
+	key := fmt.Sprintf("%s/%s", push.ProductName, push.After)
+
+	w.pushLock.Lock()
+	defer w.pushLock.Unlock()
+
+	if w.ctx.Err() != nil {
+		w.log.Warnf("the push of %s to product %s is dropped, the watch is closed", push.After, push.ProductName)
+		return
+	}
+
+	if _, ok := w.queued[key]; ok {
+		return
+	}
+
+	select {
+	case w.pushes <- &queuedPush{ctx: ctx, push: push}:
+		w.queued[key] = struct{}{}
+	default:
+		w.log.Warnf("the push queue is full, the push of %s to product %s is dropped", push.After, push.ProductName)
+	}
+}
+
+func (w *WatchUsecase) handlePushes() {
+	defer w.workers.Done()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case queued := <-w.pushes:
+			w.handleQueuedPush(queued)
+		}
+	}
+}
+
+func (w *WatchUsecase) handleQueuedPush(queued *queuedPush) {
+	push := queued.push
+	defer func() {
+		w.pushLock.Lock()
+		defer w.pushLock.Unlock()
+
+		delete(w.queued, fmt.Sprintf("%s/%s", push.ProductName, push.After))
+	}()
+
+	_, err := w.HandlePush(pushContext{Context: w.ctx, values: queued.ctx}, push)
+	if err != nil {
+		w.log.Errorf("failed to handle the push of %s to product %s, err: %v", push.After, push.ProductName, err)
+	}
+}
+
+// isWatchedPush reports whether the push is to the main branch of the default project, other pushes have no resource events.
+func (w *WatchUsecase) isWatchedPush(push *PushEvent) bool {
+	return push.ProjectPath == w.configs.Git.DefaultProductName && push.Ref == fmt.Sprintf("refs/heads/%s", _MainBranch) && push.After != _ZeroCommit
+}
+
+// HandlePush compares the resources before and after the push, and publishes the changes to the watchers of the product.
+// Pushes to other projects or branches than the main branch of the default project are ignored.
+func (w *WatchUsecase) HandlePush(ctx context.Context, push *PushEvent) ([]*ResourceEvent, error) {
+	if !w.isWatchedPush(push) {
+		return nil, nil
+	}
+
+	_, project, err := w.resourcesUsecase.GetProductAndCodeRepo(ctx, push.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	localPath, err := w.resourcesUsecase.CloneCodeRepo(ctx, project.HttpUrlToRepo)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanCodeRepo(localPath)
+
+	before := map[string]*nodestree.Node{}
+	if push.Before != _ZeroCommit {
+		before, err = w.loadResources(ctx, localPath, push.Before)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	after, err := w.loadResources(ctx, localPath, push.After)
+	if err != nil {
+		return nil, err
+	}
+
+	events := resourceEvents(before, after)
+	for _, event := range events {
+		event.Product = push.ProductName
+		event.Commit = push.After
+	}
+
+	w.publish(push.ProductName, events)
+
+	return events, nil
+}
+
+// loadResources loads the resources of the revision by kind and name, the files that cannot be parsed are left out.
+func (w *WatchUsecase) loadResources(ctx context.Context, localPath, revision string) (map[string]*nodestree.Node, error) {
+	err := w.gitRepo.Checkout(ctx, localPath, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, _, err := w.nodestree.LoadWithViolations(localPath)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make(map[string]*nodestree.Node)
+	for _, node := range resourceNodes(&nodes) {
+		resources[fmt.Sprintf("%s/%s", node.Kind, resourceEventName(node))] = node
+	}
+
+	return resources, nil
+}
+
+func resourceEvents(before, after map[string]*nodestree.Node) []*ResourceEvent {
+	var events []*ResourceEvent
+	for key, node := range after {
+		old, ok := before[key]
+		if !ok {
+			events = append(events, &ResourceEvent{Type: ResourceCreated, Kind: node.Kind, Name: resourceEventName(node)})
+		} else if !reflect.DeepEqual(old.Content, node.Content) {
+			events = append(events, &ResourceEvent{Type: ResourceUpdated, Kind: node.Kind, Name: resourceEventName(node)})
+		}
+	}
+
+	for key, node := range before {
+		if _, ok := after[key]; !ok {
+			events = append(events, &ResourceEvent{Type: ResourceDeleted, Kind: node.Kind, Name: resourceEventName(node)})
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Kind != events[j].Kind {
+			return events[i].Kind < events[j].Kind
+		}
+		return events[i].Name < events[j].Name
+	})
+
+	return events
+}
+
+func resourceEventName(node *nodestree.Node) string {
+	if codeRepo, ok := node.Content.(*resourcev1alpha1.CodeRepo); ok && codeRepo.Spec.RepoName != "" {
+		return codeRepo.Spec.RepoName
+	}
+
+	if object, ok := node.Content.(metav1.Object); ok {
+		return object.GetName()
+	}
+
+	return node.Name
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Watch resources", func() {
+	var (
+		codeRepo    *MockCodeRepo
+		gitRepo     *MockGitRepo
+		nodesTree   *nodestree.MockNodesTree
+		beforeSHA   = "1111111111111111111111111111111111111111"
+		afterSHA    = "2222222222222222222222222222222222222222"
+		mainRef     = fmt.Sprintf("refs/heads/%s", _MainBranch)
+		projectPath = nautesConfigs.Git.DefaultProductName
+	)
+
+	newNodes := func(envs ...*nodestree.Node) nodestree.Node {
+		return nodestree.Node{
+			Path:  localRepositaryPath,
+			IsDir: true,
+			Children: []*nodestree.Node{
+				{
+					Name:     _EnvSubDir,
+					Path:     fmt.Sprintf("%s/%s", localRepositaryPath, _EnvSubDir),
+					IsDir:    true,
+					Children: envs,
+				},
+			},
+		}
+	}
+
+	var closeWatch func()
+
+	newWatchUsecase := func() *WatchUsecase {
+		resourcesUsecase := NewResourcesUsecase(logger, codeRepo, nil, gitRepo, nodesTree, nautesConfigs, nil)
+		usecase, cleanup := NewWatchUsecase(logger, gitRepo, nodesTree, resourcesUsecase, nautesConfigs)
+		closeWatch = cleanup
+		return usecase
+	}
+
+	BeforeEach(func() {
+		codeRepo = NewMockCodeRepo(ctl)
+		gitRepo = NewMockGitRepo(ctl)
+		nodesTree = nodestree.NewMockNodesTree(ctl)
+		nodesTree.EXPECT().AppendOperators(gomock.Any()).AnyTimes()
+		closeWatch = func() {}
+	})
+
+	AfterEach(func() {
+		closeWatch()
+	})
+
+	It("publishes the resources created, updated and deleted by the push", func() {
+		changed := createEnvironmentResource("env2")
+		changed.Spec.Cluster = "another-cluster"
+
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).Return(defaultProductGroup, nil)
+		codeRepo.EXPECT().GetCodeRepo(gomock.Any(), gomock.Eq(defaultProjectPath)).Return(defautlProject, nil)
+		codeRepo.EXPECT().GetCurrentUser(gomock.Any()).Return(_GitUser, _GitEmail, nil)
+		gitRepo.EXPECT().Clone(gomock.Any(), cloneRepositoryParam).Return(localRepositaryPath, nil)
+		gomock.InOrder(
+			gitRepo.EXPECT().Checkout(gomock.Any(), localRepositaryPath, beforeSHA).Return(nil),
+			nodesTree.EXPECT().LoadWithViolations(localRepositaryPath).Return(newNodes(
+				createEnvironmentNode(createEnvironmentResource("env1")),
+				createEnvironmentNode(createEnvironmentResource("env2")),
+				createEnvironmentNode(createEnvironmentResource("env3")),
+			), nil, nil),
+			gitRepo.EXPECT().Checkout(gomock.Any(), localRepositaryPath, afterSHA).Return(nil),
+			nodesTree.EXPECT().LoadWithViolations(localRepositaryPath).Return(newNodes(
+				createEnvironmentNode(createEnvironmentResource("env1")),
+				createEnvironmentNode(changed),
+				createEnvironmentNode(createEnvironmentResource("env4")),
+			), nil, nil),
+		)
+
+		usecase := newWatchUsecase()
+		events, stop := usecase.Watch(defaultGroupName)
+		defer stop()
+
+		push := &PushEvent{ProductName: defaultGroupName, ProjectPath: projectPath, Ref: mainRef, Before: beforeSHA, After: afterSHA}
+		published, err := usecase.HandlePush(context.Background(), push)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var received []string
+		for range published {
+			event := <-events
+			Expect(event.Product).Should(Equal(defaultGroupName))
+			Expect(event.Commit).Should(Equal(afterSHA))
+			received = append(received, fmt.Sprintf("%s %s/%s", event.Type, event.Kind, event.Name))
+		}
+		Expect(received).Should(Equal([]string{
+			fmt.Sprintf("%s %s/env2", ResourceUpdated, nodestree.Enviroment),
+			fmt.Sprintf("%s %s/env3", ResourceDeleted, nodestree.Enviroment),
+			fmt.Sprintf("%s %s/env4", ResourceCreated, nodestree.Enviroment),
+		}))
+	})
+
+	It("publishes nothing to the watchers of other products", func() {
+		usecase := newWatchUsecase()
+		events, stop := usecase.Watch("another-product")
+		defer stop()
+
+		usecase.publish(defaultGroupName, []*ResourceEvent{{Type: ResourceCreated, Kind: nodestree.Enviroment, Name: "env1"}})
+		Expect(events).ShouldNot(Receive())
+	})
+
+	It("ignores pushes to other branches and projects", func() {
+		usecase := newWatchUsecase()
+
+		events, err := usecase.HandlePush(context.Background(), &PushEvent{ProductName: defaultGroupName, ProjectPath: projectPath, Ref: "refs/heads/feature", Before: beforeSHA, After: afterSHA})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(events).Should(BeEmpty())
+
+		events, err = usecase.HandlePush(context.Background(), &PushEvent{ProductName: defaultGroupName, ProjectPath: "app", Ref: mainRef, Before: beforeSHA, After: afterSHA})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(events).Should(BeEmpty())
+	})
+
+	It("stops publishing to a watcher that has stopped", func() {
+		usecase := newWatchUsecase()
+		_, stop := usecase.Watch(defaultGroupName)
+		stop()
+		stop()
+
+		Expect(usecase.watchers).ShouldNot(HaveKey(defaultGroupName))
+	})
+
+	It("handles a push once while the same commit is queued or being handled", func() {
+		handling := make(chan struct{})
+		release := make(chan struct{})
+		var calls int32
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).DoAndReturn(func(ctx context.Context, gid interface{}) (*Group, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				close(handling)
+			}
+			<-release
+			return nil, ErrorGroupNotFound
+		}).AnyTimes()
+
+		usecase := newWatchUsecase()
+		push := &PushEvent{ProductName: defaultGroupName, ProjectPath: projectPath, Ref: mainRef, Before: beforeSHA, After: afterSHA}
+		usecase.ReceivePush(context.Background(), push)
+		Eventually(handling).Should(BeClosed())
+		usecase.ReceivePush(context.Background(), push)
+		usecase.ReceivePush(context.Background(), push)
+		close(release)
+		closeWatch()
+
+		Expect(atomic.LoadInt32(&calls)).Should(Equal(int32(1)))
+		Expect(usecase.queued).Should(BeEmpty())
+	})
+
+	It("cancels the pushes being handled and drops the later ones when closed", func() {
+		handling := make(chan struct{})
+		var calls int32
+		codeRepo.EXPECT().GetGroup(gomock.Any(), gomock.Eq(defaultGroupName)).DoAndReturn(func(ctx context.Context, gid interface{}) (*Group, error) {
+			atomic.AddInt32(&calls, 1)
+			Expect(ctx.Value("token")).Should(Equal("webhook-token"))
+			close(handling)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).AnyTimes()
+
+		usecase := newWatchUsecase()
+		push := &PushEvent{ProductName: defaultGroupName, ProjectPath: projectPath, Ref: mainRef, Before: beforeSHA, After: afterSHA}
+		usecase.ReceivePush(context.WithValue(context.Background(), "token", "webhook-token"), push)
+		Eventually(handling).Should(BeClosed())
+		closeWatch()
+
+		usecase.ReceivePush(context.Background(), &PushEvent{ProductName: defaultGroupName, ProjectPath: projectPath, Ref: mainRef, Before: afterSHA, After: "3333333333333333333333333333333333333333"})
+		Consistently(func() int32 { return atomic.LoadInt32(&calls) }, "100ms").Should(Equal(int32(1)))
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/internal/conf"
 	gitlabclient "github.com/nautes-labs/api-server/pkg/gitlab"
 	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
 
@@ -36,8 +37,22 @@ func NewData(logger log.Logger, configs *nautesconfigs.Config) (func(), error) {
 	return cleanup, nil
 }
 
-func NewGitRepo(config *nautesconfigs.Config) (biz.GitRepo, error) {
-	return &gitRepo{config: config}, nil
+func NewGitRepo(config *nautesconfigs.Config, data *conf.Data, k8sClient client.Client) (biz.GitRepo, error) {
+	lock, err := newRepositoryLock(data.GetRepositoryLock(), k8sClient)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &gitRepo{config: config, lock: lock, committer: data.GetGitCommitter()}
+	if data.GetGitCache().GetRoot() != "" {
+		cache, err := newRepositoryCache(data.GitCache)
+		if err != nil {
+			return nil, err
+		}
+		repo.cache = cache
+	}
+
+	return repo, nil
 }
 
 func NewSecretRepo(config *nautesconfigs.Config) (biz.Secretrepo, error) {
@@ -56,6 +71,10 @@ func NewCodeRepo(config *nautesconfigs.Config) (biz.CodeRepo, error) {
 		return NewGitlabRepo(config.Git.Addr, operator)
 	}
 
+	if config.Git.GitType == nautesconfigs.GIT_TYPE_GITHUB {
+		return NewGithubRepo(config.Git.Addr)
+	}
+
 	return nil, nil
 }
 
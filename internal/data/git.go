@@ -16,24 +16,40 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
-	"os/exec"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	git "github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/internal/conf"
 	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
 )
 
 const (
-	_DefaultProject = "/tmp/product"
+	_DefaultProjectDir     = "/tmp"
+	_DefaultProjectPattern = "product"
+	_DefaultRemote         = "origin"
 )
 
+// gitRepo implements biz.GitRepo with go-git.
+// Credentials are taken from the request context on every remote operation and never written to disk.
 type gitRepo struct {
-	config *nautesconfigs.Config
+	config    *nautesconfigs.Config
+	cache     *repositoryCache
+	lock      *repositoryLock
+	committer *conf.Data_GitCommitter
 }
 
 func extractRepoName(repoURL string) (string, error) {
@@ -51,83 +67,507 @@ func (g *gitRepo) Clone(ctx context.Context, param *biz.CloneRepositoryParam) (s
 		return "", fmt.Errorf("please check that the parameters, url, user and email are not allowed to be empty")
 	}
 
-	localRepositarySubPath := fmt.Sprintf("%s%v", _DefaultProject, time.Now().Unix())
-	err := os.MkdirAll(localRepositarySubPath, os.FileMode(0777))
+	// clone product config repository according to token
+	auth, err := basicAuth(ctx, param.User)
 	if err != nil {
 		return "", err
 	}
 
-	// clone product config repository according to token
-	token, ok := ctx.Value("token").(string)
-	if !ok {
-		return "", fmt.Errorf("token must be string type")
-	}
-	url := strings.Replace(param.URL, "https://", "", 1)
-	gitCloneURL := fmt.Sprintf("https://%s:%s@%s", param.User, token, url)
-	cmd := exec.Command("git", "clone", "--verbose", gitCloneURL)
-	cmd.Dir = localRepositarySubPath
-	data, err := cmd.CombinedOutput()
+	repoName, err := extractRepoName(param.URL)
 	if err != nil {
-		return string(data), fmt.Errorf("failed to clone the repository: %s, err: %w", url, err)
+		return "", err
 	}
 
-	repoName, err := extractRepoName(param.URL)
+	var localRepositaryPath string
+	if g.cache != nil {
+		localRepositaryPath, err = g.cache.Checkout(ctx, param.URL, param.User, auth, repoName)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		// each request clones into a directory of its own
+		localRepositarySubPath, err := os.MkdirTemp(_DefaultProjectDir, _DefaultProjectPattern)
+		if err != nil {
+			return "", err
+		}
+
+		localRepositaryPath = filepath.Join(localRepositarySubPath, repoName)
+		_, err = git.PlainCloneContext(ctx, localRepositaryPath, false, &git.CloneOptions{
+			URL:  param.URL,
+			Auth: auth,
+		})
+		if err != nil {
+			os.RemoveAll(localRepositarySubPath)
+			return "", convertGitError(fmt.Errorf("failed to clone the repository: %s, err: %w", param.URL, err))
+		}
+	}
+
+	err = setUserConfig(localRepositaryPath, param.User, param.Email)
 	if err != nil {
 		return "", err
 	}
-	// set git config user name
-	localRepositaryPath := fmt.Sprintf("%s/%s", localRepositarySubPath, repoName)
-	cmd1 := exec.Command("git", "config", "user.name", param.User)
-	cmd1.Dir = localRepositaryPath
-	err = cmd1.Run()
+
+	return localRepositaryPath, nil
+}
+
+// Lock serializes changes to the repository until the returned function is called.
+func (g *gitRepo) Lock(ctx context.Context, url string) (func(), error) {
+	return g.lock.Lock(ctx, url)
+}
+
+func setUserConfig(path, user, email string) error {
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := r.Config()
 	if err != nil {
-		return "", fmt.Errorf("failed to set git user user in %s, err: %w", localRepositaryPath, err)
+		return err
 	}
 
-	// set git config user email
-	cmd2 := exec.Command("git", "config", "user.email", param.Email)
-	cmd2.Dir = localRepositaryPath
-	err = cmd2.Run()
+	cfg.User.Name = user
+	cfg.User.Email = email
+	err = r.SetConfig(cfg)
 	if err != nil {
-		return "", fmt.Errorf("failed to set git user email in %s, err: %w", localRepositaryPath, err)
+		return fmt.Errorf("failed to set git user in %s, err: %w", path, err)
 	}
 
-	return localRepositaryPath, nil
+	return nil
 }
 
+// basicAuth builds the credentials of the current user, the token comes from the request context.
+func basicAuth(ctx context.Context, user string) (*githttp.BasicAuth, error) {
+	token, ok := ctx.Value("token").(string)
+	if !ok {
+		return nil, fmt.Errorf("token must be string type")
+	}
+
+	return &githttp.BasicAuth{Username: user, Password: token}, nil
+}
+
+// repositoryAuth builds the credentials for a cloned repository, the user is the one recorded at clone time.
+func repositoryAuth(ctx context.Context, r *git.Repository) (*githttp.BasicAuth, error) {
+	cfg, err := r.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	return basicAuth(ctx, cfg.User.Name)
+}
+
+// convertGitError maps go-git errors to the errors of biz, so that callers can tell them apart.
+func convertGitError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, transport.ErrAuthenticationRequired), errors.Is(err, transport.ErrAuthorizationFailed):
+		return biz.ErrorGitAuthFailed.WithCause(err)
+	case errors.Is(err, git.ErrForceNeeded), errors.Is(err, git.ErrNonFastForwardUpdate), strings.Contains(err.Error(), "non-fast-forward"):
+		return biz.ErrorGitNonFastForward.WithCause(err)
+	}
+
+	return err
+}
+
+// Diff returns the patch between two revisions, eg: Diff(ctx, path, "main", "remotes/origin/main").
+// With a single revision the patch is taken from that revision to HEAD.
 func (g *gitRepo) Diff(ctx context.Context, path string, command ...string) (string, error) {
-	cmd := exec.Command("git", "diff")
-	cmd.Args = append(cmd.Args, command...)
-	cmd.Dir = path
-	data, err := cmd.CombinedOutput()
+	if len(command) == 0 || len(command) > 2 {
+		return "", fmt.Errorf("diff expects one or two revisions, got %d", len(command))
+	}
+
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return "", err
+	}
+
+	revisions := append([]string{}, command...)
+	if len(revisions) == 1 {
+		revisions = append(revisions, "HEAD")
+	}
+
+	from, err := resolveCommit(r, revisions[0])
+	if err != nil {
+		return "", err
+	}
+	to, err := resolveCommit(r, revisions[1])
+	if err != nil {
+		return "", err
+	}
+
+	if from.Hash == to.Hash {
+		return "", nil
+	}
+
+	patch, err := from.PatchContext(ctx, to)
 	if err != nil {
-		return string(data), fmt.Errorf("diff data: %v, err: %w", string(data), err)
+		return "", fmt.Errorf("diff data: %s..%s, err: %w", revisions[0], revisions[1], err)
 	}
 
-	return string(data), nil
+	return patch.String(), nil
 }
 
+// Fetch updates the remote-tracking branches, the first argument is the remote name and defaults to origin.
 func (g *gitRepo) Fetch(ctx context.Context, path string, command ...string) (string, error) {
-	cmd := exec.Command("git", "fetch")
-	cmd.Args = append(cmd.Args, command...)
-	cmd.Dir = path
-	data, err := cmd.CombinedOutput()
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return "", err
+	}
+
+	auth, err := repositoryAuth(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	remote := _DefaultRemote
+	if len(command) > 0 {
+		remote = command[0]
+	}
+
+	err = r.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: remote,
+		Auth:       auth,
+	})
+	if errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return git.NoErrAlreadyUpToDate.Error(), nil
+	}
 	if err != nil {
-		return string(data), fmt.Errorf("fetch data: %v, err: %w", string(data), err)
+		return "", convertGitError(fmt.Errorf("fetch data: %s, err: %w", remote, err))
 	}
 
-	return string(data), nil
+	return "", nil
 }
 
+// Merge merges the remote-tracking branch of the current branch into it.
+// Files changed on both sides with different content are reported as biz.ErrorGitConflict.
 func (g *gitRepo) Merge(ctx context.Context, path string) (string, error) {
-	cmd := exec.Command("git", "merge")
-	cmd.Dir = path
-	data, err := cmd.CombinedOutput()
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return "", err
+	}
+
+	head, err := r.Head()
 	if err != nil {
-		return string(data), fmt.Errorf("merge data: %v, err: %w", string(data), err)
+		return "", err
+	}
+
+	upstream := plumbing.NewRemoteReferenceName(_DefaultRemote, head.Name().Short())
+	ours, err := r.CommitObject(head.Hash())
+	if err != nil {
+		return "", err
+	}
+	theirs, err := resolveCommit(r, upstream.String())
+	if err != nil {
+		return "", err
+	}
+
+	ok, err := theirs.IsAncestor(ours)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return "Already up to date.", nil
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		return "", err
+	}
+
+	ok, err = ours.IsAncestor(theirs)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		err = w.Reset(&git.ResetOptions{Commit: theirs.Hash, Mode: git.HardReset})
+		if err != nil {
+			return "", err
+		}
+		return "Fast-forward", nil
+	}
+
+	bases, err := ours.MergeBase(theirs)
+	if err != nil {
+		return "", err
+	}
+	if len(bases) == 0 {
+		return "", biz.ErrorGitConflict.WithCause(fmt.Errorf("refusing to merge unrelated histories"))
+	}
+
+	ourChanges, err := changedFiles(bases[0], ours)
+	if err != nil {
+		return "", err
+	}
+	theirChanges, err := changedFiles(bases[0], theirs)
+	if err != nil {
+		return "", err
+	}
+
+	var conflicts []string
+	for name, entry := range ourChanges {
+		theirEntry, ok := theirChanges[name]
+		if ok && theirEntry != entry {
+			conflicts = append(conflicts, name)
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return "", biz.ErrorGitConflict.WithCause(fmt.Errorf("merge conflict in %s", strings.Join(conflicts, ", ")))
 	}
 
-	return string(data), nil
+	// Start from the remote tree and replay the files only changed locally.
+	err = w.Reset(&git.ResetOptions{Commit: theirs.Hash, Mode: git.HardReset})
+	if err != nil {
+		return "", err
+	}
+
+	for name, entry := range ourChanges {
+		if _, ok := theirChanges[name]; ok {
+			continue
+		}
+
+		err = applyFile(r, w, path, name, entry)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	message := fmt.Sprintf("Merge remote-tracking branch '%s'", upstream.Short())
+	_, err = w.Commit(message, g.commitOptions(ours.Hash, theirs.Hash))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Merge made by go-git, %d file(s) changed.", len(ourChanges)), nil
+}
+
+type fileEntry struct {
+	hash plumbing.Hash
+	mode uint32
+}
+
+// changedFiles lists the files changed between two commits, a deleted file has an empty entry.
+func changedFiles(from, to *object.Commit) (map[string]fileEntry, error) {
+	fromTree, err := from.Tree()
+	if err != nil {
+		return nil, err
+	}
+	toTree, err := to.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := object.DiffTree(fromTree, toTree)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]fileEntry)
+	for _, change := range changes {
+		if change.From.Name != "" && change.From.Name != change.To.Name {
+			files[change.From.Name] = fileEntry{}
+		}
+		if change.To.Name != "" {
+			files[change.To.Name] = fileEntry{
+				hash: change.To.TreeEntry.Hash,
+				mode: uint32(change.To.TreeEntry.Mode),
+			}
+		}
+	}
+
+	return files, nil
+}
+
+func applyFile(r *git.Repository, w *git.Worktree, path, name string, entry fileEntry) error {
+	filename := filepath.Join(path, name)
+	if entry.hash.IsZero() {
+		err := os.Remove(filename)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		_, err = w.Remove(name)
+		return err
+	}
+
+	blob, err := r.BlobObject(entry.hash)
+	if err != nil {
+		return err
+	}
+	reader, err := blob.Reader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	err = os.MkdirAll(filepath.Dir(filename), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	mode := os.FileMode(0644)
+	if entry.mode&0111 != 0 {
+		mode = os.FileMode(0755)
+	}
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, reader)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Add(name)
+	return err
+}
+
+func resolveCommit(r *git.Repository, revision string) (*object.Commit, error) {
+	hash, err := r.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve revision %s, err: %w", revision, err)
+	}
+
+	return r.CommitObject(*hash)
+}
+
+// Log lists the commits that touched the file, the newest first.
+func (g *gitRepo) Log(ctx context.Context, path, file string) ([]*biz.Commit, error) {
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := r.Log(&git.LogOptions{FileName: &file})
+	if err != nil {
+		return nil, fmt.Errorf("log data: %s, err: %w", file, err)
+	}
+	defer iter.Close()
+
+	var commits []*biz.Commit
+	err = iter.ForEach(func(c *object.Commit) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		commits = append(commits, &biz.Commit{
+			SHA:         c.Hash.String(),
+			Author:      c.Author.Name,
+			AuthorEmail: c.Author.Email,
+			Date:        c.Author.When,
+			Message:     strings.TrimSpace(c.Message),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
+// Show returns the content of the file in the revision, which can be a commit SHA, a tag or a branch.
+func (g *gitRepo) Show(ctx context.Context, path, revision, file string) ([]byte, error) {
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := resolveCommit(r, revision)
+	if err != nil {
+		return nil, biz.ErrorRevisionNotFound.WithCause(err)
+	}
+
+	f, err := commit.File(file)
+	if errors.Is(err, object.ErrFileNotFound) {
+		return nil, biz.ErrorRevisionNotFound.WithCause(fmt.Errorf("%s does not exist in revision %s", file, revision))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := f.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(content), nil
+}
+
+// Checkout checks out the revision with a detached HEAD, see resolveRevision for the forms of revision.
+func (g *gitRepo) Checkout(ctx context.Context, path, revision string) error {
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return err
+	}
+
+	commit, err := resolveRevision(r, revision)
+	if err != nil {
+		return biz.ErrorRevisionNotFound.WithCause(err)
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		return err
+	}
+
+	err = w.Checkout(&git.CheckoutOptions{Hash: commit.Hash, Force: true})
+	if err != nil {
+		return fmt.Errorf("failed to check out revision %s, err: %w", revision, err)
+	}
+
+	return nil
+}
+
+// resolveRevision resolves a commit SHA, a tag, a branch or an RFC 3339 timestamp to a commit,
+// a timestamp resolves to the last commit of HEAD made at or before it.
+func resolveRevision(r *git.Repository, revision string) (*object.Commit, error) {
+	when, err := time.Parse(time.RFC3339, revision)
+	if err != nil {
+		return resolveCommit(r, revision)
+	}
+
+	head, err := r.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := r.Log(&git.LogOptions{From: head.Hash(), Order: git.LogOrderCommitterTime})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	for {
+		commit, err := iter.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("no commit was made at or before %s", revision)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !commit.Committer.When.After(when) {
+			return commit, nil
+		}
+	}
+}
+
+// Head returns the SHA of the commit checked out in the repository.
+func (g *gitRepo) Head(path string) (string, error) {
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := r.Head()
+	if err != nil {
+		return "", err
+	}
+
+	return ref.Hash().String(), nil
 }
 
 func (g *gitRepo) Status(path string) (string, error) {
@@ -149,37 +589,138 @@ func (g *gitRepo) Status(path string) (string, error) {
 	return status.String(), nil
 }
 
+// Commit stages every change in the working tree and commits it, a clean working tree is left untouched.
 func (g *gitRepo) Commit(path, message string) error {
-	cmdAdd := exec.Command("git", "add", ".")
-	cmdAdd.Dir = path
-	_, err := cmdAdd.CombinedOutput()
+	r, err := git.PlainOpen(path)
 	if err != nil {
 		return err
 	}
 
-	cmdCommit := exec.Command("git", "commit", "-m", message)
-	cmdCommit.Dir = path
-	data, err := cmdCommit.CombinedOutput()
+	w, err := r.Worktree()
+	if err != nil {
+		return err
+	}
+
+	err = w.AddWithOptions(&git.AddOptions{All: true})
+	if err != nil {
+		return err
+	}
+
+	status, err := w.Status()
 	if err != nil {
-		return fmt.Errorf("commit data: %v, err: %w", string(data), err)
+		return err
+	}
+	if status.IsClean() {
+		return nil
+	}
+
+	_, err = w.Commit(message, g.commitOptions())
+	if err != nil {
+		return fmt.Errorf("commit data: %s, err: %w", message, err)
 	}
 
 	return nil
 }
 
+// commitOptions records the configured service identity as committer,
+// the author is left to the git user of the repository, who is the user the repository was cloned for.
+func (g *gitRepo) commitOptions(parents ...plumbing.Hash) *git.CommitOptions {
+	options := &git.CommitOptions{Parents: parents}
+	if g.committer.GetName() != "" && g.committer.GetEmail() != "" {
+		options.Committer = &object.Signature{
+			Name:  g.committer.GetName(),
+			Email: g.committer.GetEmail(),
+			When:  time.Now(),
+		}
+	}
+
+	return options
+}
+
+// Push pushes to a remote, the first argument is the remote name and defaults to origin,
+// the rest are branches or refspecs and default to the current branch.
 func (g *gitRepo) Push(ctx context.Context, path string, command ...string) error {
-	cmd := exec.Command("git", "push")
-	cmd.Args = append(cmd.Args, command...)
-	cmd.Dir = path
-	data, err := cmd.CombinedOutput()
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return err
+	}
+
+	auth, err := repositoryAuth(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	remote := _DefaultRemote
+	if len(command) > 0 {
+		remote = command[0]
+	}
+
+	err = g.checkLock(r, remote)
+	if err != nil {
+		return err
+	}
+
+	var refSpecs []gitconfig.RefSpec
+	if len(command) > 1 {
+		for _, spec := range command[1:] {
+			refSpecs = append(refSpecs, branchRefSpec(spec))
+		}
+	} else {
+		head, err := r.Head()
+		if err != nil {
+			return err
+		}
+		refSpecs = append(refSpecs, branchRefSpec(head.Name().String()))
+	}
+
+	err = r.PushContext(ctx, &git.PushOptions{
+		RemoteName: remote,
+		RefSpecs:   refSpecs,
+		Auth:       auth,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return convertGitError(fmt.Errorf("push data: %s, err: %w", remote, err))
+	}
+
+	return nil
+}
+
+// checkLock fails if the lock of the remote repository was lost, another replica may be pushing to it.
+func (g *gitRepo) checkLock(r *git.Repository, remote string) error {
+	if g.lock == nil {
+		return nil
+	}
+
+	remoteConfig, err := r.Remote(remote)
 	if err != nil {
-		return fmt.Errorf("push data: %v, err: %w", string(data), err)
+		return err
+	}
+
+	for _, url := range remoteConfig.Config().URLs {
+		err := g.lock.Check(url)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (g *gitRepo) SaveConfig(ctx context.Context, path string) error {
+// branchRefSpec expands a branch name into a refspec pushing it to the branch of the same name.
+func branchRefSpec(spec string) gitconfig.RefSpec {
+	if strings.Contains(spec, ":") {
+		return gitconfig.RefSpec(spec)
+	}
+
+	ref := spec
+	if !strings.HasPrefix(ref, "refs/") {
+		ref = plumbing.NewBranchReferenceName(spec).String()
+	}
+
+	return gitconfig.RefSpec(fmt.Sprintf("%s:%s", ref, ref))
+}
+
+func (g *gitRepo) SaveConfig(ctx context.Context, path, message string) error {
 	status, err := g.Status(path)
 	if err != nil {
 		return err
@@ -187,7 +728,6 @@ func (g *gitRepo) SaveConfig(ctx context.Context, path string) error {
 
 	if status != "" {
 		// Commits the current staging area to the repository, with the new file
-		message := "api: saved configuration file"
 		err = g.Commit(path, message)
 		if err != nil {
 			return err
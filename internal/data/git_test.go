@@ -0,0 +1,387 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/internal/conf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	_TestUser  = "developer"
+	_TestEmail = "developer@nautes.io"
+)
+
+// newBareRepository creates a bare repository holding a single commit on main.
+func newBareRepository(dir string) string {
+	remote := filepath.Join(dir, "remote", "product.git")
+	r, err := git.PlainInit(remote, true)
+	Expect(err).ShouldNot(HaveOccurred())
+	err = r.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName("main")))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	seed := filepath.Join(dir, "seed")
+	s, err := git.PlainInit(seed, false)
+	Expect(err).ShouldNot(HaveOccurred())
+	err = s.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName("main")))
+	Expect(err).ShouldNot(HaveOccurred())
+	_, err = s.CreateRemote(&gitconfig.RemoteConfig{Name: _DefaultRemote, URLs: []string{remote}})
+	Expect(err).ShouldNot(HaveOccurred())
+	commitFile(seed, "README.md", "product")
+	err = s.Push(&git.PushOptions{RemoteName: _DefaultRemote})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return remote
+}
+
+func commitFile(path, name, content string) {
+	commitFileAt(path, name, content, time.Now())
+}
+
+func commitFileAt(path, name, content string, when time.Time) {
+	err := os.MkdirAll(filepath.Dir(filepath.Join(path, name)), os.ModePerm)
+	Expect(err).ShouldNot(HaveOccurred())
+	err = ioutil.WriteFile(filepath.Join(path, name), []byte(content), 0644)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	r, err := git.PlainOpen(path)
+	Expect(err).ShouldNot(HaveOccurred())
+	w, err := r.Worktree()
+	Expect(err).ShouldNot(HaveOccurred())
+	_, err = w.Add(name)
+	Expect(err).ShouldNot(HaveOccurred())
+	_, err = w.Commit(fmt.Sprintf("update %s", name), &git.CommitOptions{
+		Author: &object.Signature{Name: _TestUser, Email: _TestEmail, When: when},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+}
+
+func readRemoteFile(remote, name string) (string, error) {
+	r, err := git.PlainOpen(remote)
+	if err != nil {
+		return "", err
+	}
+	ref, err := r.Reference(plumbing.NewBranchReferenceName("main"), true)
+	if err != nil {
+		return "", err
+	}
+	commit, err := r.CommitObject(ref.Hash())
+	if err != nil {
+		return "", err
+	}
+	file, err := commit.File(name)
+	if err != nil {
+		return "", err
+	}
+
+	return file.Contents()
+}
+
+var _ = Describe("Git repository", func() {
+	var (
+		dir    string
+		remote string
+		repo   *gitRepo
+		param  *biz.CloneRepositoryParam
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "git-test")
+		Expect(err).ShouldNot(HaveOccurred())
+		remote = newBareRepository(dir)
+		repo = &gitRepo{}
+		param = &biz.CloneRepositoryParam{URL: remote, User: _TestUser, Email: _TestEmail}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("clones the repository without storing credentials", func() {
+		path, err := repo.Clone(ctx, param)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(filepath.Dir(path))
+		Expect(filepath.Base(path)).To(Equal("product"))
+
+		r, err := git.PlainOpen(path)
+		Expect(err).ShouldNot(HaveOccurred())
+		cfg, err := r.Config()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(cfg.User.Name).To(Equal(_TestUser))
+		Expect(cfg.User.Email).To(Equal(_TestEmail))
+		Expect(cfg.Remotes[_DefaultRemote].URLs).To(Equal([]string{remote}))
+	})
+
+	It("clones every request into its own directory", func() {
+		first, err := repo.Clone(ctx, param)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(filepath.Dir(first))
+		second, err := repo.Clone(ctx, param)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(filepath.Dir(second))
+
+		Expect(first).NotTo(Equal(second))
+	})
+
+	It("fails to clone without token", func() {
+		_, err := repo.Clone(context.Background(), param)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("reports an authentication failure", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		_, err := repo.Clone(ctx, &biz.CloneRepositoryParam{URL: fmt.Sprintf("%s/nautes/product.git", server.URL), User: _TestUser, Email: _TestEmail})
+		Expect(errors.Is(err, biz.ErrorGitAuthFailed)).To(BeTrue())
+	})
+
+	It("commits and pushes the changed configuration", func() {
+		repo.committer = &conf.Data_GitCommitter{Name: "nautes-api-server", Email: "api-server@nautes.io"}
+		path, err := repo.Clone(ctx, param)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(filepath.Dir(path))
+
+		err = ioutil.WriteFile(filepath.Join(path, "env.yaml"), []byte("kind: Environment"), 0644)
+		Expect(err).ShouldNot(HaveOccurred())
+		err = repo.SaveConfig(ctx, path, "api: create Environment env1 of product demo")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		content, err := readRemoteFile(remote, "env.yaml")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(content).To(Equal("kind: Environment"))
+
+		head, err := repo.Head(path)
+		Expect(err).ShouldNot(HaveOccurred())
+		remoteRepo, err := git.PlainOpen(remote)
+		Expect(err).ShouldNot(HaveOccurred())
+		ref, err := remoteRepo.Reference(plumbing.NewBranchReferenceName("main"), true)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(head).To(Equal(ref.Hash().String()))
+
+		commit, err := remoteRepo.CommitObject(ref.Hash())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(commit.Message).To(Equal("api: create Environment env1 of product demo"))
+		Expect(commit.Author.Name).To(Equal(_TestUser))
+		Expect(commit.Author.Email).To(Equal(_TestEmail))
+		Expect(commit.Committer.Name).To(Equal("nautes-api-server"))
+		Expect(commit.Committer.Email).To(Equal("api-server@nautes.io"))
+	})
+
+	It("leaves a clean working tree untouched", func() {
+		path, err := repo.Clone(ctx, param)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(filepath.Dir(path))
+
+		err = repo.Commit(path, "nothing to commit")
+		Expect(err).ShouldNot(HaveOccurred())
+		diff, err := repo.Diff(ctx, path, "main", "remotes/origin/main")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(diff).To(BeEmpty())
+	})
+
+	It("lists the history of a file and shows it in a revision", func() {
+		path, err := repo.Clone(ctx, param)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(filepath.Dir(path))
+
+		commitFile(path, "envs/env1.yaml", "cluster: c1")
+		commitFile(path, "envs/env2.yaml", "cluster: c1")
+		commitFile(path, "envs/env1.yaml", "cluster: c2")
+
+		commits, err := repo.Log(ctx, path, "envs/env1.yaml")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(commits).To(HaveLen(2))
+		Expect(commits[0].Author).To(Equal(_TestUser))
+		Expect(commits[0].AuthorEmail).To(Equal(_TestEmail))
+		Expect(commits[0].Message).To(Equal("update envs/env1.yaml"))
+
+		content, err := repo.Show(ctx, path, commits[1].SHA, "envs/env1.yaml")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(content)).To(Equal("cluster: c1"))
+
+		_, err = repo.Show(ctx, path, "main~3", "envs/env1.yaml")
+		Expect(errors.Is(err, biz.ErrorRevisionNotFound)).To(BeTrue())
+		_, err = repo.Show(ctx, path, "0000000000000000000000000000000000000000", "envs/env1.yaml")
+		Expect(errors.Is(err, biz.ErrorRevisionNotFound)).To(BeTrue())
+	})
+
+	It("checks out a revision by SHA, tag or timestamp", func() {
+		path, err := repo.Clone(ctx, param)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(filepath.Dir(path))
+
+		monday := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+		commitFileAt(path, "envs/env1.yaml", "cluster: c1", monday)
+		commitFileAt(path, "envs/env1.yaml", "cluster: c2", monday.AddDate(0, 0, 1))
+		commits, err := repo.Log(ctx, path, "envs/env1.yaml")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		r, err := git.PlainOpen(path)
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = r.CreateTag("v1", plumbing.NewHash(commits[1].SHA), nil)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		readFile := func() string {
+			content, err := ioutil.ReadFile(filepath.Join(path, "envs/env1.yaml"))
+			Expect(err).ShouldNot(HaveOccurred())
+			return string(content)
+		}
+
+		Expect(repo.Checkout(ctx, path, commits[1].SHA)).To(Succeed())
+		Expect(readFile()).To(Equal("cluster: c1"))
+		Expect(repo.Checkout(ctx, path, "main")).To(Succeed())
+		Expect(readFile()).To(Equal("cluster: c2"))
+		Expect(repo.Checkout(ctx, path, "v1")).To(Succeed())
+		Expect(readFile()).To(Equal("cluster: c1"))
+
+		Expect(repo.Checkout(ctx, path, "main")).To(Succeed())
+		Expect(repo.Checkout(ctx, path, monday.Add(12*time.Hour).Format(time.RFC3339))).To(Succeed())
+		Expect(readFile()).To(Equal("cluster: c1"))
+
+		Expect(repo.Checkout(ctx, path, "main")).To(Succeed())
+		err = repo.Checkout(ctx, path, "2000-01-01T00:00:00Z")
+		Expect(errors.Is(err, biz.ErrorRevisionNotFound)).To(BeTrue())
+		err = repo.Checkout(ctx, path, "v2")
+		Expect(errors.Is(err, biz.ErrorRevisionNotFound)).To(BeTrue())
+	})
+
+	Context("when the remote branch has moved", func() {
+		var path, other string
+
+		BeforeEach(func() {
+			var err error
+			path, err = repo.Clone(ctx, param)
+			Expect(err).ShouldNot(HaveOccurred())
+			other, err = repo.Clone(ctx, param)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			commitFile(other, "cluster.yaml", "kind: Cluster")
+			err = repo.Push(ctx, other)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(filepath.Dir(path))
+			os.RemoveAll(filepath.Dir(other))
+		})
+
+		It("shows the remote changes after fetch", func() {
+			_, err := repo.Fetch(ctx, path, "origin")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			diff, err := repo.Diff(ctx, path, "main", "remotes/origin/main")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(diff).To(ContainSubstring("cluster.yaml"))
+		})
+
+		It("rejects a push that is not a fast-forward", func() {
+			commitFile(path, "env.yaml", "kind: Environment")
+
+			err := repo.Push(ctx, path)
+			Expect(errors.Is(err, biz.ErrorGitNonFastForward)).To(BeTrue())
+		})
+
+		// Same order as ResourcesUsecase.retryAutoMerge: fetch, commit, then merge.
+		It("merges changes to different files", func() {
+			_, err := repo.Fetch(ctx, path)
+			Expect(err).ShouldNot(HaveOccurred())
+			commitFile(path, "env.yaml", "kind: Environment")
+			_, err = repo.Merge(ctx, path)
+			Expect(err).ShouldNot(HaveOccurred())
+			err = repo.Push(ctx, path)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			content, err := readRemoteFile(remote, "env.yaml")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(content).To(Equal("kind: Environment"))
+			content, err = readRemoteFile(remote, "cluster.yaml")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(content).To(Equal("kind: Cluster"))
+		})
+
+		It("fast-forwards when there are no local commits", func() {
+			_, err := repo.Fetch(ctx, path)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = repo.Merge(ctx, path)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = os.Stat(filepath.Join(path, "cluster.yaml"))
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("reports a conflict when both sides change the same file", func() {
+			_, err := repo.Fetch(ctx, path)
+			Expect(err).ShouldNot(HaveOccurred())
+			commitFile(path, "cluster.yaml", "kind: Environment")
+			_, err = repo.Merge(ctx, path)
+			Expect(errors.Is(err, biz.ErrorGitConflict)).To(BeTrue())
+		})
+	})
+
+	Context("with repository cache", func() {
+		BeforeEach(func() {
+			cache, err := newRepositoryCache(&conf.Data_GitCache{Root: filepath.Join(dir, "cache"), MaxEntries: 1})
+			Expect(err).ShouldNot(HaveOccurred())
+			repo.cache = cache
+		})
+
+		It("refreshes the cached repository and hands out isolated working copies", func() {
+			first, err := repo.Clone(ctx, param)
+			Expect(err).ShouldNot(HaveOccurred())
+			commitFile(first, "env.yaml", "kind: Environment")
+			err = repo.Push(ctx, first)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			second, err := repo.Clone(ctx, param)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(second).NotTo(Equal(first))
+
+			content, err := ioutil.ReadFile(filepath.Join(second, "env.yaml"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(content)).To(Equal("kind: Environment"))
+		})
+
+		It("evicts the least recently used repository", func() {
+			first, err := repo.Clone(ctx, param)
+			Expect(err).ShouldNot(HaveOccurred())
+			os.RemoveAll(first)
+			_, err = repo.Clone(ctx, &biz.CloneRepositoryParam{URL: remote, User: "other", Email: _TestEmail})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(repo.cache.entries).To(HaveLen(1))
+			Expect(repo.cache.entries).To(HaveKey(cacheKey(remote, "other")))
+		})
+	})
+})
@@ -0,0 +1,455 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/nautes-labs/api-server/internal/conf"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	_DefaultCacheMaxEntries = 64
+	_DefaultCacheMaxAge     = 24 * time.Hour
+	_CacheMirrorsDir        = "mirrors"
+	_CacheWorktreesDir      = "worktrees"
+	_CacheEvictedDir        = "evicted"
+	_CacheHit               = "hit"
+	_CacheMiss              = "miss"
+)
+
+var (
+	gitCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "api_server",
+		Subsystem: "git_cache",
+		Name:      "requests_total",
+		Help:      "Number of repository cache lookups, partitioned by hit or miss.",
+	}, []string{"result"})
+	gitCacheEvictions = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "api_server",
+		Subsystem: "git_cache",
+		Name:      "evictions_total",
+		Help:      "Number of cached repositories removed by the eviction policy.",
+	})
+	gitCacheEntries = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "api_server",
+		Subsystem: "git_cache",
+		Name:      "entries",
+		Help:      "Number of repositories currently kept in the cache.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(gitCacheRequests, gitCacheEvictions, gitCacheEntries)
+}
+
+type cacheEntry struct {
+	mu        sync.Mutex
+	path      string
+	lastUsed  time.Time
+	inUse     int
+	worktrees []string
+}
+
+// referenced reports whether a working copy handed out from the entry still exists.
+// Working copies read their objects from the cached repository, so it has to stay in place until they are removed.
+func (e *cacheEntry) referenced() bool {
+	var worktrees []string
+	for _, path := range e.worktrees {
+		if _, err := os.Stat(path); err == nil {
+			worktrees = append(worktrees, path)
+		}
+	}
+	e.worktrees = worktrees
+
+	return len(worktrees) > 0
+}
+
+// repositoryCache keeps a working copy of every repository on disk, keyed by repository url and user.
+// Callers never touch the cached copy directly, each checkout gets its own working copy,
+// which shares the objects of the cached copy instead of duplicating them.
+type repositoryCache struct {
+	mu         sync.Mutex
+	root       string
+	maxEntries int
+	maxAge     time.Duration
+	entries    map[string]*cacheEntry
+}
+
+func newRepositoryCache(c *conf.Data_GitCache) (*repositoryCache, error) {
+	cache := &repositoryCache{
+		root:       c.Root,
+		maxEntries: int(c.MaxEntries),
+		maxAge:     c.MaxAge.AsDuration(),
+		entries:    make(map[string]*cacheEntry),
+	}
+	if cache.maxEntries <= 0 {
+		cache.maxEntries = _DefaultCacheMaxEntries
+	}
+	if cache.maxAge <= 0 {
+		cache.maxAge = _DefaultCacheMaxAge
+	}
+
+	// Working copies handed out by a previous process are never cleaned by their callers,
+	// and the repositories it evicted may not have been removed yet.
+	for _, dir := range []string{cache.worktreesDir(), cache.evictedDir()} {
+		err := os.RemoveAll(dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, dir := range []string{cache.mirrorsDir(), cache.worktreesDir(), cache.evictedDir()} {
+		err := os.MkdirAll(dir, os.FileMode(0700))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create repository cache directory %s, err: %w", dir, err)
+		}
+	}
+
+	mirrors, err := ioutil.ReadDir(cache.mirrorsDir())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mirror := range mirrors {
+		if !mirror.IsDir() {
+			continue
+		}
+		cache.entries[mirror.Name()] = &cacheEntry{
+			path:     filepath.Join(cache.mirrorsDir(), mirror.Name()),
+			lastUsed: mirror.ModTime(),
+		}
+	}
+	gitCacheEntries.Set(float64(len(cache.entries)))
+
+	return cache, nil
+}
+
+func (c *repositoryCache) mirrorsDir() string {
+	return filepath.Join(c.root, _CacheMirrorsDir)
+}
+
+func (c *repositoryCache) worktreesDir() string {
+	return filepath.Join(c.root, _CacheWorktreesDir)
+}
+
+func (c *repositoryCache) evictedDir() string {
+	return filepath.Join(c.root, _CacheEvictedDir)
+}
+
+func cacheKey(url, user string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s", url, user)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Checkout refreshes the cached copy of the repository and returns the path of an isolated working copy.
+// The working copy belongs to the caller, who removes it when done.
+func (c *repositoryCache) Checkout(ctx context.Context, url, user string, auth transport.AuthMethod, repoName string) (string, error) {
+	entry, hit := c.acquire(cacheKey(url, user))
+	defer c.release(entry)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if hit {
+		err := refreshMirror(ctx, entry.path, auth)
+		if err != nil {
+			// A fresh clone replaces the objects that the working copies still in use read from.
+			if c.referenced(entry) {
+				return "", convertGitError(fmt.Errorf("failed to refresh the cached repository %s, err: %w", url, err))
+			}
+			hit = false
+		}
+	}
+
+	if !hit {
+		err := cloneMirror(ctx, entry.path, url, auth)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if hit {
+		gitCacheRequests.WithLabelValues(_CacheHit).Inc()
+	} else {
+		gitCacheRequests.WithLabelValues(_CacheMiss).Inc()
+	}
+
+	worktree, err := os.MkdirTemp(c.worktreesDir(), _DefaultProjectPattern)
+	if err != nil {
+		return "", err
+	}
+
+	localRepositaryPath := filepath.Join(worktree, repoName)
+	err = checkoutWorktree(entry.path, localRepositaryPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to check out cached repository %s, err: %w", url, err)
+	}
+	c.track(entry, localRepositaryPath)
+
+	return localRepositaryPath, nil
+}
+
+func (c *repositoryCache) acquire(key string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	evicted := c.evict(key)
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &cacheEntry{path: filepath.Join(c.mirrorsDir(), key)}
+		c.entries[key] = entry
+		gitCacheEntries.Set(float64(len(c.entries)))
+	}
+	entry.inUse++
+	entry.lastUsed = time.Now()
+	c.mu.Unlock()
+
+	// Deleting repositories is slow, it is done after unlocking so that other checkouts are not stalled.
+	for _, path := range evicted {
+		_ = os.RemoveAll(path)
+	}
+	pruneEmptyDirs(c.worktreesDir())
+
+	return entry, ok
+}
+
+func (c *repositoryCache) release(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry.inUse--
+}
+
+func (c *repositoryCache) track(entry *cacheEntry, worktree string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry.worktrees = append(entry.worktrees, worktree)
+}
+
+func (c *repositoryCache) referenced(entry *cacheEntry) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return entry.referenced()
+}
+
+// evict removes cached repositories that have not been used within max age,
+// then the least recently used ones until the cache holds no more than max entries.
+// Entries in use, or with working copies that still exist, are never evicted. The caller must hold c.mu and delete the returned paths.
+func (c *repositoryCache) evict(skip string) []string {
+	var evicted []string
+	var candidates []string
+	for key, entry := range c.entries {
+		if key == skip || entry.inUse > 0 || entry.referenced() {
+			continue
+		}
+		if time.Since(entry.lastUsed) > c.maxAge {
+			if path := c.remove(key); path != "" {
+				evicted = append(evicted, path)
+			}
+			continue
+		}
+		candidates = append(candidates, key)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return c.entries[candidates[i]].lastUsed.Before(c.entries[candidates[j]].lastUsed)
+	})
+	for _, key := range candidates {
+		if len(c.entries) < c.maxEntries {
+			break
+		}
+		if path := c.remove(key); path != "" {
+			evicted = append(evicted, path)
+		}
+	}
+
+	return evicted
+}
+
+// remove drops the repository from the cache and moves it out of the way of a new checkout of the same key.
+// It returns the path the repository was moved to, or an empty path if it cannot be moved,
+// then it is left in place and removed by the next clone into its path.
+func (c *repositoryCache) remove(key string) string {
+	path := c.entries[key].path
+	delete(c.entries, key)
+	gitCacheEvictions.Inc()
+	gitCacheEntries.Set(float64(len(c.entries)))
+
+	evictedPath := filepath.Join(c.evictedDir(), fmt.Sprintf("%s-%d", key, time.Now().UnixNano()))
+	err := os.Rename(path, evictedPath)
+	if err != nil {
+		return ""
+	}
+
+	return evictedPath
+}
+
+func cloneMirror(ctx context.Context, path, url string, auth transport.AuthMethod) error {
+	err := os.RemoveAll(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = git.PlainCloneContext(ctx, path, false, &git.CloneOptions{
+		URL:  url,
+		Auth: auth,
+	})
+	if err != nil {
+		return convertGitError(fmt.Errorf("failed to clone the repository to cache, err: %w", err))
+	}
+
+	return nil
+}
+
+// refreshMirror brings the cached copy up to date with the remote branch it tracks.
+func refreshMirror(ctx context.Context, path string, auth transport.AuthMethod) error {
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return err
+	}
+
+	err = r.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: _DefaultRemote,
+		Auth:       auth,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return err
+	}
+
+	head, err := r.Head()
+	if err != nil {
+		return err
+	}
+
+	remoteRef, err := r.Reference(plumbing.NewRemoteReferenceName(_DefaultRemote, head.Name().Short()), true)
+	if err != nil {
+		return err
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		return err
+	}
+
+	err = w.Reset(&git.ResetOptions{Commit: remoteRef.Hash(), Mode: git.HardReset})
+	if err != nil {
+		return err
+	}
+
+	return w.Clean(&git.CleanOptions{Dir: true})
+}
+
+// checkoutWorktree creates a working copy of the cached repository at path.
+// The working copy borrows the objects of the cached repository through git alternates,
+// only its references, remotes and checked out files are its own.
+func checkoutWorktree(mirrorPath, path string) error {
+	mirrorPath, err := filepath.Abs(mirrorPath)
+	if err != nil {
+		return err
+	}
+
+	mirror, err := git.PlainOpen(mirrorPath)
+	if err != nil {
+		return err
+	}
+
+	head, err := mirror.Head()
+	if err != nil {
+		return err
+	}
+
+	mirrorConfig, err := mirror.Config()
+	if err != nil {
+		return err
+	}
+
+	r, err := git.PlainInit(path, false)
+	if err != nil {
+		return err
+	}
+
+	alternates := filepath.Join(path, git.GitDirName, "objects", "info", "alternates")
+	err = os.MkdirAll(filepath.Dir(alternates), os.FileMode(0700))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(alternates, []byte(filepath.Join(mirrorPath, git.GitDirName, "objects")+"\n"), os.FileMode(0600))
+	if err != nil {
+		return err
+	}
+
+	cfg, err := r.Config()
+	if err != nil {
+		return err
+	}
+	cfg.Remotes = mirrorConfig.Remotes
+	cfg.Branches = mirrorConfig.Branches
+	err = r.SetConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	refs, err := mirror.References()
+	if err != nil {
+		return err
+	}
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		return r.Storer.SetReference(ref)
+	})
+	if err != nil {
+		return err
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		return err
+	}
+
+	return w.Reset(&git.ResetOptions{Commit: head.Hash(), Mode: git.HardReset})
+}
+
+func pruneEmptyDirs(root string) {
+	dirs, err := ioutil.ReadDir(root)
+	if err != nil {
+		return
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		path := filepath.Join(root, dir.Name())
+		children, err := ioutil.ReadDir(path)
+		if err == nil && len(children) == 0 {
+			_ = os.Remove(path)
+		}
+	}
+}
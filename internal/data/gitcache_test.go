@@ -0,0 +1,174 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/nautes-labs/api-server/internal/conf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ = Describe("Repository cache", func() {
+	var (
+		dir    string
+		remote string
+		cache  *repositoryCache
+	)
+
+	newCache := func(maxEntries int32, maxAge time.Duration) *repositoryCache {
+		cache, err := newRepositoryCache(&conf.Data_GitCache{
+			Root:       filepath.Join(dir, "cache"),
+			MaxEntries: maxEntries,
+			MaxAge:     durationpb.New(maxAge),
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return cache
+	}
+
+	checkout := func(user string) string {
+		path, err := cache.Checkout(ctx, remote, user, nil, "product")
+		Expect(err).ShouldNot(HaveOccurred())
+		return path
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "git-cache-test")
+		Expect(err).ShouldNot(HaveOccurred())
+		remote = newBareRepository(dir)
+		cache = newCache(2, time.Hour)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("clones the repository on a miss and refreshes it on a hit", func() {
+		misses := testutil.ToFloat64(gitCacheRequests.WithLabelValues(_CacheMiss))
+		hits := testutil.ToFloat64(gitCacheRequests.WithLabelValues(_CacheHit))
+
+		first := checkout(_TestUser)
+		Expect(testutil.ToFloat64(gitCacheRequests.WithLabelValues(_CacheMiss))).To(Equal(misses + 1))
+
+		commitFile(first, "env.yaml", "kind: Environment")
+		Expect((&gitRepo{}).Push(ctx, first)).To(Succeed())
+
+		second := checkout(_TestUser)
+		Expect(testutil.ToFloat64(gitCacheRequests.WithLabelValues(_CacheHit))).To(Equal(hits + 1))
+		Expect(filepath.Join(second, "env.yaml")).To(BeAnExistingFile())
+		Expect(cache.entries).To(HaveLen(1))
+	})
+
+	It("hands out working copies that do not share changes", func() {
+		first := checkout(_TestUser)
+		err := ioutil.WriteFile(filepath.Join(first, "draft.yaml"), []byte("kind: Environment"), 0644)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		second := checkout(_TestUser)
+		Expect(filepath.Dir(second)).NotTo(Equal(filepath.Dir(first)))
+		Expect(filepath.Join(second, "draft.yaml")).NotTo(BeAnExistingFile())
+	})
+
+	It("shares the objects of the cached copy with the working copies", func() {
+		path := checkout(_TestUser)
+
+		alternates, err := ioutil.ReadFile(filepath.Join(path, ".git", "objects", "info", "alternates"))
+		Expect(err).ShouldNot(HaveOccurred())
+		mirror, err := filepath.Abs(cache.entries[cacheKey(remote, _TestUser)].path)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(alternates)).To(Equal(filepath.Join(mirror, ".git", "objects") + "\n"))
+		packs, err := ioutil.ReadDir(filepath.Join(path, ".git", "objects", "pack"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(packs).To(BeEmpty())
+
+		commitFile(path, "env.yaml", "kind: Environment")
+		Expect((&gitRepo{}).Push(ctx, path)).To(Succeed())
+	})
+
+	It("keeps a cached copy per user", func() {
+		checkout(_TestUser)
+		checkout("maintainer")
+
+		Expect(cache.entries).To(HaveLen(2))
+		Expect(cache.entries).To(HaveKey(cacheKey(remote, _TestUser)))
+		Expect(cache.entries).To(HaveKey(cacheKey(remote, "maintainer")))
+	})
+
+	It("evicts the least recently used copy when the cache is full", func() {
+		os.RemoveAll(checkout(_TestUser))
+		evicted := cache.entries[cacheKey(remote, _TestUser)].path
+		os.RemoveAll(checkout("maintainer"))
+		checkout("owner")
+
+		Expect(cache.entries).To(HaveLen(2))
+		Expect(cache.entries).NotTo(HaveKey(cacheKey(remote, _TestUser)))
+		Expect(evicted).NotTo(BeADirectory())
+		entries, err := ioutil.ReadDir(cache.evictedDir())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("evicts the copies that have not been used within max age", func() {
+		os.RemoveAll(checkout(_TestUser))
+		cache.entries[cacheKey(remote, _TestUser)].lastUsed = time.Now().Add(-2 * time.Hour)
+		checkout("maintainer")
+
+		Expect(cache.entries).To(HaveLen(1))
+		Expect(cache.entries).To(HaveKey(cacheKey(remote, "maintainer")))
+	})
+
+	It("never evicts a copy in use", func() {
+		entry, _ := cache.acquire(cacheKey(remote, _TestUser))
+		defer cache.release(entry)
+		entry.lastUsed = time.Now().Add(-2 * time.Hour)
+
+		checkout("maintainer")
+		checkout("owner")
+
+		Expect(cache.entries).To(HaveKey(cacheKey(remote, _TestUser)))
+	})
+
+	It("never evicts a copy whose working copies still exist", func() {
+		path := checkout(_TestUser)
+		cache.entries[cacheKey(remote, _TestUser)].lastUsed = time.Now().Add(-2 * time.Hour)
+
+		checkout("maintainer")
+		checkout("owner")
+
+		Expect(cache.entries).To(HaveKey(cacheKey(remote, _TestUser)))
+		Expect(filepath.Join(path, "README.md")).To(BeAnExistingFile())
+
+		os.RemoveAll(path)
+		checkout("maintainer")
+		Expect(cache.entries).NotTo(HaveKey(cacheKey(remote, _TestUser)))
+	})
+
+	It("loads the copies cached by a previous process", func() {
+		checkout(_TestUser)
+
+		cache = newCache(2, time.Hour)
+		Expect(cache.entries).To(HaveKey(cacheKey(remote, _TestUser)))
+		entries, err := ioutil.ReadDir(cache.worktreesDir())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+})
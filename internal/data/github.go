@@ -0,0 +1,632 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/v41/github"
+	commonv1 "github.com/nautes-labs/api-server/api/common/v1"
+	"github.com/nautes-labs/api-server/internal/biz"
+)
+
+const (
+	_GithubHost           = "github.com"
+	_GithubVisibilityPriv = "private"
+	_GithubVisibilityPub  = "public"
+	_GithubPerPage        = 100
+)
+
+// githubRepo implements biz.CodeRepo with the GitHub REST API.
+// A product is an organization, the team of the same name in it is granted access to every repository of the product,
+// and a coderepo is a repository of the organization.
+type githubRepo struct {
+	url        string
+	httpClient *http.Client
+}
+
+func NewGithubRepo(url string) (*githubRepo, error) {
+	return &githubRepo{url: url, httpClient: http.DefaultClient}, nil
+}
+
+func (g *githubRepo) GetCurrentUser(ctx context.Context) (user string, email string, err error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return
+	}
+
+	currentUser, _, err := client.Users.Get(ctx, "")
+	if err != nil {
+		return
+	}
+
+	email = currentUser.GetEmail()
+	if email == "" {
+		email = fmt.Sprintf("%d+%s@users.noreply.github.com", currentUser.GetID(), currentUser.GetLogin())
+	}
+
+	return currentUser.GetLogin(), email, nil
+}
+
+func (g *githubRepo) CreateCodeRepo(ctx context.Context, gid int, options *biz.GitCodeRepoOptions) (*biz.Project, error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := g.getOrganization(ctx, client, gid)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := githubRepository(githubCodeRepoOptions(options))
+	team, res, err := client.Teams.GetTeamBySlug(ctx, org.GetLogin(), strings.ToLower(org.GetLogin()))
+	if err != nil && (res == nil || res.StatusCode != http.StatusNotFound) {
+		return nil, err
+	}
+	if team != nil {
+		repo.TeamID = team.ID
+	}
+
+	repository, res, err := client.Repositories.Create(ctx, org.GetLogin(), repo)
+	if err != nil && res != nil && res.StatusCode == http.StatusForbidden {
+		return nil, commonv1.ErrorNoAuthorization("no permission to create repository, err: %s", err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return convertGithubRepository(repository), nil
+}
+
+func (g *githubRepo) DeleteCodeRepo(ctx context.Context, pid interface{}) error {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return err
+	}
+
+	repository, err := g.getRepository(ctx, client, pid)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Repositories.Delete(ctx, repository.GetOwner().GetLogin(), repository.GetName())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *githubRepo) UpdateCodeRepo(ctx context.Context, pid interface{}, options *biz.GitCodeRepoOptions) (*biz.Project, error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := g.getRepository(ctx, client, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, _, err = client.Repositories.Edit(ctx, repository.GetOwner().GetLogin(), repository.GetName(), githubRepository(githubCodeRepoOptions(options)))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertGithubRepository(repository), nil
+}
+
+func (g *githubRepo) GetCodeRepo(ctx context.Context, pid interface{}) (*biz.Project, error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := g.getRepository(ctx, client, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertGithubRepository(repository), nil
+}
+
+// CreateGroup creates the organization of the product and the team that is granted its repositories.
+// Organizations can only be created through the API of GitHub Enterprise Server.
+func (g *githubRepo) CreateGroup(ctx context.Context, gitOptions *biz.GitGroupOptions) (*biz.Group, error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	options := gitOptions.Github
+	if options == nil {
+		return nil, fmt.Errorf("the github options of the product cannot be empty")
+	}
+
+	if g.isGithubCom() {
+		return nil, biz.ErrorGroupNotSupported.WithCause(fmt.Errorf("github.com cannot create organization %s through its API, create it on github.com first", options.Path))
+	}
+
+	admin, _, err := g.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, res, err := client.Admin.CreateOrg(ctx, &github.Organization{
+		Login:       github.String(options.Path),
+		Name:        github.String(options.Name),
+		Description: github.String(options.Description),
+	}, admin)
+	if err != nil && res != nil && res.StatusCode == http.StatusForbidden {
+		return nil, commonv1.ErrorNoAuthorization("no permission to create organization, err: %s", err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	privacy := "closed"
+	if options.Visibility == _GithubVisibilityPriv {
+		privacy = "secret"
+	}
+	_, res, err = client.Teams.CreateTeam(ctx, org.GetLogin(), github.NewTeam{
+		Name:        org.GetLogin(),
+		Description: github.String(options.Description),
+		Privacy:     github.String(privacy),
+	})
+	if err != nil && (res == nil || res.StatusCode != http.StatusUnprocessableEntity) {
+		return nil, err
+	}
+
+	return convertGithubOrganization(org), nil
+}
+
+func (g *githubRepo) DeleteGroup(ctx context.Context, gid interface{}) error {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return err
+	}
+
+	org, err := g.getOrganization(ctx, client, gid)
+	if err != nil {
+		return err
+	}
+
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("orgs/%s", org.GetLogin()), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Do(ctx, req, nil)
+	if err != nil && res != nil && res.StatusCode == http.StatusForbidden {
+		return commonv1.ErrorNoAuthorization("no permission to delete organization, err: %s", err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *githubRepo) UpdateGroup(ctx context.Context, gid interface{}, git *biz.GitGroupOptions) (*biz.Group, error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := g.getOrganization(ctx, client, gid)
+	if err != nil {
+		return nil, err
+	}
+
+	if git.Github == nil {
+		return convertGithubOrganization(org), nil
+	}
+
+	org, _, err = client.Organizations.Edit(ctx, org.GetLogin(), &github.Organization{
+		Name:        github.String(git.Github.Name),
+		Description: github.String(git.Github.Description),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertGithubOrganization(org), nil
+}
+
+func (g *githubRepo) GetGroup(ctx context.Context, gid interface{}) (*biz.Group, error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := g.getOrganization(ctx, client, gid)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertGithubOrganization(org), nil
+}
+
+func (g *githubRepo) ListGroupCodeRepos(ctx context.Context, gid interface{}, opts ...interface{}) ([]*biz.Project, error) {
+	var page, per_page int
+	var result []*biz.Project
+
+	if len(opts) > 0 {
+		if value, ok := opts[0].(int); ok {
+			page = value
+		}
+	}
+
+	if len(opts) > 1 {
+		if value, ok := opts[1].(int); ok {
+			per_page = value
+		}
+	}
+
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := g.getOrganization(ctx, client, gid)
+	if err != nil {
+		return nil, err
+	}
+
+	repositories, _, err := client.Repositories.ListByOrg(ctx, org.GetLogin(), &github.RepositoryListByOrgOptions{
+		ListOptions: github.ListOptions{
+			Page:    page,
+			PerPage: per_page,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, repository := range repositories {
+		result = append(result, convertGithubRepository(repository))
+	}
+
+	return result, nil
+}
+
+func (g *githubRepo) ListAllGroups(ctx context.Context) ([]*biz.Group, error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*biz.Group
+	opts := &github.ListOptions{PerPage: _GithubPerPage}
+	for {
+		orgs, res, err := client.Organizations.List(ctx, "", opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, org := range orgs {
+			groups = append(groups, convertGithubOrganization(org))
+		}
+
+		if res.NextPage == 0 {
+			break
+		}
+		opts.Page = res.NextPage
+	}
+
+	return groups, nil
+}
+
+func (g *githubRepo) ListDeployKeys(ctx context.Context, pid interface{}, opt *biz.ListOptions) ([]*biz.ProjectDeployKey, error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := g.getRepository(ctx, client, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, res, err := client.Repositories.ListKeys(ctx, repository.GetOwner().GetLogin(), repository.GetName(), &github.ListOptions{Page: opt.Page, PerPage: opt.PerPage})
+	if err != nil && res != nil && res.StatusCode == http.StatusNotFound {
+		return nil, commonv1.ErrorDeploykeyNotFound("failed to list deploykeys, err: %s", err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	projectDeployKeys := []*biz.ProjectDeployKey{}
+	for _, key := range keys {
+		projectDeployKeys = append(projectDeployKeys, convertGithubKey(key))
+	}
+
+	return projectDeployKeys, nil
+}
+
+func (g *githubRepo) DeleteDeployKey(ctx context.Context, pid interface{}, deployKey int) error {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return err
+	}
+
+	repository, err := g.getRepository(ctx, client, pid)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Repositories.DeleteKey(ctx, repository.GetOwner().GetLogin(), repository.GetName(), int64(deployKey))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *githubRepo) GetDeployKey(ctx context.Context, pid interface{}, deployKeyID int) (*biz.ProjectDeployKey, error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := g.getRepository(ctx, client, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	key, res, err := client.Repositories.GetKey(ctx, repository.GetOwner().GetLogin(), repository.GetName(), int64(deployKeyID))
+	if err != nil && res != nil && res.StatusCode == http.StatusNotFound {
+		return nil, commonv1.ErrorDeploykeyNotFound("failed to get deploy key, err: %s", err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return convertGithubKey(key), nil
+}
+
+func (g *githubRepo) SaveDeployKey(ctx context.Context, publicKey []byte, project *biz.Project) (*biz.ProjectDeployKey, error) {
+	client, err := NewGithubClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := g.getRepository(ctx, client, int(project.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	key, _, err := client.Repositories.CreateKey(ctx, repository.GetOwner().GetLogin(), repository.GetName(), &github.Key{
+		Title:    github.String(fmt.Sprintf("repo-%v", project.Id)),
+		Key:      github.String(strings.TrimSpace(string(publicKey))),
+		ReadOnly: github.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertGithubKey(key), nil
+}
+
+// getRepository accepts the id of the repository or its full name, just like GitLab accepts a project id or path.
+func (g *githubRepo) getRepository(ctx context.Context, client *github.Client, pid interface{}) (*github.Repository, error) {
+	var repository *github.Repository
+	var res *github.Response
+	var err error
+
+	switch id := pid.(type) {
+	case int:
+		repository, res, err = client.Repositories.GetByID(ctx, int64(id))
+	case int32:
+		repository, res, err = client.Repositories.GetByID(ctx, int64(id))
+	case int64:
+		repository, res, err = client.Repositories.GetByID(ctx, id)
+	case string:
+		names := strings.SplitN(id, "/", 2)
+		if len(names) != 2 {
+			return nil, commonv1.ErrorProjectNotFound("the repository %s must be in the form of owner/name", id)
+		}
+		repository, res, err = client.Repositories.Get(ctx, names[0], names[1])
+	default:
+		return nil, fmt.Errorf("unsupported repository id type %T", pid)
+	}
+
+	if err != nil && res != nil && res.StatusCode == http.StatusForbidden {
+		return nil, commonv1.ErrorNoAuthorization("no permission to get repository, err: %s", err)
+	}
+
+	if err != nil && res != nil && res.StatusCode == http.StatusNotFound {
+		return nil, commonv1.ErrorProjectNotFound("failed to get repository, err: %s", err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+// getOrganization accepts the id of the organization or its login.
+func (g *githubRepo) getOrganization(ctx context.Context, client *github.Client, gid interface{}) (*github.Organization, error) {
+	var org *github.Organization
+	var res *github.Response
+	var err error
+
+	switch id := gid.(type) {
+	case int:
+		org, res, err = client.Organizations.GetByID(ctx, int64(id))
+	case int32:
+		org, res, err = client.Organizations.GetByID(ctx, int64(id))
+	case int64:
+		org, res, err = client.Organizations.GetByID(ctx, id)
+	case string:
+		org, res, err = client.Organizations.Get(ctx, id)
+	default:
+		return nil, fmt.Errorf("unsupported organization id type %T", gid)
+	}
+
+	if err != nil && res != nil && res.StatusCode == http.StatusForbidden {
+		return nil, commonv1.ErrorNoAuthorization("no access to the server, err: %s", err)
+	}
+
+	if err != nil && res != nil && res.StatusCode == http.StatusNotFound {
+		return nil, commonv1.ErrorGroupNotFound("failed to get organization, err: %s", err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+func githubCodeRepoOptions(options *biz.GitCodeRepoOptions) *biz.GithubCodeRepoOptions {
+	if options != nil && options.Github != nil {
+		return options.Github
+	}
+
+	return &biz.GithubCodeRepoOptions{}
+}
+
+func githubRepository(options *biz.GithubCodeRepoOptions) *github.Repository {
+	repository := &github.Repository{}
+	name := options.Path
+	if name == "" {
+		name = options.Name
+	}
+	if name != "" {
+		repository.Name = github.String(name)
+	}
+	if options.Description != "" {
+		repository.Description = github.String(options.Description)
+	}
+	if options.Visibility != "" {
+		repository.Private = github.Bool(options.Visibility == _GithubVisibilityPriv)
+	}
+
+	return repository
+}
+
+func convertGithubRepository(repository *github.Repository) *biz.Project {
+	visibility := _GithubVisibilityPub
+	if repository.GetPrivate() {
+		visibility = _GithubVisibilityPriv
+	}
+
+	return &biz.Project{
+		Id:                repository.GetID(),
+		Name:              repository.GetName(),
+		Visibility:        visibility,
+		Description:       repository.GetDescription(),
+		Path:              repository.GetName(),
+		WebUrl:            repository.GetHTMLURL(),
+		SshUrlToRepo:      repository.GetSSHURL(),
+		HttpUrlToRepo:     repository.GetCloneURL(),
+		PathWithNamespace: repository.GetFullName(),
+		Maintainer:        repository.GetPermissions()["admin"] || repository.GetPermissions()["maintain"],
+	}
+}
+
+func convertGithubOrganization(org *github.Organization) *biz.Group {
+	name := org.GetName()
+	if name == "" {
+		name = org.GetLogin()
+	}
+
+	return &biz.Group{
+		Id:          org.GetID(),
+		Name:        name,
+		Visibility:  _GithubVisibilityPriv,
+		Description: org.GetDescription(),
+		Path:        org.GetLogin(),
+		WebUrl:      org.GetHTMLURL(),
+	}
+}
+
+func convertGithubKey(key *github.Key) *biz.ProjectDeployKey {
+	projectDeployKey := &biz.ProjectDeployKey{
+		ID:      int(key.GetID()),
+		Title:   key.GetTitle(),
+		Key:     key.GetKey(),
+		CanPush: !key.GetReadOnly(),
+	}
+	if key.CreatedAt != nil {
+		projectDeployKey.CreatedAt = &key.CreatedAt.Time
+	}
+
+	return projectDeployKey
+}
+
+type githubTokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *githubTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.token))
+	return t.base.RoundTrip(req)
+}
+
+func NewGithubClient(ctx context.Context, g *githubRepo) (*github.Client, error) {
+	token := ctx.Value("token")
+	if token == nil {
+		return nil, fmt.Errorf("token is not found")
+	}
+	tokenstring, ok := token.(string)
+	if !ok {
+		return nil, fmt.Errorf("token type error, it must be string")
+	}
+
+	base := g.httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	httpClient := &http.Client{
+		Transport: &githubTokenTransport{token: tokenstring, base: base},
+		Timeout:   g.httpClient.Timeout,
+	}
+
+	// github.com serves its API from api.github.com, GitHub Enterprise Server serves it under /api/v3 of the host.
+	if g.isGithubCom() {
+		return github.NewClient(httpClient), nil
+	}
+
+	return github.NewEnterpriseClient(g.url, g.url, httpClient)
+}
+
+// isGithubCom reports whether the repositories are hosted on github.com rather than on GitHub Enterprise Server.
+func (g *githubRepo) isGithubCom() bool {
+	if g.url == "" {
+		return true
+	}
+
+	addr, err := url.Parse(g.url)
+	if err != nil {
+		return false
+	}
+
+	return addr.Host == _GithubHost || addr.Host == "api."+_GithubHost
+}
@@ -0,0 +1,205 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	commonv1 "github.com/nautes-labs/api-server/api/common/v1"
+	"github.com/nautes-labs/api-server/internal/biz"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GitHub code repo", func() {
+	var (
+		server   *httptest.Server
+		mux      *http.ServeMux
+		repo     *githubRepo
+		received map[string]interface{}
+	)
+
+	writeJSON := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+
+	BeforeEach(func() {
+		received = map[string]interface{}{}
+		mux = http.NewServeMux()
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				writeJSON(w, http.StatusUnauthorized, `{"message":"Bad credentials"}`)
+				return
+			}
+			mux.ServeHTTP(w, r)
+		}))
+		repo = &githubRepo{url: server.URL, httpClient: server.Client()}
+
+		mux.HandleFunc("/api/v3/orgs/nautes", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":12,"login":"nautes","name":"Nautes","html_url":"https://github.example.com/nautes"}`)
+		})
+		mux.HandleFunc("/api/v3/organizations/12", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":12,"login":"nautes","name":"Nautes"}`)
+		})
+		mux.HandleFunc("/api/v3/repos/nautes/api-server", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":34,"name":"api-server","full_name":"nautes/api-server","private":true,"owner":{"login":"nautes"},"clone_url":"https://github.example.com/nautes/api-server.git"}`)
+		})
+		mux.HandleFunc("/api/v3/repositories/34", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":34,"name":"api-server","full_name":"nautes/api-server","private":true,"owner":{"login":"nautes"}}`)
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("uses the noreply address when the email of the user is private", func() {
+		mux.HandleFunc("/api/v3/user", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":7,"login":"developer"}`)
+		})
+
+		user, email, err := repo.GetCurrentUser(ctx)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(user).Should(Equal("developer"))
+		Expect(email).Should(Equal("7+developer@users.noreply.github.com"))
+	})
+
+	It("maps the product to an organization", func() {
+		group, err := repo.GetGroup(ctx, "nautes")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(group).Should(Equal(&biz.Group{
+			Id:         12,
+			Name:       "Nautes",
+			Visibility: "private",
+			Path:       "nautes",
+			WebUrl:     "https://github.example.com/nautes",
+		}))
+	})
+
+	It("returns group not found when the organization does not exist", func() {
+		_, err := repo.GetGroup(ctx, "missing")
+		Expect(commonv1.IsGroupNotFound(err)).To(BeTrue())
+	})
+
+	It("gets the repository by full name and by id", func() {
+		project, err := repo.GetCodeRepo(ctx, "nautes/api-server")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(project.Id).Should(Equal(int64(34)))
+		Expect(project.Visibility).Should(Equal("private"))
+		Expect(project.PathWithNamespace).Should(Equal("nautes/api-server"))
+		Expect(project.HttpUrlToRepo).Should(Equal("https://github.example.com/nautes/api-server.git"))
+
+		project, err = repo.GetCodeRepo(ctx, 34)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(project.Path).Should(Equal("api-server"))
+	})
+
+	It("reports whether the user maintains the repository", func() {
+		project, err := repo.GetCodeRepo(ctx, "nautes/api-server")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(project.Maintainer).Should(BeFalse())
+
+		mux.HandleFunc("/api/v3/repos/nautes/managed", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":35,"name":"managed","full_name":"nautes/managed","owner":{"login":"nautes"},"permissions":{"admin":false,"maintain":true,"push":true,"pull":true}}`)
+		})
+		project, err = repo.GetCodeRepo(ctx, "nautes/managed")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(project.Maintainer).Should(BeTrue())
+	})
+
+	It("keeps repository ids that do not fit in 32 bits", func() {
+		mux.HandleFunc("/api/v3/repos/nautes/large", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":3000000001,"name":"large","full_name":"nautes/large","owner":{"login":"nautes"}}`)
+		})
+
+		project, err := repo.GetCodeRepo(ctx, "nautes/large")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(project.Id).Should(Equal(int64(3000000001)))
+	})
+
+	It("lists the repositories of the organization with only a page", func() {
+		mux.HandleFunc("/api/v3/orgs/nautes/repos", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("page")).Should(Equal("2"))
+			writeJSON(w, http.StatusOK, `[{"id":34,"name":"api-server","full_name":"nautes/api-server","owner":{"login":"nautes"}}]`)
+		})
+
+		projects, err := repo.ListGroupCodeRepos(ctx, "nautes", 2)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(projects).Should(HaveLen(1))
+		Expect(projects[0].Name).Should(Equal("api-server"))
+	})
+
+	It("refuses to create an organization on github.com", func() {
+		repo.url = "https://github.com"
+		_, err := repo.CreateGroup(ctx, &biz.GitGroupOptions{Github: &biz.GroupOptions{Name: "Nautes", Path: "nautes"}})
+		Expect(biz.ErrorGroupNotSupported.Is(err)).To(BeTrue())
+	})
+
+	It("returns project not found when the repository does not exist", func() {
+		_, err := repo.GetCodeRepo(ctx, "nautes/missing")
+		Expect(commonv1.IsProjectNotFound(err)).To(BeTrue())
+	})
+
+	It("grants the product team access to a new repository", func() {
+		mux.HandleFunc("/api/v3/orgs/nautes/teams/nautes", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":56,"slug":"nautes"}`)
+		})
+		mux.HandleFunc("/api/v3/orgs/nautes/repos", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).Should(Equal(http.MethodPost))
+			body, _ := ioutil.ReadAll(r.Body)
+			Expect(json.Unmarshal(body, &received)).Should(Succeed())
+			writeJSON(w, http.StatusCreated, `{"id":78,"name":"pipeline","full_name":"nautes/pipeline","owner":{"login":"nautes"}}`)
+		})
+
+		project, err := repo.CreateCodeRepo(ctx, 12, &biz.GitCodeRepoOptions{
+			Github: &biz.GithubCodeRepoOptions{Name: "pipeline", Visibility: "private"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(project.Id).Should(Equal(int64(78)))
+		Expect(received).Should(HaveKeyWithValue("name", "pipeline"))
+		Expect(received).Should(HaveKeyWithValue("private", true))
+		Expect(received).Should(HaveKeyWithValue("team_id", BeNumerically("==", 56)))
+	})
+
+	It("adds a read only deploy key", func() {
+		mux.HandleFunc("/api/v3/repos/nautes/api-server/keys", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			Expect(json.Unmarshal(body, &received)).Should(Succeed())
+			writeJSON(w, http.StatusCreated, `{"id":90,"title":"repo-34","key":"ssh-ed25519 AAAA","read_only":true}`)
+		})
+
+		key, err := repo.SaveDeployKey(ctx, []byte("ssh-ed25519 AAAA\n"), &biz.Project{Id: 34})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(key).Should(Equal(&biz.ProjectDeployKey{ID: 90, Title: "repo-34", Key: "ssh-ed25519 AAAA"}))
+		Expect(received).Should(HaveKeyWithValue("key", "ssh-ed25519 AAAA"))
+		Expect(received).Should(HaveKeyWithValue("read_only", true))
+	})
+
+	It("returns deploy key not found when the key does not exist", func() {
+		_, err := repo.GetDeployKey(ctx, 34, 91)
+		Expect(commonv1.IsDeploykeyNotFound(err)).To(BeTrue())
+	})
+
+	It("fails without a token", func() {
+		_, err := repo.GetCodeRepo(context.Background(), 34)
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -77,7 +77,7 @@ func (g *gitlabRepo) CreateCodeRepo(ctx context.Context, gid int, options *biz.G
 	}
 
 	return &biz.Project{
-		Id:                int32(project.ID),
+		Id:                int64(project.ID),
 		Name:              project.Name,
 		Path:              project.Path,
 		WebUrl:            project.WebURL,
@@ -123,7 +123,7 @@ func (g *gitlabRepo) UpdateCodeRepo(ctx context.Context, pid interface{}, option
 	}
 
 	return &biz.Project{
-		Id:                int32(project.ID),
+		Id:                int64(project.ID),
 		Name:              project.Name,
 		Path:              project.Path,
 		Visibility:        string(project.Visibility),
@@ -155,7 +155,7 @@ func (g *gitlabRepo) GetCodeRepo(ctx context.Context, pid interface{}) (*biz.Pro
 	}
 
 	return &biz.Project{
-		Id:                int32(project.ID),
+		Id:                int64(project.ID),
 		Name:              project.Name,
 		Visibility:        string(project.Visibility),
 		Description:       project.Description,
@@ -164,9 +164,23 @@ func (g *gitlabRepo) GetCodeRepo(ctx context.Context, pid interface{}) (*biz.Pro
 		SshUrlToRepo:      project.SSHURLToRepo,
 		HttpUrlToRepo:     project.HTTPURLToRepo,
 		PathWithNamespace: project.PathWithNamespace,
+		Maintainer:        isGitlabMaintainer(project.Permissions),
 	}, nil
 }
 
+// isGitlabMaintainer reports whether the permissions grant the maintainer role on the project, directly or through its group.
+func isGitlabMaintainer(permissions *gitlab.Permissions) bool {
+	if permissions == nil {
+		return false
+	}
+
+	if permissions.ProjectAccess != nil && permissions.ProjectAccess.AccessLevel >= gitlab.MaintainerPermissions {
+		return true
+	}
+
+	return permissions.GroupAccess != nil && permissions.GroupAccess.AccessLevel >= gitlab.MaintainerPermissions
+}
+
 func (g *gitlabRepo) CreateGroup(ctx context.Context, gitOptions *biz.GitGroupOptions) (*biz.Group, error) {
 	client, err := NewGitlabClient(ctx, g)
 	if err != nil {
@@ -183,13 +197,13 @@ func (g *gitlabRepo) CreateGroup(ctx context.Context, gitOptions *biz.GitGroupOp
 	}
 
 	return &biz.Group{
-		Id:          int32(group.ID),
+		Id:          int64(group.ID),
 		Name:        group.Name,
 		Visibility:  string(group.Visibility),
 		Description: group.Description,
 		Path:        group.Path,
 		WebUrl:      group.WebURL,
-		ParentId:    int32(group.ParentID),
+		ParentId:    int64(group.ParentID),
 	}, nil
 }
 
@@ -231,13 +245,13 @@ func (g *gitlabRepo) UpdateGroup(ctx context.Context, gid interface{}, git *biz.
 	}
 
 	return &biz.Group{
-		Id:          int32(group.ID),
+		Id:          int64(group.ID),
 		Name:        group.Name,
 		Visibility:  string(group.Visibility),
 		Description: group.Description,
 		Path:        group.Path,
 		WebUrl:      group.WebURL,
-		ParentId:    int32(group.ParentID),
+		ParentId:    int64(group.ParentID),
 	}, nil
 }
 
@@ -261,13 +275,13 @@ func (g *gitlabRepo) GetGroup(ctx context.Context, gid interface{}) (*biz.Group,
 	}
 
 	return &biz.Group{
-		Id:          int32(group.ID),
+		Id:          int64(group.ID),
 		Name:        group.Name,
 		Visibility:  string(group.Visibility),
 		Description: group.Description,
 		Path:        group.Path,
 		WebUrl:      group.WebURL,
-		ParentId:    int32(group.ParentID),
+		ParentId:    int64(group.ParentID),
 	}, nil
 }
 
@@ -305,7 +319,7 @@ func (g *gitlabRepo) ListGroupCodeRepos(ctx context.Context, gid interface{}, op
 
 	for _, project := range projects {
 		result = append(result, &biz.Project{
-			Id:                int32(project.ID),
+			Id:                int64(project.ID),
 			Name:              project.Name,
 			Visibility:        string(project.Visibility),
 			Description:       project.Description,
@@ -334,13 +348,13 @@ func (g *gitlabRepo) ListAllGroups(ctx context.Context) ([]*biz.Group, error) {
 
 	for _, group := range groups {
 		Groups = append(Groups, &biz.Group{
-			Id:          int32(group.ID),
+			Id:          int64(group.ID),
 			Name:        group.Name,
 			Visibility:  string(group.Visibility),
 			Description: group.Description,
 			Path:        group.Path,
 			WebUrl:      group.WebURL,
-			ParentId:    int32(group.ParentID),
+			ParentId:    int64(group.ParentID),
 		})
 	}
 
@@ -433,6 +447,143 @@ func (g *gitlabRepo) SaveDeployKey(ctx context.Context, publicKey []byte, projec
 	}, nil
 }
 
+func (g *gitlabRepo) CreateMergeRequest(ctx context.Context, pid interface{}, options *biz.MergeRequestOptions) (*biz.MergeRequest, error) {
+	client, err := NewGitlabClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &gitlab.CreateMergeRequestOptions{
+		Title:              gitlab.String(options.Title),
+		Description:        gitlab.String(options.Description),
+		SourceBranch:       gitlab.String(options.SourceBranch),
+		TargetBranch:       gitlab.String(options.TargetBranch),
+		RemoveSourceBranch: gitlab.Bool(true),
+	}
+	mergeRequest, res, err := client.CreateMergeRequest(pid, opts)
+	if err != nil {
+		return nil, convertMergeRequestError(res, err)
+	}
+
+	return toMergeRequest(mergeRequest), nil
+}
+
+func (g *gitlabRepo) GetMergeRequest(ctx context.Context, pid interface{}, mergeRequestID int) (*biz.MergeRequest, error) {
+	client, err := NewGitlabClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	mergeRequest, res, err := client.GetMergeRequest(pid, mergeRequestID, &gitlab.GetMergeRequestsOptions{})
+	if err != nil {
+		return nil, convertMergeRequestError(res, err)
+	}
+
+	return toMergeRequest(mergeRequest), nil
+}
+
+// ListMergeRequests lists all merge requests of the project in the state, an empty state means all states.
+func (g *gitlabRepo) ListMergeRequests(ctx context.Context, pid interface{}, state string) ([]*biz.MergeRequest, error) {
+	client, err := NewGitlabClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &gitlab.ListProjectMergeRequestsOptions{
+		ListOptions: gitlab.ListOptions{
+			Page:    1,
+			PerPage: 100,
+		},
+	}
+	if state != "" {
+		opts.State = gitlab.String(state)
+	}
+
+	var result []*biz.MergeRequest
+	for {
+		mergeRequests, res, err := client.ListProjectMergeRequests(pid, opts)
+		if err != nil {
+			return nil, convertMergeRequestError(res, err)
+		}
+
+		for _, mergeRequest := range mergeRequests {
+			result = append(result, toMergeRequest(mergeRequest))
+		}
+
+		if res == nil || res.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = res.NextPage
+	}
+}
+
+func (g *gitlabRepo) ApproveMergeRequest(ctx context.Context, pid interface{}, mergeRequestID int) error {
+	client, err := NewGitlabClient(ctx, g)
+	if err != nil {
+		return err
+	}
+
+	_, res, err := client.ApproveMergeRequest(pid, mergeRequestID, &gitlab.ApproveMergeRequestOptions{})
+	if err != nil {
+		return convertMergeRequestError(res, err)
+	}
+
+	return nil
+}
+
+func (g *gitlabRepo) AcceptMergeRequest(ctx context.Context, pid interface{}, mergeRequestID int) (*biz.MergeRequest, error) {
+	client, err := NewGitlabClient(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &gitlab.AcceptMergeRequestOptions{
+		ShouldRemoveSourceBranch: gitlab.Bool(true),
+	}
+	mergeRequest, res, err := client.AcceptMergeRequest(pid, mergeRequestID, opts)
+	if err != nil {
+		return nil, convertMergeRequestError(res, err)
+	}
+
+	return toMergeRequest(mergeRequest), nil
+}
+
+func convertMergeRequestError(res *gitlab.Response, err error) error {
+	if res == nil {
+		return err
+	}
+
+	switch res.StatusCode {
+	case 401, 403:
+		return commonv1.ErrorNoAuthorization("no permission to operate merge request, err: %s", err)
+	case 404:
+		return biz.ErrorProposalNotFound.WithCause(err)
+	case 405, 406, 409, 422:
+		return biz.ErrorProposalNotMerged.WithCause(err)
+	}
+
+	return err
+}
+
+func toMergeRequest(mergeRequest *gitlab.MergeRequest) *biz.MergeRequest {
+	result := &biz.MergeRequest{
+		ID:           mergeRequest.IID,
+		Title:        mergeRequest.Title,
+		Description:  mergeRequest.Description,
+		State:        mergeRequest.State,
+		MergeStatus:  mergeRequest.MergeStatus,
+		SourceBranch: mergeRequest.SourceBranch,
+		TargetBranch: mergeRequest.TargetBranch,
+		WebUrl:       mergeRequest.WebURL,
+		CreatedAt:    mergeRequest.CreatedAt,
+	}
+	if mergeRequest.Author != nil {
+		result.Author = mergeRequest.Author.Username
+	}
+
+	return result
+}
+
 func NewGitlabClient(ctx context.Context, g *gitlabRepo) (gitlabclient.GitlabOperator, error) {
 	token := ctx.Value("token")
 	if token == nil {
@@ -0,0 +1,302 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/internal/conf"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	_DefaultLeaseDuration = 60 * time.Second
+	_LeaseRetryInterval   = time.Second
+	_LeaseNamePrefix      = "api-server-repo-"
+)
+
+// repositoryLock serializes changes to the same repository.
+// Requests in this process queue on a mutex, and when a lease namespace is configured
+// the holder also takes a Kubernetes Lease so that other replicas queue as well.
+type repositoryLock struct {
+	mu            sync.Mutex
+	locks         map[string]*lockEntry
+	client        client.Client
+	namespace     string
+	leaseDuration time.Duration
+	identity      string
+}
+
+// lockEntry is the mutex of a repository, it is dropped once no request holds or waits for it.
+type lockEntry struct {
+	mu   sync.Mutex
+	refs int
+	// lost is set when the holder fails to renew its lease, guarded by repositoryLock.mu.
+	lost error
+}
+
+func newRepositoryLock(c *conf.Data_RepositoryLock, k8sClient client.Client) (*repositoryLock, error) {
+	lock := &repositoryLock{
+		locks:         make(map[string]*lockEntry),
+		client:        k8sClient,
+		namespace:     c.GetLeaseNamespace(),
+		leaseDuration: c.GetLeaseDuration().AsDuration(),
+	}
+	if lock.leaseDuration <= 0 {
+		lock.leaseDuration = _DefaultLeaseDuration
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	suffix := make([]byte, 4)
+	_, err = rand.Read(suffix)
+	if err != nil {
+		return nil, err
+	}
+	lock.identity = fmt.Sprintf("%s-%s", hostname, hex.EncodeToString(suffix))
+
+	return lock, nil
+}
+
+// Lock blocks until the repository is free or ctx is done, the returned function releases it.
+func (l *repositoryLock) Lock(ctx context.Context, url string) (func(), error) {
+	entry := l.acquire(url)
+
+	locked := make(chan struct{})
+	go func() {
+		entry.mu.Lock()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+	case <-ctx.Done():
+		// The mutex is released as soon as the pending goroutine gets it.
+		go func() {
+			<-locked
+			l.unlock(url, entry)
+		}()
+		return nil, fmt.Errorf("timed out waiting for repository %s, err: %w", url, ctx.Err())
+	}
+
+	if l.namespace == "" || l.client == nil {
+		return func() { l.unlock(url, entry) }, nil
+	}
+
+	release, err := l.acquireLease(ctx, leaseName(url), func(err error) { l.setLost(entry, err) })
+	if err != nil {
+		l.unlock(url, entry)
+		return nil, err
+	}
+
+	return func() {
+		release()
+		l.unlock(url, entry)
+	}, nil
+}
+
+// Check returns an error if the holder of the repository lost its lease, its changes must not be pushed then.
+func (l *repositoryLock) Check(url string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.locks[url]
+	if !ok || entry.lost == nil {
+		return nil
+	}
+
+	return biz.ErrorRepositoryLockLost.WithCause(entry.lost)
+}
+
+// acquire returns the mutex of the repository, the caller must call unlock once it is done with it.
+func (l *repositoryLock) acquire(url string) *lockEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.locks[url]
+	if !ok {
+		entry = &lockEntry{}
+		l.locks[url] = entry
+	}
+	entry.refs++
+
+	return entry
+}
+
+func (l *repositoryLock) unlock(url string, entry *lockEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry.lost = nil
+	entry.refs--
+	if entry.refs == 0 {
+		delete(l.locks, url)
+	}
+	entry.mu.Unlock()
+}
+
+func (l *repositoryLock) setLost(entry *lockEntry, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry.lost = err
+}
+
+func leaseName(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return fmt.Sprintf("%s%s", _LeaseNamePrefix, hex.EncodeToString(sum[:])[:16])
+}
+
+// acquireLease takes the lease and keeps renewing it until the returned function is called.
+// lost is called when the lease cannot be renewed, because another holder took it or it expired before it was renewed.
+func (l *repositoryLock) acquireLease(ctx context.Context, name string, lost func(error)) (func(), error) {
+	for {
+		ok, err := l.tryAcquireLease(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			break
+		}
+
+		select {
+		case <-time.After(_LeaseRetryInterval):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for lease %s, err: %w", name, ctx.Err())
+		}
+	}
+
+	// Keep the lease while the holder is still pushing, a crashed holder simply lets it expire.
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(l.leaseDuration / 3)
+		defer ticker.Stop()
+		renewed := time.Now()
+		for {
+			select {
+			case <-ticker.C:
+				ok, err := l.tryAcquireLease(context.Background(), name)
+				if ok {
+					renewed = time.Now()
+					continue
+				}
+				if err == nil {
+					lost(fmt.Errorf("the lease %s is held by another replica", name))
+					return
+				}
+				// A failed renewal is retried until the lease would have expired.
+				if time.Since(renewed) >= l.leaseDuration {
+					lost(fmt.Errorf("failed to renew the lease %s, err: %w", name, err))
+					return
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+		l.releaseLease(name)
+	}, nil
+}
+
+// tryAcquireLease takes or renews the lease, it returns false when another holder owns an unexpired lease.
+func (l *repositoryLock) tryAcquireLease(ctx context.Context, name string) (bool, error) {
+	now := metav1.NewMicroTime(time.Now())
+	seconds := int32(l.leaseDuration.Seconds())
+
+	lease := &coordinationv1.Lease{}
+	err := l.client.Get(ctx, client.ObjectKey{Namespace: l.namespace, Name: name}, lease)
+	if apierrors.IsNotFound(err) {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: l.namespace,
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &l.identity,
+				LeaseDurationSeconds: &seconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		err = l.client.Create(ctx, lease)
+		if apierrors.IsAlreadyExists(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	held := lease.Spec.HolderIdentity != nil && *lease.Spec.HolderIdentity != "" && *lease.Spec.HolderIdentity != l.identity
+	if held && !leaseExpired(lease) {
+		return false, nil
+	}
+
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != l.identity {
+		lease.Spec.AcquireTime = &now
+	}
+	lease.Spec.HolderIdentity = &l.identity
+	lease.Spec.LeaseDurationSeconds = &seconds
+	lease.Spec.RenewTime = &now
+	err = l.client.Update(ctx, lease)
+	if apierrors.IsConflict(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (l *repositoryLock) releaseLease(name string) {
+	lease := &coordinationv1.Lease{}
+	err := l.client.Get(context.Background(), client.ObjectKey{Namespace: l.namespace, Name: name}, lease)
+	if err != nil {
+		return
+	}
+
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != l.identity {
+		return
+	}
+
+	_ = l.client.Delete(context.Background(), lease, client.Preconditions{ResourceVersion: &lease.ResourceVersion})
+}
+
+func leaseExpired(lease *coordinationv1.Lease) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+
+	expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	return time.Now().After(expiry)
+}
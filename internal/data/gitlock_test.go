@@ -0,0 +1,200 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/internal/conf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const _TestLeaseNamespace = "nautes"
+
+var _ = Describe("Repository lock", func() {
+	var (
+		url       = "https://gitlab.com/nautes/product.git"
+		k8sClient client.Client
+	)
+
+	newLock := func(k8sClient client.Client, leaseDuration time.Duration) *repositoryLock {
+		c := &conf.Data_RepositoryLock{LeaseDuration: durationpb.New(leaseDuration)}
+		if k8sClient != nil {
+			c.LeaseNamespace = _TestLeaseNamespace
+		}
+		lock, err := newRepositoryLock(c, k8sClient)
+		Expect(err).ShouldNot(HaveOccurred())
+		return lock
+	}
+
+	getLease := func(url string) *coordinationv1.Lease {
+		lease := &coordinationv1.Lease{}
+		err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: _TestLeaseNamespace, Name: leaseName(url)}, lease)
+		Expect(err).ShouldNot(HaveOccurred())
+		return lease
+	}
+
+	// takeLease hands the lease to another replica, as if it had expired and been taken over.
+	takeLease := func(url string) {
+		lease := getLease(url)
+		holder := "other-replica"
+		seconds := int32(60)
+		now := metav1.NewMicroTime(time.Now())
+		lease.Spec.HolderIdentity = &holder
+		lease.Spec.LeaseDurationSeconds = &seconds
+		lease.Spec.RenewTime = &now
+		Expect(k8sClient.Update(context.Background(), lease)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		k8sClient = fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build()
+	})
+
+	Context("in a single process", func() {
+		It("queues the requests to the same repository", func() {
+			lock := newLock(nil, time.Minute)
+			unlock, err := lock.Lock(ctx, url)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			locked := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				unlock, err := lock.Lock(ctx, url)
+				Expect(err).ShouldNot(HaveOccurred())
+				close(locked)
+				unlock()
+			}()
+
+			Consistently(locked, 100*time.Millisecond).ShouldNot(BeClosed())
+			unlock()
+			Eventually(locked).Should(BeClosed())
+			Eventually(func() int {
+				lock.mu.Lock()
+				defer lock.mu.Unlock()
+				return len(lock.locks)
+			}).Should(BeZero())
+		})
+
+		It("does not queue the requests to different repositories", func() {
+			lock := newLock(nil, time.Minute)
+			unlock, err := lock.Lock(ctx, url)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer unlock()
+
+			other, err := lock.Lock(ctx, "https://gitlab.com/nautes/other.git")
+			Expect(err).ShouldNot(HaveOccurred())
+			other()
+			Expect(lock.locks).To(HaveLen(1))
+		})
+
+		It("stops waiting when the request is done", func() {
+			lock := newLock(nil, time.Minute)
+			unlock, err := lock.Lock(ctx, url)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+			_, err = lock.Lock(timeout, url)
+			Expect(err).Should(HaveOccurred())
+
+			unlock()
+			Eventually(func() int {
+				lock.mu.Lock()
+				defer lock.mu.Unlock()
+				return len(lock.locks)
+			}).Should(BeZero())
+		})
+	})
+
+	Context("across replicas", func() {
+		It("waits for the lease held by another replica", func() {
+			first := newLock(k8sClient, time.Minute)
+			second := newLock(k8sClient, time.Minute)
+
+			unlock, err := first.Lock(ctx, url)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(*getLease(url).Spec.HolderIdentity).To(Equal(first.identity))
+
+			timeout, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			_, err = second.Lock(timeout, url)
+			Expect(err).Should(HaveOccurred())
+
+			unlock()
+			unlock, err = second.Lock(ctx, url)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(*getLease(url).Spec.HolderIdentity).To(Equal(second.identity))
+			unlock()
+		})
+
+		It("takes over an expired lease", func() {
+			first := newLock(k8sClient, time.Minute)
+			release, err := first.Lock(ctx, url)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer release()
+
+			lease := getLease(url)
+			expired := metav1.NewMicroTime(time.Now().Add(-2 * time.Minute))
+			lease.Spec.RenewTime = &expired
+			Expect(k8sClient.Update(context.Background(), lease)).To(Succeed())
+
+			second := newLock(k8sClient, time.Minute)
+			unlock, err := second.Lock(ctx, url)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer unlock()
+			Expect(*getLease(url).Spec.HolderIdentity).To(Equal(second.identity))
+		})
+
+		It("fails the push of a holder that lost its lease", func() {
+			dir, err := ioutil.TempDir("", "git-lock-test")
+			Expect(err).ShouldNot(HaveOccurred())
+			defer os.RemoveAll(dir)
+			remote := newBareRepository(dir)
+
+			lock := newLock(k8sClient, 300*time.Millisecond)
+			repo := &gitRepo{lock: lock}
+			path, err := repo.Clone(ctx, &biz.CloneRepositoryParam{URL: remote, User: _TestUser, Email: _TestEmail})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer os.RemoveAll(filepath.Dir(path))
+
+			unlock, err := repo.Lock(ctx, remote)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer unlock()
+			Expect(lock.Check(remote)).To(Succeed())
+
+			takeLease(remote)
+			Eventually(func() error { return lock.Check(remote) }).Should(HaveOccurred())
+
+			commitFile(path, "env.yaml", "kind: Environment")
+			err = repo.Push(ctx, path)
+			Expect(errors.Is(err, biz.ErrorRepositoryLockLost)).To(BeTrue())
+			_, err = readRemoteFile(remote, "env.yaml")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
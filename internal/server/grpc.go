@@ -15,20 +15,31 @@
 package server
 
 import (
+	applyv1 "github.com/nautes-labs/api-server/api/apply/v1"
+	artifactrepov1 "github.com/nautes-labs/api-server/api/artifactrepo/v1"
+	clusterv1 "github.com/nautes-labs/api-server/api/cluster/v1"
+	coderepov1 "github.com/nautes-labs/api-server/api/coderepo/v1"
+	deploymentruntimev1 "github.com/nautes-labs/api-server/api/deploymentruntime/v1"
+	environmentv1 "github.com/nautes-labs/api-server/api/environment/v1"
 	productv1 "github.com/nautes-labs/api-server/api/product/v1"
+	projectv1 "github.com/nautes-labs/api-server/api/project/v1"
+	projectpipelineruntimev1 "github.com/nautes-labs/api-server/api/projectpipelineruntime/v1"
+	proposalv1 "github.com/nautes-labs/api-server/api/proposal/v1"
 	"github.com/nautes-labs/api-server/internal/conf"
-	"github.com/nautes-labs/api-server/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, product *service.ProductService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, serviceProductGroup *ServiceProductGroup, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			TokenWithContext(),
+			validate.Validator(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -41,6 +52,19 @@ func NewGRPCServer(c *conf.Server, product *service.ProductService, logger log.L
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
 	srv := grpc.NewServer(opts...)
-	productv1.RegisterProductServer(srv, product)
+	serviceProductGroup.RegisterGRPC(srv)
 	return srv
 }
+
+func (s *ServiceProductGroup) RegisterGRPC(srv *grpc.Server) {
+	productv1.RegisterProductServer(srv, s.product)
+	projectv1.RegisterProjectServer(srv, s.project)
+	environmentv1.RegisterEnvironmentServer(srv, s.enviroment)
+	clusterv1.RegisterClusterServer(srv, s.cluster)
+	coderepov1.RegisterCodeRepoServer(srv, s.codeRepo)
+	artifactrepov1.RegisterArtifactRepoServer(srv, s.artifactRepo)
+	proposalv1.RegisterProposalServer(srv, s.proposal)
+	applyv1.RegisterApplyServer(srv, s.apply)
+	deploymentruntimev1.RegisterDeploymentruntimeServer(srv, s.deploymentRuntime)
+	projectpipelineruntimev1.RegisterProjectPipelineRuntimeServer(srv, s.projectPipelineRuntime)
+}
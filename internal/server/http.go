@@ -18,6 +18,8 @@ import (
 	"context"
 	"strings"
 
+	applyv1 "github.com/nautes-labs/api-server/api/apply/v1"
+	artifactrepov1 "github.com/nautes-labs/api-server/api/artifactrepo/v1"
 	clusterv1 "github.com/nautes-labs/api-server/api/cluster/v1"
 	coderepov1 "github.com/nautes-labs/api-server/api/coderepo/v1"
 	deploymentruntimev1 "github.com/nautes-labs/api-server/api/deploymentruntime/v1"
@@ -25,6 +27,7 @@ import (
 	productv1 "github.com/nautes-labs/api-server/api/product/v1"
 	projectv1 "github.com/nautes-labs/api-server/api/project/v1"
 	projectpipelineruntimev1 "github.com/nautes-labs/api-server/api/projectpipelineruntime/v1"
+	proposalv1 "github.com/nautes-labs/api-server/api/proposal/v1"
 	"github.com/nautes-labs/api-server/internal/conf"
 	"github.com/nautes-labs/api-server/internal/service"
 
@@ -35,6 +38,7 @@ import (
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/go-kratos/swagger-api/openapiv2"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 type ServiceProductGroup struct {
@@ -45,9 +49,13 @@ type ServiceProductGroup struct {
 	project                *service.ProjectService
 	enviroment             *service.EnvironmentService
 	cluster                *service.ClusterService
+	artifactRepo           *service.ArtifactRepoService
+	proposal               *service.ProposalService
+	apply                  *service.ApplyService
+	watch                  *service.WatchService
 }
 
-func NewServiceGroup(projectPipelineRuntime *service.ProjectPipelineRuntimeService, deploymentRuntime *service.DeploymentruntimeService, codeRepo *service.CodeRepoService, product *service.ProductService, project *service.ProjectService, enviroment *service.EnvironmentService, cluster *service.ClusterService) *ServiceProductGroup {
+func NewServiceGroup(projectPipelineRuntime *service.ProjectPipelineRuntimeService, deploymentRuntime *service.DeploymentruntimeService, codeRepo *service.CodeRepoService, product *service.ProductService, project *service.ProjectService, enviroment *service.EnvironmentService, cluster *service.ClusterService, artifactRepo *service.ArtifactRepoService, proposal *service.ProposalService, apply *service.ApplyService, watch *service.WatchService) *ServiceProductGroup {
 	return &ServiceProductGroup{
 		projectPipelineRuntime: projectPipelineRuntime,
 		deploymentRuntime:      deploymentRuntime,
@@ -56,17 +64,29 @@ func NewServiceGroup(projectPipelineRuntime *service.ProjectPipelineRuntimeServi
 		project:                project,
 		enviroment:             enviroment,
 		cluster:                cluster,
+		artifactRepo:           artifactRepo,
+		proposal:               proposal,
+		apply:                  apply,
+		watch:                  watch,
 	}
 }
 
 func (s *ServiceProductGroup) Register(srv *http.Server) {
+	// The apply route must be registered before the product routes, otherwise the product name would match "{productName}:apply".
+	applyv1.RegisterApplyHTTPServer(srv, s.apply)
 	productv1.RegisterProductHTTPServer(srv, s.product)
 	projectv1.RegisterProjectHTTPServer(srv, s.project)
 	environmentv1.RegisterEnvironmentHTTPServer(srv, s.enviroment)
 	clusterv1.RegisterClusterHTTPServer(srv, s.cluster)
 	coderepov1.RegisterCodeRepoHTTPServer(srv, s.codeRepo)
+	artifactrepov1.RegisterArtifactRepoHTTPServer(srv, s.artifactRepo)
+	proposalv1.RegisterProposalHTTPServer(srv, s.proposal)
 	deploymentruntimev1.RegisterDeploymentruntimeHTTPServer(srv, s.deploymentRuntime)
 	projectpipelineruntimev1.RegisterProjectPipelineRuntimeHTTPServer(srv, s.projectPipelineRuntime)
+
+	r := srv.Route("/")
+	r.POST("/api/v1/webhooks/gitlab", s.watch.GitlabWebhook)
+	r.GET("/api/v1/products/{productName}/watch", s.watch.Watch)
 }
 
 // NewHTTPServer new a HTTP server.
@@ -91,10 +111,13 @@ func NewHTTPServer(c *conf.Server, serviceProductGroup *ServiceProductGroup) *ht
 	openAPIhandler := openapiv2.NewHandler(openapiv2.WithGeneratorOptions(generator.UseJSONNamesForFields(true), generator.EnumsAsInts(true)))
 	srv := http.NewServer(opts...)
 	srv.HandlePrefix("/q/", openAPIhandler)
+	srv.Handle("/metrics", promhttp.Handler())
 	serviceProductGroup.Register(srv)
 	return srv
 }
 
+// TokenWithContext puts the bearer token of the request into the context.
+// Over gRPC the header is read from the "authorization" metadata.
 func TokenWithContext() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
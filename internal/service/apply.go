@@ -0,0 +1,422 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	applyv1 "github.com/nautes-labs/api-server/api/apply/v1"
+	artifactrepov1 "github.com/nautes-labs/api-server/api/artifactrepo/v1"
+	coderepov1 "github.com/nautes-labs/api-server/api/coderepo/v1"
+	deploymentruntimev1 "github.com/nautes-labs/api-server/api/deploymentruntime/v1"
+	environmentv1 "github.com/nautes-labs/api-server/api/environment/v1"
+	projectv1 "github.com/nautes-labs/api-server/api/project/v1"
+	projectpipelineruntimev1 "github.com/nautes-labs/api-server/api/projectpipelineruntime/v1"
+	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	_BundleFormatYaml    = "yaml"
+	_BundleFormatArchive = "tar.gz"
+	// _MaxBundleSize is the largest size of the files read from an archive, after decompression.
+	_MaxBundleSize = 32 << 20
+	// _MaxBundleEntries is the largest number of entries read from an archive.
+	_MaxBundleEntries = 4096
+)
+
+type ApplyService struct {
+	applyv1.UnimplementedApplyServer
+	apply   *biz.ApplyUsecase
+	configs *nautesconfigs.Config
+}
+
+func NewApplyService(apply *biz.ApplyUsecase, configs *nautesconfigs.Config) *ApplyService {
+	return &ApplyService{apply: apply, configs: configs}
+}
+
+func (s *ApplyService) ApplyProduct(ctx context.Context, req *applyv1.ApplyRequest) (*applyv1.ApplyReply, error) {
+	resources := make([]*biz.ApplyResource, 0, len(req.Resources))
+	for _, resource := range req.Resources {
+		applyResource, err := s.convertResource(req.ProductName, resource)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, applyResource)
+	}
+
+	options := &biz.BizOptions{
+		ProductName:       req.ProductName,
+		InsecureSkipCheck: req.InsecureSkipCheck,
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
+	}
+	result, err := s.apply.Apply(ctx, options, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	return &applyv1.ApplyReply{
+		Msg:         changeMessage(result, "applied", fmt.Sprintf("product %s", req.ProductName)),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
+		Warnings:    result.Warnings,
+	}, nil
+}
+
+func (s *ApplyService) ExportProduct(ctx context.Context, req *applyv1.ExportRequest) (*applyv1.ExportReply, error) {
+	productResources, err := s.apply.ExportProduct(ctx, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := s.exportResources(productResources)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Format == _BundleFormatArchive {
+		archive, err := archiveBundle(resources)
+		if err != nil {
+			return nil, err
+		}
+
+		return &applyv1.ExportReply{Format: _BundleFormatArchive, Archive: archive}, nil
+	}
+
+	documents, err := yamlBundle(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	return &applyv1.ExportReply{Format: _BundleFormatYaml, Yaml: strings.Join(documents, "---\n")}, nil
+}
+
+func (s *ApplyService) ImportProduct(ctx context.Context, req *applyv1.ImportRequest) (*applyv1.ApplyReply, error) {
+	var documents [][]byte
+	var err error
+	if len(req.Archive) > 0 {
+		documents, err = readArchiveBundle(req.Archive)
+	} else {
+		documents, err = readYamlBundle([]byte(req.Yaml))
+	}
+	if err != nil {
+		return nil, biz.ErrorInvalidApply.WithCause(fmt.Errorf("failed to read the bundle, err: %w", err))
+	}
+
+	resources := make([]*applyv1.Resource, 0, len(documents))
+	for _, document := range documents {
+		resource, err := parseResource(document)
+		if err != nil {
+			return nil, biz.ErrorInvalidApply.WithCause(err)
+		}
+		resources = append(resources, resource)
+	}
+
+	return s.ApplyProduct(ctx, &applyv1.ApplyRequest{
+		ProductName:       req.ProductName,
+		Resources:         resources,
+		InsecureSkipCheck: req.InsecureSkipCheck,
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
+	})
+}
+
+// exportResources converts the resources of the product to the resources accepted by apply.
+func (s *ApplyService) exportResources(productResources *biz.ProductResources) ([]*applyv1.Resource, error) {
+	var resources []*applyv1.Resource
+	add := func(kind, name string, body proto.Message) error {
+		resource, err := newResource(kind, name, body)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, resource)
+		return nil
+	}
+
+	for _, project := range productResources.Projects {
+		if err := add(nodestree.Project, project.Name, projectBody(project)); err != nil {
+			return nil, err
+		}
+	}
+	for _, codeRepo := range productResources.CodeRepos {
+		if err := add(nodestree.CodeRepo, codeRepo.Project.Path, codeRepoBody(s.configs.Git.GitType, codeRepo.CodeRepo, codeRepo.Project)); err != nil {
+			return nil, err
+		}
+	}
+	for _, artifactRepo := range productResources.ArtifactRepos {
+		if err := add(nodestree.ArtifactRepo, artifactRepo.Name, artifactRepoBody(artifactRepo)); err != nil {
+			return nil, err
+		}
+	}
+	for _, env := range productResources.Environments {
+		if err := add(nodestree.Enviroment, env.Name, environmentBody(env)); err != nil {
+			return nil, err
+		}
+	}
+	for _, runtime := range productResources.DeploymentRuntimes {
+		if err := add(nodestree.DeploymentRuntime, runtime.Name, deploymentRuntimeBody(runtime)); err != nil {
+			return nil, err
+		}
+	}
+	for _, runtime := range productResources.ProjectPipelineRuntimes {
+		if err := add(nodestree.ProjectPipelineRuntime, runtime.Name, projectPipelineRuntimeBody(runtime)); err != nil {
+			return nil, err
+		}
+	}
+
+	return resources, nil
+}
+
+// convertResource converts the spec of the resource to the data of its kind, the spec is checked like the body of the save request of the kind.
+func (s *ApplyService) convertResource(productName string, resource *applyv1.Resource) (*biz.ApplyResource, error) {
+	applyResource := &biz.ApplyResource{
+		Kind: resource.Kind,
+		Name: resource.Name,
+	}
+
+	var err error
+	switch resource.Kind {
+	case nodestree.Project:
+		body := &projectv1.SaveRequest_Body{}
+		err = unmarshalSpec(resource, body, body.Validate)
+		applyResource.Data = projectData(resource.Name, body)
+	case nodestree.CodeRepo:
+		body := &coderepov1.SaveRequest_Body{}
+		err = unmarshalSpec(resource, body, body.Validate)
+		if err == nil {
+			applyResource.GitOptions, err = codeRepoGitOptions(s.configs.Git.GitType, resource.Name, body.Git)
+		}
+		applyResource.Data = codeRepoData(resource.Name, body)
+	case nodestree.Enviroment:
+		body := &environmentv1.SaveRequest_Body{}
+		err = unmarshalSpec(resource, body, body.Validate)
+		applyResource.Data = environmentData(resource.Name, body)
+	case nodestree.DeploymentRuntime:
+		body := &deploymentruntimev1.SaveRequest_Body{}
+		err = unmarshalSpec(resource, body, body.Validate)
+		applyResource.Data = deploymentRuntimeData(productName, resource.Name, body)
+	case nodestree.ProjectPipelineRuntime:
+		body := &projectpipelineruntimev1.SaveRequest_Body{}
+		err = unmarshalSpec(resource, body, body.Validate)
+		applyResource.Data = projectPipelineRuntimeData(resource.Name, body)
+	case nodestree.ArtifactRepo:
+		body := &artifactrepov1.SaveRequest_Body{}
+		err = unmarshalSpec(resource, body, body.Validate)
+		applyResource.Data = artifactRepoData(resource.Name, body)
+	default:
+		err = fmt.Errorf("the kind %s is not supported", resource.Kind)
+	}
+	if err != nil {
+		return nil, biz.ErrorInvalidApply.WithCause(fmt.Errorf("invalid %s %s: %w", resource.Kind, resource.Name, err))
+	}
+
+	return applyResource, nil
+}
+
+func unmarshalSpec(resource *applyv1.Resource, body proto.Message, validate func() error) error {
+	spec, err := protojson.Marshal(resource.Spec)
+	if err != nil {
+		return err
+	}
+
+	err = protojson.Unmarshal(spec, body)
+	if err != nil {
+		return err
+	}
+
+	return validate()
+}
+
+func newResource(kind, name string, body proto.Message) (*applyv1.Resource, error) {
+	bytes, err := protojson.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &structpb.Struct{}
+	err = protojson.Unmarshal(bytes, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &applyv1.Resource{Kind: kind, Name: name, Spec: spec}, nil
+}
+
+func parseResource(document []byte) (*applyv1.Resource, error) {
+	bytes, err := yaml.YAMLToJSON(document)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := &applyv1.Resource{}
+	err = protojson.Unmarshal(bytes, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	err = resource.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+func yamlBundle(resources []*applyv1.Resource) ([]string, error) {
+	documents := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		bytes, err := protojson.Marshal(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		document, err := yaml.JSONToYAML(bytes)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, string(document))
+	}
+
+	return documents, nil
+}
+
+// archiveBundle writes every resource to its own file named after its kind and name.
+func archiveBundle(resources []*applyv1.Resource) ([]byte, error) {
+	documents, err := yamlBundle(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for i, resource := range resources {
+		header := &tar.Header{
+			Name: path.Join(resource.Kind, fmt.Sprintf("%s.yaml", resource.Name)),
+			Mode: 0644,
+			Size: int64(len(documents[i])),
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return nil, err
+		}
+		if _, err := tarWriter.Write([]byte(documents[i])); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func readYamlBundle(bundle []byte) ([][]byte, error) {
+	var documents [][]byte
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(bundle)))
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(bytes.TrimSpace(document)) > 0 {
+			documents = append(documents, document)
+		}
+	}
+
+	return documents, nil
+}
+
+// readArchiveBundle reads the yaml files of the archive, a file may contain several resources.
+// The archive is rejected when it has more than _MaxBundleEntries entries or its files exceed _MaxBundleSize.
+func readArchiveBundle(archive []byte) ([][]byte, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	var documents [][]byte
+	var entries int
+	var size int64
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entries++
+		if entries > _MaxBundleEntries {
+			return nil, fmt.Errorf("the archive has more than %d entries", _MaxBundleEntries)
+		}
+
+		ext := path.Ext(header.Name)
+		if header.Typeflag != tar.TypeReg || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		content, err := io.ReadAll(io.LimitReader(tarReader, _MaxBundleSize-size+1))
+		if err != nil {
+			return nil, err
+		}
+		size += int64(len(content))
+		if size > _MaxBundleSize {
+			return nil, fmt.Errorf("the files of the archive are larger than %d bytes", _MaxBundleSize)
+		}
+
+		fileDocuments, err := readYamlBundle(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s, err: %w", header.Name, err)
+		}
+		documents = append(documents, fileDocuments...)
+	}
+
+	return documents, nil
+}
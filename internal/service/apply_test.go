@@ -0,0 +1,162 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"strings"
+
+	applyv1 "github.com/nautes-labs/api-server/api/apply/v1"
+	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Product bundle", func() {
+	var (
+		service  *ApplyService
+		exported []*applyv1.Resource
+	)
+
+	BeforeEach(func() {
+		var err error
+		configs := &nautesconfigs.Config{Git: nautesconfigs.GitRepo{GitType: nautesconfigs.GIT_TYPE_GITLAB}}
+		service = NewApplyService(nil, configs)
+		exported, err = service.exportResources(&biz.ProductResources{
+			Projects: []*resourcev1alpha1.Project{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "project1"},
+					Spec:       resourcev1alpha1.ProjectSpec{Language: "go"},
+				},
+			},
+			ArtifactRepos: []*resourcev1alpha1.ArtifactRepo{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "maven-releases"},
+					Spec: resourcev1alpha1.ArtifactRepoSpec{
+						ArtifactRepoProvider: "harbor",
+						RepoType:             "local",
+						PackageType:          "maven",
+					},
+				},
+			},
+			Environments: []*resourcev1alpha1.Environment{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "env1"},
+					Spec:       resourcev1alpha1.EnvironmentSpec{Cluster: "cluster1", EnvType: "host"},
+				},
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(exported).Should(HaveLen(3))
+	})
+
+	// importResources reads the documents of a bundle back into resources and checks they can be applied.
+	importResources := func(documents [][]byte) []*applyv1.Resource {
+		resources := make([]*applyv1.Resource, 0, len(documents))
+		for _, document := range documents {
+			resource, err := parseResource(document)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = service.convertResource("product1", resource)
+			Expect(err).ShouldNot(HaveOccurred())
+			resources = append(resources, resource)
+		}
+
+		return resources
+	}
+
+	expectSameResources := func(resources []*applyv1.Resource) {
+		Expect(resources).Should(HaveLen(len(exported)))
+		for i := range exported {
+			Expect(proto.Equal(resources[i], exported[i])).Should(BeTrue(), "resource %s %s changed", exported[i].Kind, exported[i].Name)
+		}
+	}
+
+	It("imports the resources exported as yaml", func() {
+		documents, err := yamlBundle(exported)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		bundle, err := readYamlBundle([]byte(strings.Join(documents, "---\n")))
+		Expect(err).ShouldNot(HaveOccurred())
+		expectSameResources(importResources(bundle))
+	})
+
+	It("imports the resources exported as an archive", func() {
+		archive, err := archiveBundle(exported)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		bundle, err := readArchiveBundle(archive)
+		Expect(err).ShouldNot(HaveOccurred())
+		expectSameResources(importResources(bundle))
+	})
+
+	It("names the files of the archive after the kind and name of the resources", func() {
+		archive, err := archiveBundle(exported)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+		Expect(err).ShouldNot(HaveOccurred())
+		header, err := tar.NewReader(gzipReader).Next()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(header.Name).Should(Equal(nodestree.Project + "/project1.yaml"))
+	})
+
+	It("rejects a document that is not a resource", func() {
+		_, err := parseResource([]byte("kind: Environment\nname: env1\n"))
+		Expect(err).Should(HaveOccurred())
+	})
+
+	writeArchive := func(files map[string][]byte) []byte {
+		var buf bytes.Buffer
+		gzipWriter := gzip.NewWriter(&buf)
+		tarWriter := tar.NewWriter(gzipWriter)
+		for name, content := range files {
+			Expect(tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))})).Should(Succeed())
+			_, err := tarWriter.Write(content)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		Expect(tarWriter.Close()).Should(Succeed())
+		Expect(gzipWriter.Close()).Should(Succeed())
+		return buf.Bytes()
+	}
+
+	It("rejects an archive with too many entries", func() {
+		files := make(map[string][]byte, _MaxBundleEntries+1)
+		for i := 0; i <= _MaxBundleEntries; i++ {
+			files[fmt.Sprintf("README-%d", i)] = nil
+		}
+
+		_, err := readArchiveBundle(writeArchive(files))
+		Expect(err).Should(MatchError(ContainSubstring("entries")))
+	})
+
+	It("rejects an archive whose files are too large", func() {
+		files := map[string][]byte{
+			"Environment/env1.yaml": bytes.Repeat([]byte("#"), _MaxBundleSize/2+1),
+			"Environment/env2.yaml": bytes.Repeat([]byte("#"), _MaxBundleSize/2+1),
+		}
+
+		_, err := readArchiveBundle(writeArchive(files))
+		Expect(err).Should(MatchError(ContainSubstring("larger than")))
+	})
+})
@@ -0,0 +1,213 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	artifactrepov1 "github.com/nautes-labs/api-server/api/artifactrepo/v1"
+	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
+	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
+)
+
+type ArtifactRepoService struct {
+	artifactrepov1.UnimplementedArtifactRepoServer
+	artifactRepo *biz.ArtifactRepoUsecase
+}
+
+func NewArtifactRepoService(artifactRepo *biz.ArtifactRepoUsecase) *ArtifactRepoService {
+	return &ArtifactRepoService{artifactRepo: artifactRepo}
+}
+
+func (s *ArtifactRepoService) CovertArtifactRepoValueToReply(artifactRepo *resourcev1alpha1.ArtifactRepo) *artifactrepov1.GetReply {
+	return &artifactrepov1.GetReply{
+		Product:              artifactRepo.Spec.Product,
+		Name:                 artifactRepo.Name,
+		ArtifactRepoProvider: artifactRepo.Spec.ArtifactRepoProvider,
+		Projects:             artifactRepo.Spec.Projects,
+		RepoType:             artifactRepo.Spec.RepoType,
+		PackageType:          artifactRepo.Spec.PackageType,
+		ResourceVersion:      artifactRepo.ResourceVersion,
+	}
+}
+
+func (s *ArtifactRepoService) GetArtifactRepo(ctx context.Context, req *artifactrepov1.GetRequest) (*artifactrepov1.GetReply, error) {
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, new([]nodestree.Violation))
+	artifactRepo, err := s.artifactRepo.GetArtifactRepo(ctx, req.ArtifactRepoName, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	setETag(ctx, artifactRepo.ResourceVersion)
+
+	return s.CovertArtifactRepoValueToReply(artifactRepo), nil
+}
+
+func (s *ArtifactRepoService) ListArtifactRepos(ctx context.Context, req *artifactrepov1.ListsRequest) (*artifactrepov1.ListsReply, error) {
+	var violations []nodestree.Violation
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, &violations)
+	artifactRepos, err := s.artifactRepo.ListArtifactRepos(ctx, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*artifactrepov1.GetReply
+	for _, artifactRepo := range artifactRepos {
+		items = append(items, s.CovertArtifactRepoValueToReply(artifactRepo))
+	}
+
+	reply := &artifactrepov1.ListsReply{Items: items}
+	for _, violation := range violations {
+		reply.Violations = append(reply.Violations, &artifactrepov1.Violation{
+			Path:    violation.Path,
+			Rule:    violation.Rule,
+			Message: violation.Message,
+		})
+	}
+
+	return reply, nil
+}
+
+func (s *ArtifactRepoService) SaveArtifactRepo(ctx context.Context, req *artifactrepov1.SaveRequest) (*artifactrepov1.SaveReply, error) {
+	options := &biz.BizOptions{
+		ResouceName:       req.ArtifactRepoName,
+		ProductName:       req.ProductName,
+		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
+	}
+	result, err := s.artifactRepo.SaveArtifactRepo(ctx, options, artifactRepoData(req.ArtifactRepoName, req.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return &artifactrepov1.SaveReply{
+		Msg:         changeMessage(result, "saved", req.ArtifactRepoName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
+	}, nil
+}
+
+func artifactRepoData(name string, body *artifactrepov1.SaveRequest_Body) *biz.ArtifactRepoData {
+	return &biz.ArtifactRepoData{
+		Name: name,
+		Spec: resourcev1alpha1.ArtifactRepoSpec{
+			ArtifactRepoProvider: body.ArtifactRepoProvider,
+			Projects:             body.Projects,
+			RepoName:             name,
+			RepoType:             body.RepoType,
+			PackageType:          body.PackageType,
+		},
+	}
+}
+
+func artifactRepoBody(artifactRepo *resourcev1alpha1.ArtifactRepo) *artifactrepov1.SaveRequest_Body {
+	return &artifactrepov1.SaveRequest_Body{
+		ArtifactRepoProvider: artifactRepo.Spec.ArtifactRepoProvider,
+		Projects:             artifactRepo.Spec.Projects,
+		RepoType:             artifactRepo.Spec.RepoType,
+		PackageType:          artifactRepo.Spec.PackageType,
+	}
+}
+
+func (s *ArtifactRepoService) DeleteArtifactRepo(ctx context.Context, req *artifactrepov1.DeleteRequest) (*artifactrepov1.DeleteReply, error) {
+	options := &biz.BizOptions{
+		ResouceName:       req.ArtifactRepoName,
+		ProductName:       req.ProductName,
+		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
+	}
+	result, err := s.artifactRepo.DeleteArtifactRepo(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &artifactrepov1.DeleteReply{
+		Msg:         changeMessage(result, "deleted", req.ArtifactRepoName),
+		DryRun:      result.DryRun,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
+	}, nil
+}
+
+func (s *ArtifactRepoService) GetArtifactRepoHistory(ctx context.Context, req *artifactrepov1.HistoryRequest) (*artifactrepov1.HistoryReply, error) {
+	commits, err := s.artifactRepo.GetArtifactRepoHistory(ctx, req.ArtifactRepoName, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*artifactrepov1.Commit
+	for _, commit := range commits {
+		items = append(items, &artifactrepov1.Commit{
+			Sha:         commit.SHA,
+			Author:      commit.Author,
+			AuthorEmail: commit.AuthorEmail,
+			Date:        commit.Date.Format(time.RFC3339),
+			Message:     commit.Message,
+		})
+	}
+
+	return &artifactrepov1.HistoryReply{Items: items}, nil
+}
+
+func (s *ArtifactRepoService) RollbackArtifactRepo(ctx context.Context, req *artifactrepov1.RollbackRequest) (*artifactrepov1.SaveReply, error) {
+	options := &biz.BizOptions{
+		ResouceName:       req.ArtifactRepoName,
+		ProductName:       req.ProductName,
+		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
+	}
+	result, err := s.artifactRepo.RollbackArtifactRepo(ctx, options, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &artifactrepov1.SaveReply{
+		Msg:         changeMessage(result, "rolled back", req.ArtifactRepoName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
+	}, nil
+}
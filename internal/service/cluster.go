@@ -17,9 +17,12 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	clusterv1 "github.com/nautes-labs/api-server/api/cluster/v1"
 	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/internal/conf"
 	ClusterRegistration "github.com/nautes-labs/api-server/pkg/cluster"
 	registercluster "github.com/nautes-labs/api-server/pkg/cluster"
 	"github.com/nautes-labs/api-server/pkg/nodestree"
@@ -28,53 +31,162 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// _DefaultExpiryDays is the number of days the credentials of the listed clusters expire within, if the request does not set it.
+const _DefaultExpiryDays = 30
+
 type ClusterService struct {
 	clusterv1.UnimplementedClusterServer
-	cluster *biz.ClusterUsecase
-	configs *nautesconfigs.Config
+	cluster      *biz.ClusterUsecase
+	configs      *nautesconfigs.Config
+	requirements *biz.PreflightRequirements
+}
+
+func NewClusterService(cluster *biz.ClusterUsecase, configs *nautesconfigs.Config, data *conf.Data) *ClusterService {
+	requirements := &biz.PreflightRequirements{
+		MinVersion: data.GetPreflight().GetMinVersion(),
+		CRDs:       data.GetPreflight().GetCrds(),
+	}
+	return &ClusterService{cluster: cluster, configs: configs, requirements: requirements}
 }
 
-func NewClusterService(cluster *biz.ClusterUsecase, configs *nautesconfigs.Config) *ClusterService {
-	return &ClusterService{cluster: cluster, configs: configs}
+func (s *ClusterService) CovertClusterValueToReply(cluster *biz.ClusterData) *clusterv1.GetReply {
+	return &clusterv1.GetReply{
+		Name:        cluster.ClusterName,
+		ApiServer:   cluster.ApiServer,
+		ClusterKind: cluster.ClusterKind,
+		ClusterType: cluster.ClusterType,
+		Usage:       cluster.Usage,
+		HostCluster: cluster.HostCluster,
+		Vclusters:   cluster.Vclusters,
+	}
+}
+
+func (s *ClusterService) GetCluster(ctx context.Context, req *clusterv1.GetRequest) (*clusterv1.GetReply, error) {
+	cluster, err := s.cluster.GetCluster(ctx, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CovertClusterValueToReply(cluster), nil
+}
+
+func (s *ClusterService) ListClusters(ctx context.Context, req *clusterv1.ListsRequest) (*clusterv1.ListsReply, error) {
+	clusters, err := s.cluster.ListClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*clusterv1.GetReply
+	for _, cluster := range clusters {
+		items = append(items, s.CovertClusterValueToReply(cluster))
+	}
+
+	return &clusterv1.ListsReply{
+		Items: items,
+	}, nil
+}
+
+func (s *ClusterService) ValidateCluster(ctx context.Context, req *clusterv1.ValidateRequest) (*clusterv1.ValidateReply, error) {
+	param, err := s.clusterRegistrationParam(req.ClusterName, req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.cluster.ValidateCluster(ctx, param, req.Body.Kubeconfig, s.requirements)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &clusterv1.ValidateReply{Passed: report.Passed}
+	for _, check := range report.Checks {
+		reply.Checks = append(reply.Checks, &clusterv1.PreflightCheck{
+			Type:   check.Type,
+			Name:   check.Name,
+			Passed: check.Passed,
+			Msg:    check.Message,
+		})
+	}
+
+	return reply, nil
 }
 
 func (s *ClusterService) SaveCluster(ctx context.Context, req *clusterv1.SaveRequest) (*clusterv1.SaveReply, error) {
+	param, err := s.clusterRegistrationParam(req.ClusterName, req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.SkipPreflight {
+		report, err := s.cluster.ValidateCluster(ctx, param, req.Body.Kubeconfig, s.requirements)
+		if err != nil {
+			return nil, err
+		}
+
+		if !report.Passed {
+			var messages []string
+			for _, check := range report.FailedChecks() {
+				messages = append(messages, check.Message)
+			}
+			return nil, biz.ErrorPreflightFailed.WithCause(fmt.Errorf("%s", strings.Join(messages, "; ")))
+		}
+	}
+
+	result, err := s.cluster.SaveCluster(ctx, param, req.Body.Kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterv1.SaveReply{
+		Msg:        fmt.Sprintf("Successfully saved %s cluster", req.ClusterName),
+		CommitSha:  result.Commit,
+		Branch:     result.Branch,
+		CommitUrl:  result.CommitURL,
+		AutoMerged: result.AutoMerged,
+	}, nil
+}
+
+// clusterRegistrationParam converts the body of the save request to the parameter of the cluster registration.
+func (s *ClusterService) clusterRegistrationParam(clusterName string, body *clusterv1.SaveRequest_Body) (*ClusterRegistration.ClusterRegistrationParam, error) {
+	if body == nil {
+		return nil, fmt.Errorf("the body of cluster %s is not found", clusterName)
+	}
+
 	cluster := &resourcev1alpha1.Cluster{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: resourcev1alpha1.GroupVersion.String(),
 			Kind:       nodestree.Cluster,
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      req.ClusterName,
+			Name:      clusterName,
 			Namespace: s.configs.Nautes.Namespace,
 		},
 		Spec: resourcev1alpha1.ClusterSpec{
-			ApiServer:   req.Body.ApiServer,
-			ClusterType: resourcev1alpha1.ClusterType(req.Body.ClusterType),
-			ClusterKind: resourcev1alpha1.ClusterKind(req.Body.ClusterKind),
-			Usage:       resourcev1alpha1.ClusterUsage(req.Body.Usage),
+			ApiServer:   body.ApiServer,
+			ClusterType: resourcev1alpha1.ClusterType(body.ClusterType),
+			ClusterKind: resourcev1alpha1.ClusterKind(body.ClusterKind),
+			Usage:       resourcev1alpha1.ClusterUsage(body.Usage),
 		},
 	}
 
-	if err := checkHostClusterIsExist(cluster, req.Body); err != nil {
+	if err := checkHostClusterIsExist(cluster, body); err != nil {
 		return nil, err
 	}
 
 	var vcluster *registercluster.Vcluster
 	if ok := registercluster.IsVirtualRuntime(cluster); ok {
-		if req.Body.Vcluster != nil {
+		if body.Vcluster != nil {
 			vcluster = &registercluster.Vcluster{
-				HttpsNodePort: req.Body.Vcluster.HttpsNodePort,
+				HttpsNodePort: body.Vcluster.HttpsNodePort,
 			}
 		}
 	}
 
 	var traefik *registercluster.Traefik
 	if ok := registercluster.IsVirtualRuntime(cluster); !ok {
-		if req.Body.Traefik != nil {
+		if body.Traefik != nil {
 			traefik = &ClusterRegistration.Traefik{
-				HttpNodePort:  req.Body.Traefik.HttpNodePort,
-				HttpsNodePort: req.Body.Traefik.HttpsNodePort,
+				HttpNodePort:  body.Traefik.HttpNodePort,
+				HttpsNodePort: body.Traefik.HttpsNodePort,
 			}
 		} else {
 			return nil, fmt.Errorf("traefik parameter is not found")
@@ -83,30 +195,89 @@ func (s *ClusterService) SaveCluster(ctx context.Context, req *clusterv1.SaveReq
 
 	param := &ClusterRegistration.ClusterRegistrationParam{
 		Cluster:    cluster,
-		ArgocdHost: req.Body.ArgocdHost,
+		ArgocdHost: body.ArgocdHost,
 		Traefik:    traefik,
 		Vcluster:   vcluster,
 	}
 
-	if err := s.cluster.SaveCluster(ctx, param, req.Body.Kubeconfig); err != nil {
+	return param, nil
+}
+
+func (s *ClusterService) DeleteCluster(ctx context.Context, req *clusterv1.DeleteRequest) (*clusterv1.DeleteReply, error) {
+	result, err := s.cluster.DeleteCluster(ctx, req.ClusterName)
+	if err != nil {
 		return nil, err
 	}
-
-	return &clusterv1.SaveReply{
-		Msg: fmt.Sprintf("Successfully saved %s cluster", req.ClusterName),
+	return &clusterv1.DeleteReply{
+		Msg:        fmt.Sprintf("Successfully deleted %s cluster", req.ClusterName),
+		CommitSha:  result.Commit,
+		Branch:     result.Branch,
+		CommitUrl:  result.CommitURL,
+		AutoMerged: result.AutoMerged,
 	}, nil
 }
 
-func (s *ClusterService) DeleteCluster(ctx context.Context, req *clusterv1.DeleteRequest) (*clusterv1.DeleteReply, error) {
-	err := s.cluster.DeleteCluster(ctx, req.ClusterName)
+func (s *ClusterService) UpdateKubeconfig(ctx context.Context, req *clusterv1.UpdateKubeconfigRequest) (*clusterv1.UpdateKubeconfigReply, error) {
+	credentials, err := s.cluster.UpdateKubeconfig(ctx, req.ClusterName, req.Kubeconfig)
 	if err != nil {
 		return nil, err
 	}
-	return &clusterv1.DeleteReply{
-		Msg: fmt.Sprintf("Successfully deleted %s cluster", req.ClusterName),
+
+	return &clusterv1.UpdateKubeconfigReply{
+		Msg:          fmt.Sprintf("Successfully updated the kubeconfig of %s cluster", req.ClusterName),
+		Certificates: clusterCertificates(credentials.Certificates),
+		ExpiresAt:    formatExpiry(credentials.ExpiresAt),
 	}, nil
 }
 
+func (s *ClusterService) ListExpiringClusters(ctx context.Context, req *clusterv1.ListExpiringRequest) (*clusterv1.ListExpiringReply, error) {
+	days := req.Days
+	if days == 0 {
+		days = _DefaultExpiryDays
+	}
+
+	clusters, err := s.cluster.ListExpiringClusters(ctx, time.Duration(days)*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &clusterv1.ListExpiringReply{}
+	for _, cluster := range clusters {
+		item := &clusterv1.ExpiringCluster{
+			Name:         cluster.ClusterName,
+			ExpiresAt:    formatExpiry(cluster.ExpiresAt),
+			Certificates: clusterCertificates(cluster.Certificates),
+		}
+		if cluster.Err != nil {
+			item.Error = cluster.Err.Error()
+		}
+		reply.Items = append(reply.Items, item)
+	}
+
+	return reply, nil
+}
+
+func clusterCertificates(certificates []*biz.ClusterCertificate) []*clusterv1.Certificate {
+	var items []*clusterv1.Certificate
+	for _, certificate := range certificates {
+		items = append(items, &clusterv1.Certificate{
+			Source:   certificate.Source,
+			Subject:  certificate.Subject,
+			NotAfter: certificate.NotAfter.Format(time.RFC3339),
+		})
+	}
+
+	return items
+}
+
+func formatExpiry(expiresAt time.Time) string {
+	if expiresAt.IsZero() {
+		return ""
+	}
+
+	return expiresAt.Format(time.RFC3339)
+}
+
 func checkHostClusterIsExist(cluster *resourcev1alpha1.Cluster, body *clusterv1.SaveRequest_Body) error {
 	if ok := registercluster.IsVirtualRuntime(cluster); ok {
 		if body.HostCluster == "" {
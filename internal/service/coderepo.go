@@ -17,11 +17,12 @@ package service
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"time"
 
 	coderepov1 "github.com/nautes-labs/api-server/api/coderepo/v1"
 	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
 	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
 	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
 )
@@ -29,12 +30,14 @@ import (
 type CodeRepoService struct {
 	coderepov1.UnimplementedCodeRepoServer
 	codeRepo *biz.CodeRepoUsecase
+	status   *biz.StatusUsecase
 	configs  *nautesconfigs.Config
 }
 
-func NewCodeRepoService(codeRepo *biz.CodeRepoUsecase, configs *nautesconfigs.Config) *CodeRepoService {
+func NewCodeRepoService(codeRepo *biz.CodeRepoUsecase, status *biz.StatusUsecase, configs *nautesconfigs.Config) *CodeRepoService {
 	return &CodeRepoService{
 		codeRepo: codeRepo,
+		status:   status,
 		configs:  configs,
 	}
 }
@@ -75,43 +78,138 @@ func (s *CodeRepoService) CovertCodeRepoValueToReply(codeRepo *resourcev1alpha1.
 		PipelineRuntime:   codeRepo.Spec.PipelineRuntime,
 		DeploymentRuntime: codeRepo.Spec.DeploymentRuntime,
 		Git:               git,
+		ResourceVersion:   codeRepo.ResourceVersion,
 	}
 }
 
 func (s *CodeRepoService) GetCodeRepo(ctx context.Context, req *coderepov1.GetRequest) (*coderepov1.GetReply, error) {
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, new([]nodestree.Violation))
 	codeRepo, project, err := s.codeRepo.GetCodeRepo(ctx, req.CoderepoName, req.ProductName)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.CovertCodeRepoValueToReply(codeRepo, project), nil
+	setETag(ctx, codeRepo.ResourceVersion)
+
+	reply := s.CovertCodeRepoValueToReply(codeRepo, project)
+	if req.WithStatus {
+		status, err := s.status.GetResourceStatus(ctx, req.ProductName, nodestree.CodeRepo, codeRepo.Name)
+		if err != nil {
+			return nil, err
+		}
+		reply.Status = codeRepoStatus(status)
+	}
+
+	return reply, nil
 }
 
 func (s *CodeRepoService) ListCodeRepos(ctx context.Context, req *coderepov1.ListsRequest) (*coderepov1.ListsReply, error) {
+	var violations []nodestree.Violation
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, &violations)
 	cps, err := s.codeRepo.ListCodeRepos(ctx, req.ProductName)
 	if err != nil {
 		return nil, err
 	}
 
+	var statuses map[string]*biz.ResourceStatus
+	if req.WithStatus {
+		statuses, err = s.status.ListResourceStatuses(ctx, req.ProductName, nodestree.CodeRepo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var items []*coderepov1.GetReply
 	for _, cp := range cps {
-		items = append(items, s.CovertCodeRepoValueToReply(cp.CodeRepo, cp.Project))
+		item := s.CovertCodeRepoValueToReply(cp.CodeRepo, cp.Project)
+		if req.WithStatus {
+			item.Status = codeRepoStatus(listedStatus(statuses, cp.CodeRepo.Name))
+		}
+		items = append(items, item)
 	}
 
-	return &coderepov1.ListsReply{
-		Items: items,
-	}, nil
+	reply := &coderepov1.ListsReply{Items: items}
+	for _, violation := range violations {
+		reply.Violations = append(reply.Violations, &coderepov1.Violation{
+			Path:    violation.Path,
+			Rule:    violation.Rule,
+			Message: violation.Message,
+		})
+	}
+
+	return reply, nil
+}
+
+// codeRepoStatus converts the status of the resource in the cluster, the status is nil if it was not requested.
+func codeRepoStatus(status *biz.ResourceStatus) *coderepov1.Status {
+	if status == nil {
+		return nil
+	}
+
+	reply := &coderepov1.Status{
+		Synced:             status.Synced,
+		Generation:         status.Generation,
+		ObservedGeneration: status.ObservedGeneration,
+		LastError:          status.LastError,
+	}
+	for _, condition := range status.Conditions {
+		reply.Conditions = append(reply.Conditions, &coderepov1.Condition{
+			Type:               condition.Type,
+			Status:             string(condition.Status),
+			ObservedGeneration: condition.ObservedGeneration,
+			LastTransitionTime: condition.LastTransitionTime.Format(time.RFC3339),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+
+	return reply
 }
 
 func (s *CodeRepoService) SaveCodeRepo(ctx context.Context, req *coderepov1.SaveRequest) (*coderepov1.SaveReply, error) {
+	gitOptions, err := codeRepoGitOptions(s.configs.Git.GitType, req.CoderepoName, req.Body.Git)
+	if err != nil {
+		return nil, err
+	}
+
+	data := codeRepoData(req.CoderepoName, req.Body)
+	options := &biz.BizOptions{
+		ResouceName:       req.CoderepoName,
+		ProductName:       req.ProductName,
+		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
+	}
+	result, err := s.codeRepo.SaveCodeRepo(ctx, options, data, gitOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coderepov1.SaveReply{
+		Msg:         changeMessage(result, "saved", req.CoderepoName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
+	}, nil
+}
+
+func codeRepoGitOptions(gitType nautesconfigs.GitType, name string, git *coderepov1.Git) (*biz.GitCodeRepoOptions, error) {
 	gitOptions := &biz.GitCodeRepoOptions{
 		Gitlab: &biz.GitlabCodeRepoOptions{},
 	}
 
-	// TODO
-	// Coming soon to support github
-	if s.configs.Git.GitType == nautesconfigs.GIT_TYPE_GITLAB {
-		bytes, err := json.Marshal(req.Body.Git.Gitlab)
+	if gitType == nautesconfigs.GIT_TYPE_GITLAB {
+		bytes, err := json.Marshal(git.Gitlab)
 		if err != nil {
 			return nil, err
 		}
@@ -122,56 +220,211 @@ func (s *CodeRepoService) SaveCodeRepo(ctx context.Context, req *coderepov1.Save
 		}
 
 		if gitOptions.Gitlab.Name == "" {
-			gitOptions.Gitlab.Name = req.CoderepoName
+			gitOptions.Gitlab.Name = name
 		}
 
 		if gitOptions.Gitlab.Path == "" {
 			gitOptions.Gitlab.Path = gitOptions.Gitlab.Name
 		}
 	} else {
-		if gitOptions.Github != "" {
-			return nil, errors.New("coming soon to support github")
+		gitOptions.Github = &biz.GithubCodeRepoOptions{}
+		bytes, err := json.Marshal(git.Github)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(bytes, gitOptions.Github)
+		if err != nil {
+			return nil, err
+		}
+
+		if gitOptions.Github.Name == "" {
+			gitOptions.Github.Name = name
+		}
+
+		if gitOptions.Github.Path == "" {
+			gitOptions.Github.Path = gitOptions.Github.Name
 		}
 	}
 
-	data := &biz.CodeRepoData{
+	return gitOptions, nil
+}
+
+func codeRepoData(name string, body *coderepov1.SaveRequest_Body) *biz.CodeRepoData {
+	return &biz.CodeRepoData{
 		Spec: resourcev1alpha1.CodeRepoSpec{
-			Project:           req.Body.Project,
-			RepoName:          req.CoderepoName,
-			DeploymentRuntime: req.Body.DeploymentRuntime,
-			PipelineRuntime:   req.Body.PipelineRuntime,
+			Project:           body.Project,
+			RepoName:          name,
+			DeploymentRuntime: body.DeploymentRuntime,
+			PipelineRuntime:   body.PipelineRuntime,
 			Webhook: &resourcev1alpha1.Webhook{
-				Events: req.Body.Webhook.Events,
+				Events: body.GetWebhook().GetEvents(),
 			},
 		},
 	}
+}
+
+func codeRepoBody(gitType nautesconfigs.GitType, codeRepo *resourcev1alpha1.CodeRepo, project *biz.Project) *coderepov1.SaveRequest_Body {
+	body := &coderepov1.SaveRequest_Body{
+		Project:           codeRepo.Spec.Project,
+		DeploymentRuntime: codeRepo.Spec.DeploymentRuntime,
+		PipelineRuntime:   codeRepo.Spec.PipelineRuntime,
+		Git:               &coderepov1.Git{},
+	}
+
+	if codeRepo.Spec.Webhook != nil {
+		body.Webhook = &coderepov1.Webhook{Events: codeRepo.Spec.Webhook.Events}
+	}
+
+	if gitType == nautesconfigs.GIT_TYPE_GITLAB {
+		body.Git.Gitlab = &coderepov1.Gitlab{
+			Name:        project.Name,
+			Path:        project.Path,
+			Visibility:  project.Visibility,
+			Description: project.Description,
+		}
+	} else {
+		body.Git.Github = &coderepov1.Github{
+			Name:        project.Name,
+			Path:        project.Path,
+			Visibility:  project.Visibility,
+			Description: project.Description,
+		}
+	}
+
+	return body
+}
+
+func (s *CodeRepoService) DeleteCodeRepo(ctx context.Context, req *coderepov1.DeleteRequest) (*coderepov1.DeleteReply, error) {
 	options := &biz.BizOptions{
 		ResouceName:       req.CoderepoName,
 		ProductName:       req.ProductName,
 		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
 	}
-	err := s.codeRepo.SaveCodeRepo(ctx, options, data, gitOptions)
+	result, err := s.codeRepo.DeleteCodeRepo(ctx, options)
 	if err != nil {
 		return nil, err
 	}
 
-	return &coderepov1.SaveReply{
-		Msg: fmt.Sprintf("Successfully saved %v configuration", req.CoderepoName),
+	return &coderepov1.DeleteReply{
+		Msg:         changeMessage(result, "deleted", req.CoderepoName),
+		DryRun:      result.DryRun,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
 	}, nil
 }
 
-func (s *CodeRepoService) DeleteCodeRepo(ctx context.Context, req *coderepov1.DeleteRequest) (*coderepov1.DeleteReply, error) {
+func (s *CodeRepoService) GetCodeRepoHistory(ctx context.Context, req *coderepov1.HistoryRequest) (*coderepov1.HistoryReply, error) {
+	commits, err := s.codeRepo.GetCodeRepoHistory(ctx, req.CoderepoName, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*coderepov1.Commit
+	for _, commit := range commits {
+		items = append(items, &coderepov1.Commit{
+			Sha:         commit.SHA,
+			Author:      commit.Author,
+			AuthorEmail: commit.AuthorEmail,
+			Date:        commit.Date.Format(time.RFC3339),
+			Message:     commit.Message,
+		})
+	}
+
+	return &coderepov1.HistoryReply{Items: items}, nil
+}
+
+func (s *CodeRepoService) RollbackCodeRepo(ctx context.Context, req *coderepov1.RollbackRequest) (*coderepov1.SaveReply, error) {
 	options := &biz.BizOptions{
 		ResouceName:       req.CoderepoName,
 		ProductName:       req.ProductName,
 		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
 	}
-	err := s.codeRepo.DeleteCodeRepo(ctx, options)
+	result, err := s.codeRepo.RollbackCodeRepo(ctx, options, req.Revision)
 	if err != nil {
 		return nil, err
 	}
 
-	return &coderepov1.DeleteReply{
-		Msg: fmt.Sprintf("Successfully deleted %v configuration", req.CoderepoName),
+	return &coderepov1.SaveReply{
+		Msg:         changeMessage(result, "rolled back", req.CoderepoName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
 	}, nil
 }
+
+func (s *CodeRepoService) RotateDeployKey(ctx context.Context, req *coderepov1.RotateDeployKeyRequest) (*coderepov1.RotateDeployKeysReply, error) {
+	rotation, err := s.codeRepo.RotateDeployKey(ctx, req.ProductName, req.CoderepoName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coderepov1.RotateDeployKeysReply{
+		Results: []*coderepov1.DeployKeyRotation{deployKeyRotation(rotation)},
+		Message: fmt.Sprintf("Successfully rotated the deploy key of %s", req.CoderepoName),
+	}, nil
+}
+
+func (s *CodeRepoService) RotateProductDeployKeys(ctx context.Context, req *coderepov1.RotateProductDeployKeysRequest) (*coderepov1.RotateDeployKeysReply, error) {
+	rotations, err := s.codeRepo.RotateProductDeployKeys(ctx, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	return deployKeyRotationsReply(rotations), nil
+}
+
+func (s *CodeRepoService) RotateTenantDeployKeys(ctx context.Context, req *coderepov1.RotateTenantDeployKeysRequest) (*coderepov1.RotateDeployKeysReply, error) {
+	rotations, err := s.codeRepo.RotateTenantDeployKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return deployKeyRotationsReply(rotations), nil
+}
+
+func deployKeyRotationsReply(rotations []*biz.DeployKeyRotation) *coderepov1.RotateDeployKeysReply {
+	reply := &coderepov1.RotateDeployKeysReply{}
+	failures := 0
+	for _, rotation := range rotations {
+		if rotation.Err != nil {
+			failures++
+		}
+		reply.Results = append(reply.Results, deployKeyRotation(rotation))
+	}
+	reply.Message = fmt.Sprintf("Rotated %d deploy keys with %d failures", len(rotations)-failures, failures)
+
+	return reply
+}
+
+func deployKeyRotation(rotation *biz.DeployKeyRotation) *coderepov1.DeployKeyRotation {
+	result := &coderepov1.DeployKeyRotation{
+		Product:     rotation.ProductName,
+		Coderepo:    rotation.CodeRepoName,
+		DeployKeyId: int64(rotation.DeployKeyID),
+	}
+	if rotation.Err != nil {
+		result.Error = rotation.Err.Error()
+	}
+
+	return result
+}
@@ -16,10 +16,11 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	deploymentruntimev1 "github.com/nautes-labs/api-server/api/deploymentruntime/v1"
 	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
 	"github.com/nautes-labs/pkg/api/v1alpha1"
 	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
 )
@@ -27,18 +28,20 @@ import (
 type DeploymentruntimeService struct {
 	deploymentruntimev1.UnimplementedDeploymentruntimeServer
 	deploymentRuntime *biz.DeploymentRuntimeUsecase
+	status            *biz.StatusUsecase
 }
 
-func NewDeploymentruntimeService(deploymentRuntime *biz.DeploymentRuntimeUsecase) *DeploymentruntimeService {
-	return &DeploymentruntimeService{deploymentRuntime: deploymentRuntime}
+func NewDeploymentruntimeService(deploymentRuntime *biz.DeploymentRuntimeUsecase, status *biz.StatusUsecase) *DeploymentruntimeService {
+	return &DeploymentruntimeService{deploymentRuntime: deploymentRuntime, status: status}
 }
 
 func (s *DeploymentruntimeService) CovertCodeRepoValueToReply(runtime *resourcev1alpha1.DeploymentRuntime) *deploymentruntimev1.GetReply {
 	return &deploymentruntimev1.GetReply{
-		Product:     runtime.Spec.Product,
-		Name:        runtime.Name,
-		Destination: runtime.Spec.Destination,
-		ProjectsRef: runtime.Spec.ProjectsRef,
+		Product:         runtime.Spec.Product,
+		Name:            runtime.Name,
+		Destination:     runtime.Spec.Destination,
+		ProjectsRef:     runtime.Spec.ProjectsRef,
+		ResourceVersion: runtime.ResourceVersion,
 		ManifestSource: &deploymentruntimev1.ManifestSource{
 			CodeRepo:       runtime.Spec.ManifestSource.CodeRepo,
 			TargetRevision: runtime.Spec.ManifestSource.TargetRevision,
@@ -48,71 +51,222 @@ func (s *DeploymentruntimeService) CovertCodeRepoValueToReply(runtime *resourcev
 }
 
 func (s *DeploymentruntimeService) GetDeploymentRuntime(ctx context.Context, req *deploymentruntimev1.GetRequest) (*deploymentruntimev1.GetReply, error) {
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, new([]nodestree.Violation))
 	runtime, err := s.deploymentRuntime.GetDeploymentRuntime(ctx, req.DeploymentruntimeName, req.ProductName)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.CovertCodeRepoValueToReply(runtime), nil
+	setETag(ctx, runtime.ResourceVersion)
+
+	reply := s.CovertCodeRepoValueToReply(runtime)
+	if req.WithStatus {
+		status, err := s.status.GetResourceStatus(ctx, req.ProductName, nodestree.DeploymentRuntime, runtime.Name)
+		if err != nil {
+			return nil, err
+		}
+		reply.Status = deploymentRuntimeStatus(status)
+	}
+
+	return reply, nil
 }
 
 func (s *DeploymentruntimeService) ListDeploymentRuntimes(ctx context.Context, req *deploymentruntimev1.ListsRequest) (*deploymentruntimev1.ListsReply, error) {
+	var violations []nodestree.Violation
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, &violations)
 	runtimes, err := s.deploymentRuntime.ListDeploymentRuntimes(ctx, req.ProductName)
 	if err != nil {
 		return nil, err
 	}
 
+	var statuses map[string]*biz.ResourceStatus
+	if req.WithStatus {
+		statuses, err = s.status.ListResourceStatuses(ctx, req.ProductName, nodestree.DeploymentRuntime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var items []*deploymentruntimev1.GetReply
 	for _, runtime := range runtimes {
-		items = append(items, s.CovertCodeRepoValueToReply(runtime))
+		item := s.CovertCodeRepoValueToReply(runtime)
+		if req.WithStatus {
+			item.Status = deploymentRuntimeStatus(listedStatus(statuses, runtime.Name))
+		}
+		items = append(items, item)
 	}
 
-	return &deploymentruntimev1.ListsReply{
-		Items: items,
-	}, nil
+	reply := &deploymentruntimev1.ListsReply{Items: items}
+	for _, violation := range violations {
+		reply.Violations = append(reply.Violations, &deploymentruntimev1.Violation{
+			Path:    violation.Path,
+			Rule:    violation.Rule,
+			Message: violation.Message,
+		})
+	}
+
+	return reply, nil
+}
+
+// deploymentRuntimeStatus converts the status of the resource in the cluster, the status is nil if it was not requested.
+func deploymentRuntimeStatus(status *biz.ResourceStatus) *deploymentruntimev1.Status {
+	if status == nil {
+		return nil
+	}
+
+	reply := &deploymentruntimev1.Status{
+		Synced:             status.Synced,
+		Generation:         status.Generation,
+		ObservedGeneration: status.ObservedGeneration,
+		LastError:          status.LastError,
+	}
+	for _, condition := range status.Conditions {
+		reply.Conditions = append(reply.Conditions, &deploymentruntimev1.Condition{
+			Type:               condition.Type,
+			Status:             string(condition.Status),
+			ObservedGeneration: condition.ObservedGeneration,
+			LastTransitionTime: condition.LastTransitionTime.Format(time.RFC3339),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+
+	return reply
 }
 
 func (s *DeploymentruntimeService) SaveDeploymentRuntime(ctx context.Context, req *deploymentruntimev1.SaveRequest) (*deploymentruntimev1.SaveReply, error) {
-	data := &biz.DeploymentRuntimeData{
-		Name: req.DeploymentruntimeName,
+	data := deploymentRuntimeData(req.ProductName, req.DeploymentruntimeName, req.Body)
+	options := &biz.BizOptions{
+		ResouceName:       req.DeploymentruntimeName,
+		ProductName:       req.ProductName,
+		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
+	}
+	result, err := s.deploymentRuntime.SaveDeploymentRuntime(ctx, options, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deploymentruntimev1.SaveReply{
+		Msg:         changeMessage(result, "saved", req.DeploymentruntimeName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
+	}, nil
+}
+
+func deploymentRuntimeData(productName, name string, body *deploymentruntimev1.SaveRequest_Body) *biz.DeploymentRuntimeData {
+	return &biz.DeploymentRuntimeData{
+		Name: name,
 		Spec: v1alpha1.DeploymentRuntimeSpec{
-			Product:     req.ProductName,
-			ProjectsRef: req.Body.ProjectsRef,
-			Destination: req.Body.Destination,
+			Product:     productName,
+			ProjectsRef: body.ProjectsRef,
+			Destination: body.Destination,
 			ManifestSource: resourcev1alpha1.ManifestSource{
-				CodeRepo:       req.Body.ManifestSource.CodeRepo,
-				TargetRevision: req.Body.ManifestSource.TargetRevision,
-				Path:           req.Body.ManifestSource.Path,
+				CodeRepo:       body.ManifestSource.CodeRepo,
+				TargetRevision: body.ManifestSource.TargetRevision,
+				Path:           body.ManifestSource.Path,
 			},
 		},
 	}
+}
+
+func deploymentRuntimeBody(runtime *resourcev1alpha1.DeploymentRuntime) *deploymentruntimev1.SaveRequest_Body {
+	return &deploymentruntimev1.SaveRequest_Body{
+		ProjectsRef: runtime.Spec.ProjectsRef,
+		Destination: runtime.Spec.Destination,
+		ManifestSource: &deploymentruntimev1.ManifestSource{
+			CodeRepo:       runtime.Spec.ManifestSource.CodeRepo,
+			TargetRevision: runtime.Spec.ManifestSource.TargetRevision,
+			Path:           runtime.Spec.ManifestSource.Path,
+		},
+	}
+}
+
+func (s *DeploymentruntimeService) DeleteDeploymentRuntime(ctx context.Context, req *deploymentruntimev1.DeleteRequest) (*deploymentruntimev1.DeleteReply, error) {
 	options := &biz.BizOptions{
 		ResouceName:       req.DeploymentruntimeName,
 		ProductName:       req.ProductName,
 		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
 	}
-	err := s.deploymentRuntime.SaveDeploymentRuntime(ctx, options, data)
+	result, err := s.deploymentRuntime.DeleteDeploymentRuntime(ctx, options)
 	if err != nil {
 		return nil, err
 	}
 
-	return &deploymentruntimev1.SaveReply{
-		Msg: fmt.Sprintf("Successfully saved %s configuration", req.DeploymentruntimeName),
+	return &deploymentruntimev1.DeleteReply{
+		Msg:         changeMessage(result, "deleted", req.DeploymentruntimeName),
+		DryRun:      result.DryRun,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
 	}, nil
 }
 
-func (s *DeploymentruntimeService) DeleteDeploymentRuntime(ctx context.Context, req *deploymentruntimev1.DeleteRequest) (*deploymentruntimev1.DeleteReply, error) {
+func (s *DeploymentruntimeService) GetDeploymentRuntimeHistory(ctx context.Context, req *deploymentruntimev1.HistoryRequest) (*deploymentruntimev1.HistoryReply, error) {
+	commits, err := s.deploymentRuntime.GetDeploymentRuntimeHistory(ctx, req.DeploymentruntimeName, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*deploymentruntimev1.Commit
+	for _, commit := range commits {
+		items = append(items, &deploymentruntimev1.Commit{
+			Sha:         commit.SHA,
+			Author:      commit.Author,
+			AuthorEmail: commit.AuthorEmail,
+			Date:        commit.Date.Format(time.RFC3339),
+			Message:     commit.Message,
+		})
+	}
+
+	return &deploymentruntimev1.HistoryReply{Items: items}, nil
+}
+
+func (s *DeploymentruntimeService) RollbackDeploymentRuntime(ctx context.Context, req *deploymentruntimev1.RollbackRequest) (*deploymentruntimev1.SaveReply, error) {
 	options := &biz.BizOptions{
 		ResouceName:       req.DeploymentruntimeName,
 		ProductName:       req.ProductName,
 		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
 	}
-	err := s.deploymentRuntime.DeleteDeploymentRuntime(ctx, options)
+	result, err := s.deploymentRuntime.RollbackDeploymentRuntime(ctx, options, req.Revision)
 	if err != nil {
 		return nil, err
 	}
 
-	return &deploymentruntimev1.DeleteReply{
-		Msg: fmt.Sprintf("Successfully deleted %s configuration", req.DeploymentruntimeName),
+	return &deploymentruntimev1.SaveReply{
+		Msg:         changeMessage(result, "rolled back", req.DeploymentruntimeName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
 	}, nil
 }
@@ -16,52 +16,118 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	environmentv1 "github.com/nautes-labs/api-server/api/environment/v1"
 	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
 	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
 )
 
 type EnvironmentService struct {
 	environmentv1.UnimplementedEnvironmentServer
 	environment *biz.EnvironmentUsecase
+	status      *biz.StatusUsecase
 }
 
-func NewEnvironmentService(environment *biz.EnvironmentUsecase) *EnvironmentService {
-	return &EnvironmentService{environment: environment}
+func NewEnvironmentService(environment *biz.EnvironmentUsecase, status *biz.StatusUsecase) *EnvironmentService {
+	return &EnvironmentService{environment: environment, status: status}
 }
 
 func (s *EnvironmentService) CovertCodeRepoValueToReply(env *resourcev1alpha1.Environment) *environmentv1.GetReply {
 	return &environmentv1.GetReply{
-		Product: env.Spec.Product,
-		Name:    env.Name,
-		Cluster: env.Spec.Cluster,
-		EnvType: env.Spec.EnvType,
+		Product:         env.Spec.Product,
+		Name:            env.Name,
+		ResourceVersion: env.ResourceVersion,
+		Cluster:         env.Spec.Cluster,
+		EnvType:         env.Spec.EnvType,
 	}
 }
 
 func (s *EnvironmentService) GetEnvironment(ctx context.Context, req *environmentv1.GetRequest) (*environmentv1.GetReply, error) {
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, new([]nodestree.Violation))
 	env, err := s.environment.GetEnvironment(ctx, req.EnvironmentName, req.ProductName)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.CovertCodeRepoValueToReply(env), nil
+	setETag(ctx, env.ResourceVersion)
+
+	reply := s.CovertCodeRepoValueToReply(env)
+	if req.WithStatus {
+		status, err := s.status.GetResourceStatus(ctx, req.ProductName, nodestree.Enviroment, env.Name)
+		if err != nil {
+			return nil, err
+		}
+		reply.Status = environmentStatus(status)
+	}
+
+	return reply, nil
 }
 
 func (s *EnvironmentService) ListEnvironments(ctx context.Context, req *environmentv1.ListsRequest) (*environmentv1.ListsReply, error) {
+	var violations []nodestree.Violation
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, &violations)
 	envs, err := s.environment.ListEnvironments(ctx, req.ProductName)
 	if err != nil {
 		return nil, err
 	}
 
+	var statuses map[string]*biz.ResourceStatus
+	if req.WithStatus {
+		statuses, err = s.status.ListResourceStatuses(ctx, req.ProductName, nodestree.Enviroment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var items []*environmentv1.GetReply
 	for _, env := range envs {
-		items = append(items, s.CovertCodeRepoValueToReply(env))
+		item := s.CovertCodeRepoValueToReply(env)
+		if req.WithStatus {
+			item.Status = environmentStatus(listedStatus(statuses, env.Name))
+		}
+		items = append(items, item)
 	}
 
-	return &environmentv1.ListsReply{Items: items}, nil
+	reply := &environmentv1.ListsReply{Items: items}
+	for _, violation := range violations {
+		reply.Violations = append(reply.Violations, &environmentv1.Violation{
+			Path:    violation.Path,
+			Rule:    violation.Rule,
+			Message: violation.Message,
+		})
+	}
+
+	return reply, nil
+}
+
+// environmentStatus converts the status of the resource in the cluster, the status is nil if it was not requested.
+func environmentStatus(status *biz.ResourceStatus) *environmentv1.Status {
+	if status == nil {
+		return nil
+	}
+
+	reply := &environmentv1.Status{
+		Synced:             status.Synced,
+		Generation:         status.Generation,
+		ObservedGeneration: status.ObservedGeneration,
+		LastError:          status.LastError,
+	}
+	for _, condition := range status.Conditions {
+		reply.Conditions = append(reply.Conditions, &environmentv1.Condition{
+			Type:               condition.Type,
+			Status:             string(condition.Status),
+			ObservedGeneration: condition.ObservedGeneration,
+			LastTransitionTime: condition.LastTransitionTime.Format(time.RFC3339),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+
+	return reply
 }
 
 func (s *EnvironmentService) SaveEnvironment(ctx context.Context, req *environmentv1.SaveRequest) (*environmentv1.SaveReply, error) {
@@ -69,34 +135,119 @@ func (s *EnvironmentService) SaveEnvironment(ctx context.Context, req *environme
 		ResouceName:       req.EnvironmentName,
 		ProductName:       req.ProductName,
 		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
 	}
-	err := s.environment.SaveEnvironment(ctx, options, &biz.EnviromentData{
-		Name: req.EnvironmentName,
-		Spec: resourcev1alpha1.EnvironmentSpec{
-			Cluster: req.Body.Cluster,
-			EnvType: req.Body.EnvType,
-		},
-	})
+	result, err := s.environment.SaveEnvironment(ctx, options, environmentData(req.EnvironmentName, req.Body))
 	if err != nil {
 		return nil, err
 	}
 
 	return &environmentv1.SaveReply{
-		Msg: fmt.Sprintf("Successfully saved %v configuration", req.EnvironmentName),
+		Msg:         changeMessage(result, "saved", req.EnvironmentName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
 	}, nil
 }
 
+func environmentData(name string, body *environmentv1.SaveRequest_Body) *biz.EnviromentData {
+	return &biz.EnviromentData{
+		Name: name,
+		Spec: resourcev1alpha1.EnvironmentSpec{
+			Cluster: body.Cluster,
+			EnvType: body.EnvType,
+		},
+	}
+}
+
+func environmentBody(env *resourcev1alpha1.Environment) *environmentv1.SaveRequest_Body {
+	return &environmentv1.SaveRequest_Body{
+		Cluster: env.Spec.Cluster,
+		EnvType: env.Spec.EnvType,
+	}
+}
+
 func (s *EnvironmentService) DeleteEnvironment(ctx context.Context, req *environmentv1.DeleteRequest) (*environmentv1.DeleteReply, error) {
 	options := &biz.BizOptions{
-		ResouceName: req.EnvironmentName,
-		ProductName: req.ProductName,
+		ResouceName:     req.EnvironmentName,
+		ProductName:     req.ProductName,
+		ResourceVersion: getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:          req.DryRun,
+		Proposal:        req.Proposal,
+		CommitMessage:   req.CommitMessage,
 	}
-	err := s.environment.DeleteEnvironment(ctx, options)
+	result, err := s.environment.DeleteEnvironment(ctx, options)
 	if err != nil {
 		return nil, err
 	}
 
 	return &environmentv1.DeleteReply{
-		Msg: fmt.Sprintf("Successfully deleted %v configuration", req.EnvironmentName),
+		Msg:         changeMessage(result, "deleted", req.EnvironmentName),
+		DryRun:      result.DryRun,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
+	}, nil
+}
+
+func (s *EnvironmentService) GetEnvironmentHistory(ctx context.Context, req *environmentv1.HistoryRequest) (*environmentv1.HistoryReply, error) {
+	commits, err := s.environment.GetEnvironmentHistory(ctx, req.EnvironmentName, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*environmentv1.Commit
+	for _, commit := range commits {
+		items = append(items, &environmentv1.Commit{
+			Sha:         commit.SHA,
+			Author:      commit.Author,
+			AuthorEmail: commit.AuthorEmail,
+			Date:        commit.Date.Format(time.RFC3339),
+			Message:     commit.Message,
+		})
+	}
+
+	return &environmentv1.HistoryReply{Items: items}, nil
+}
+
+func (s *EnvironmentService) RollbackEnvironment(ctx context.Context, req *environmentv1.RollbackRequest) (*environmentv1.SaveReply, error) {
+	options := &biz.BizOptions{
+		ResouceName:       req.EnvironmentName,
+		ProductName:       req.ProductName,
+		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
+	}
+	result, err := s.environment.RollbackEnvironment(ctx, options, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &environmentv1.SaveReply{
+		Msg:         changeMessage(result, "rolled back", req.EnvironmentName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
 	}, nil
 }
@@ -17,24 +17,27 @@ package service
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	productv1 "github.com/nautes-labs/api-server/api/product/v1"
 	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/internal/conf"
 	nautesconfigs "github.com/nautes-labs/pkg/pkg/nautesconfigs"
 )
 
 type ProductService struct {
 	productv1.UnimplementedProductServer
-	product *biz.ProductUsecase
-	configs *nautesconfigs.Config
+	product  *biz.ProductUsecase
+	configs  *nautesconfigs.Config
+	template string
 }
 
-func NewProductService(product *biz.ProductUsecase, configs *nautesconfigs.Config) *ProductService {
+func NewProductService(product *biz.ProductUsecase, configs *nautesconfigs.Config, data *conf.Data) *ProductService {
 	return &ProductService{
-		product: product,
-		configs: configs,
+		product:  product,
+		configs:  configs,
+		template: data.GetProductTemplate().GetProduct(),
 	}
 }
 
@@ -117,22 +120,58 @@ func (s *ProductService) SaveProduct(ctx context.Context, req *productv1.SavePro
 			git.Gitlab.Path = git.Gitlab.Name
 		}
 	} else {
-		if req.Git.Github != nil {
-			return nil, errors.New("coming soon to support github")
+		github := &biz.GroupOptions{}
+
+		bytes, err := json.Marshal(req.Git.Github)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(bytes, github)
+		if err != nil {
+			return nil, err
+		}
+
+		git.Github = github
+
+		if git.Github.Name == "" {
+			git.Github.Name = req.ProductName
+		}
+
+		if git.Github.Path == "" {
+			git.Github.Path = git.Github.Name
 		}
 	}
 
-	_, _, err := s.product.SaveProduct(ctx, req.ProductName, git)
+	options := &biz.ProductTemplateOptions{
+		Template:  req.Template,
+		RepoNames: req.RepoNames,
+	}
+	template, result, err := s.product.SaveProductFromTemplate(ctx, req.ProductName, git, options, s.template)
 	if err != nil {
 		return nil, err
 	}
 
+	if template == "" {
+		return &productv1.SaveProductReply{
+			Msg: "Successfully saved",
+		}, nil
+	}
+
 	return &productv1.SaveProductReply{
-		Msg: "Successfully saved",
+		Msg:        fmt.Sprintf("Successfully saved from template %s", template),
+		CommitSha:  result.Commit,
+		Branch:     result.Branch,
+		CommitUrl:  result.CommitURL,
+		AutoMerged: result.AutoMerged,
 	}, nil
 }
 
 func (s *ProductService) DeleteProduct(ctx context.Context, req *productv1.DeleteProductRequest) (*productv1.DeleteProductReply, error) {
+	if req.Cascade {
+		return s.deleteProductCascade(ctx, req)
+	}
+
 	err := s.product.DeleteProduct(ctx, req.ProductName)
 	if err != nil {
 		return nil, err
@@ -142,3 +181,95 @@ func (s *ProductService) DeleteProduct(ctx context.Context, req *productv1.Delet
 		Msg: "Successfully deleted",
 	}, nil
 }
+
+func (s *ProductService) GetDependencies(ctx context.Context, req *productv1.GetDependenciesRequest) (*productv1.GetDependenciesReply, error) {
+	if (req.Kind == "") != (req.Name == "") {
+		return nil, fmt.Errorf("both the kind and the name of the resource are required to look up its dependents")
+	}
+
+	graph, err := s.product.GetDependencyGraph(ctx, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Kind != "" {
+		graph, err = graph.Dependents(req.Kind, req.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reply := &productv1.GetDependenciesReply{}
+	for _, resource := range graph.Resources {
+		reply.Resources = append(reply.Resources, resourceRef(resource))
+	}
+	for _, dependency := range graph.Dependencies {
+		reply.Dependencies = append(reply.Dependencies, &productv1.Dependency{
+			From: resourceRef(dependency.From),
+			To:   resourceRef(dependency.To),
+		})
+	}
+
+	return reply, nil
+}
+
+func (s *ProductService) deleteProductCascade(ctx context.Context, req *productv1.DeleteProductRequest) (*productv1.DeleteProductReply, error) {
+	if !req.Confirm {
+		plan, err := s.product.PlanProductDeletion(ctx, req.ProductName)
+		if err != nil {
+			return nil, err
+		}
+
+		return &productv1.DeleteProductReply{
+			Msg:  "Nothing is deleted until the plan is confirmed",
+			Plan: deletionPlan(plan),
+		}, nil
+	}
+
+	if req.Fingerprint == "" {
+		return nil, errors.BadRequest("FINGERPRINT_REQUIRED", "the fingerprint of the reviewed deletion plan is required to confirm the deletion")
+	}
+
+	plan, failures, err := s.product.DeleteProductCascade(ctx, req.ProductName, req.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &productv1.DeleteProductReply{
+		Msg:  "Successfully deleted",
+		Plan: deletionPlan(plan),
+	}
+	for _, failure := range failures {
+		reply.Failures = append(reply.Failures, &productv1.DeletionFailure{
+			Target: failure.Target,
+			Error:  failure.Err.Error(),
+		})
+	}
+	if len(failures) > 0 {
+		reply.Msg = fmt.Sprintf("Deleted with %d failures", len(failures))
+	}
+
+	return reply, nil
+}
+
+func deletionPlan(plan *biz.ProductDeletionPlan) *productv1.DeletionPlan {
+	reply := &productv1.DeletionPlan{
+		SecretPaths: plan.SecretPaths,
+		Fingerprint: plan.Fingerprint(),
+	}
+	for _, project := range plan.Projects {
+		reply.Repositories = append(reply.Repositories, project.PathWithNamespace)
+	}
+	for _, resource := range plan.Resources {
+		reply.Resources = append(reply.Resources, resourceRef(resource))
+	}
+
+	return reply
+}
+
+func resourceRef(resource *biz.ProductResource) *productv1.ResourceRef {
+	return &productv1.ResourceRef{
+		Kind: resource.Kind,
+		Name: resource.Name,
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	productv1 "github.com/nautes-labs/api-server/api/product/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cascading product deletion", func() {
+	It("requires the fingerprint of the reviewed plan to confirm", func() {
+		service := &ProductService{}
+		_, err := service.DeleteProduct(context.Background(), &productv1.DeleteProductRequest{
+			ProductName: "shop",
+			Cascade:     true,
+			Confirm:     true,
+		})
+		Expect(errors.IsBadRequest(err)).Should(BeTrue())
+		Expect(errors.Reason(err)).Should(Equal("FINGERPRINT_REQUIRED"))
+	})
+})
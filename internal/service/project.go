@@ -16,10 +16,11 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	projectv1 "github.com/nautes-labs/api-server/api/project/v1"
 	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
 	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
 )
 
@@ -36,22 +37,30 @@ func NewProjectService(project *biz.ProjectUsecase) *ProjectService {
 
 func (s *ProjectService) CovertCodeRepoValueToReply(project *resourcev1alpha1.Project) *projectv1.GetReply {
 	return &projectv1.GetReply{
-		Product:  project.Spec.Product,
-		Name:     project.Name,
-		Language: project.Spec.Language,
+		Product:         project.Spec.Product,
+		Name:            project.Name,
+		Language:        project.Spec.Language,
+		ResourceVersion: project.ResourceVersion,
 	}
 }
 
 func (s *ProjectService) GetProject(ctx context.Context, req *projectv1.GetRequest) (*projectv1.GetReply, error) {
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, new([]nodestree.Violation))
 	project, err := s.project.GetProject(ctx, req.ProjectName, req.ProductName)
 	if err != nil {
 		return nil, err
 	}
 
+	setETag(ctx, project.ResourceVersion)
+
 	return s.CovertCodeRepoValueToReply(project), nil
 }
 
 func (s *ProjectService) ListProjects(ctx context.Context, req *projectv1.ListsRequest) (*projectv1.ListsReply, error) {
+	var violations []nodestree.Violation
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, &violations)
 	projects, err := s.project.ListProjects(ctx, req.ProductName)
 	if err != nil {
 		return nil, err
@@ -62,41 +71,134 @@ func (s *ProjectService) ListProjects(ctx context.Context, req *projectv1.ListsR
 		items = append(items, s.CovertCodeRepoValueToReply(project))
 	}
 
-	return &projectv1.ListsReply{Items: items}, nil
+	reply := &projectv1.ListsReply{Items: items}
+	for _, violation := range violations {
+		reply.Violations = append(reply.Violations, &projectv1.Violation{
+			Path:    violation.Path,
+			Rule:    violation.Rule,
+			Message: violation.Message,
+		})
+	}
+
+	return reply, nil
 }
 
 func (s *ProjectService) SaveProject(ctx context.Context, req *projectv1.SaveRequest) (*projectv1.SaveReply, error) {
-	project := &biz.ProjectData{
-		ProjectName: req.ProjectName,
-		Language:    req.Body.Language,
-	}
+	project := projectData(req.ProjectName, req.Body)
 	options := &biz.BizOptions{
 		ResouceName:       req.ProjectName,
 		ProductName:       req.ProductName,
 		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
 	}
-	err := s.project.SaveProject(ctx, options, project)
+	result, err := s.project.SaveProject(ctx, options, project)
 	if err != nil {
 		return nil, err
 	}
 
 	return &projectv1.SaveReply{
-		Msg: fmt.Sprintf("Successfully saved %v configuration", project.ProjectName),
+		Msg:         changeMessage(result, "saved", project.ProjectName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
 	}, nil
 }
 
+func projectData(name string, body *projectv1.SaveRequest_Body) *biz.ProjectData {
+	return &biz.ProjectData{
+		ProjectName: name,
+		Language:    body.Language,
+	}
+}
+
+func projectBody(project *resourcev1alpha1.Project) *projectv1.SaveRequest_Body {
+	return &projectv1.SaveRequest_Body{
+		Language: project.Spec.Language,
+	}
+}
+
 func (s *ProjectService) DeleteProject(ctx context.Context, req *projectv1.DeleteRequest) (*projectv1.DeleteReply, error) {
 	options := &biz.BizOptions{
 		ResouceName:       req.ProjectName,
 		ProductName:       req.ProductName,
 		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
 	}
-	err := s.project.DeleteProject(ctx, options)
+	result, err := s.project.DeleteProject(ctx, options)
 	if err != nil {
 		return nil, err
 	}
 
 	return &projectv1.DeleteReply{
-		Msg: fmt.Sprintf("Successfully deleted %v configuration", req.ProjectName),
+		Msg:         changeMessage(result, "deleted", req.ProjectName),
+		DryRun:      result.DryRun,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
+	}, nil
+}
+
+func (s *ProjectService) GetProjectHistory(ctx context.Context, req *projectv1.HistoryRequest) (*projectv1.HistoryReply, error) {
+	commits, err := s.project.GetProjectHistory(ctx, req.ProjectName, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*projectv1.Commit
+	for _, commit := range commits {
+		items = append(items, &projectv1.Commit{
+			Sha:         commit.SHA,
+			Author:      commit.Author,
+			AuthorEmail: commit.AuthorEmail,
+			Date:        commit.Date.Format(time.RFC3339),
+			Message:     commit.Message,
+		})
+	}
+
+	return &projectv1.HistoryReply{Items: items}, nil
+}
+
+func (s *ProjectService) RollbackProject(ctx context.Context, req *projectv1.RollbackRequest) (*projectv1.SaveReply, error) {
+	options := &biz.BizOptions{
+		ResouceName:       req.ProjectName,
+		ProductName:       req.ProductName,
+		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
+	}
+	result, err := s.project.RollbackProject(ctx, options, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectv1.SaveReply{
+		Msg:         changeMessage(result, "rolled back", req.ProjectName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
 	}, nil
 }
@@ -16,10 +16,11 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	projectpipelineruntimev1 "github.com/nautes-labs/api-server/api/projectpipelineruntime/v1"
 	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/pkg/nodestree"
 	resourcev1alpha1 "github.com/nautes-labs/pkg/api/v1alpha1"
 )
 
@@ -54,25 +55,33 @@ func (s *ProjectPipelineRuntimeService) CovertCodeRepoValueToReply(projectPipeli
 		})
 	}
 	return &projectpipelineruntimev1.GetReply{
-		Name:           projectPipelineRuntime.Name,
-		Project:        projectPipelineRuntime.Spec.Project,
-		PipelineSource: projectPipelineRuntime.Spec.PipelineSource,
-		CodeSources:    projectPipelineRuntime.Spec.CodeSources,
-		Destination:    projectPipelineRuntime.Spec.Destination,
-		Pipelines:      pipelines,
+		Name:            projectPipelineRuntime.Name,
+		Project:         projectPipelineRuntime.Spec.Project,
+		PipelineSource:  projectPipelineRuntime.Spec.PipelineSource,
+		CodeSources:     projectPipelineRuntime.Spec.CodeSources,
+		Destination:     projectPipelineRuntime.Spec.Destination,
+		Pipelines:       pipelines,
+		ResourceVersion: projectPipelineRuntime.ResourceVersion,
 	}
 }
 
 func (s *ProjectPipelineRuntimeService) GetProjectPipelineRuntime(ctx context.Context, req *projectpipelineruntimev1.GetRequest) (*projectpipelineruntimev1.GetReply, error) {
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, new([]nodestree.Violation))
 	runtime, err := s.projectPipelineRuntime.GetProjectPipelineRuntime(ctx, req.ProjectPipelineRuntimeName, req.ProductName)
 	if err != nil {
 		return nil, err
 	}
 
+	setETag(ctx, runtime.ResourceVersion)
+
 	return s.CovertCodeRepoValueToReply(runtime, req.ProductName), nil
 }
 
 func (s *ProjectPipelineRuntimeService) ListProjectPipelineRuntimes(ctx context.Context, req *projectpipelineruntimev1.ListsRequest) (*projectpipelineruntimev1.ListsReply, error) {
+	var violations []nodestree.Violation
+	ctx = biz.WithRevision(ctx, req.Revision)
+	ctx = biz.WithViolations(ctx, &violations)
 	runtimes, err := s.projectPipelineRuntime.ListProjectPipelineRuntimes(ctx, req.ProductName)
 	if err != nil {
 		return nil, err
@@ -83,39 +92,90 @@ func (s *ProjectPipelineRuntimeService) ListProjectPipelineRuntimes(ctx context.
 		items = append(items, s.CovertCodeRepoValueToReply(runtime, req.ProductName))
 	}
 
-	return &projectpipelineruntimev1.ListsReply{
-		Items: items,
-	}, nil
+	reply := &projectpipelineruntimev1.ListsReply{Items: items}
+	for _, violation := range violations {
+		reply.Violations = append(reply.Violations, &projectpipelineruntimev1.Violation{
+			Path:    violation.Path,
+			Rule:    violation.Rule,
+			Message: violation.Message,
+		})
+	}
+
+	return reply, nil
 }
 
 func (s *ProjectPipelineRuntimeService) SaveProjectPipelineRuntime(ctx context.Context, req *projectpipelineruntimev1.SaveRequest) (*projectpipelineruntimev1.SaveReply, error) {
-	data := &biz.ProjectPipelineRuntimeData{
-		Name: req.ProjectPipelineRuntimeName,
-		Spec: resourcev1alpha1.ProjectPipelineRuntimeSpec{
-			Project:        req.Body.Project,
-			PipelineSource: req.Body.PipelineSource,
-			CodeSources:    req.Body.CodeSources,
-			Destination:    req.Body.Destination,
-			Pipelines:      s.getResourcePipelines(req.Body.Pipelines),
-		},
-	}
+	data := projectPipelineRuntimeData(req.ProjectPipelineRuntimeName, req.Body)
 
 	options := &biz.BizOptions{
 		ResouceName:       req.ProjectPipelineRuntimeName,
 		ProductName:       req.ProductName,
 		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
 	}
-	err := s.projectPipelineRuntime.SaveProjectPipelineRuntime(ctx, options, data)
+	result, err := s.projectPipelineRuntime.SaveProjectPipelineRuntime(ctx, options, data)
 	if err != nil {
 		return nil, err
 	}
 
 	return &projectpipelineruntimev1.SaveReply{
-		Msg: fmt.Sprintf("Successfully saved %s configuration", req.ProjectPipelineRuntimeName),
+		Msg:         changeMessage(result, "saved", req.ProjectPipelineRuntimeName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
 	}, nil
 }
 
-func (s *ProjectPipelineRuntimeService) getResourcePipelines(pipelines []*projectpipelineruntimev1.Pipeline) []resourcev1alpha1.Pipeline {
+func projectPipelineRuntimeData(name string, body *projectpipelineruntimev1.SaveRequest_Body) *biz.ProjectPipelineRuntimeData {
+	return &biz.ProjectPipelineRuntimeData{
+		Name: name,
+		Spec: resourcev1alpha1.ProjectPipelineRuntimeSpec{
+			Project:        body.Project,
+			PipelineSource: body.PipelineSource,
+			CodeSources:    body.CodeSources,
+			Destination:    body.Destination,
+			Pipelines:      getResourcePipelines(body.Pipelines),
+		},
+	}
+}
+
+func projectPipelineRuntimeBody(runtime *resourcev1alpha1.ProjectPipelineRuntime) *projectpipelineruntimev1.SaveRequest_Body {
+	body := &projectpipelineruntimev1.SaveRequest_Body{
+		Project:        runtime.Spec.Project,
+		PipelineSource: runtime.Spec.PipelineSource,
+		CodeSources:    runtime.Spec.CodeSources,
+		Destination:    runtime.Spec.Destination,
+	}
+
+	for _, pipeline := range runtime.Spec.Pipelines {
+		bodyPipeline := &projectpipelineruntimev1.Pipeline{
+			Name:   pipeline.Name,
+			Branch: pipeline.Branch,
+			Path:   pipeline.Path,
+		}
+
+		for _, e := range pipeline.EventSources {
+			bodyPipeline.EventSources = append(bodyPipeline.EventSources, &projectpipelineruntimev1.EventSource{
+				Webhook: e.Webhook,
+			})
+		}
+
+		body.Pipelines = append(body.Pipelines, bodyPipeline)
+	}
+
+	return body
+}
+
+func getResourcePipelines(pipelines []*projectpipelineruntimev1.Pipeline) []resourcev1alpha1.Pipeline {
 	resourcePipelines := []resourcev1alpha1.Pipeline{}
 	for _, pipeline := range pipelines {
 		resourcePipeline := resourcev1alpha1.Pipeline{
@@ -142,13 +202,74 @@ func (s *ProjectPipelineRuntimeService) DeleteProjectPipelineRuntime(ctx context
 		ResouceName:       req.ProjectPipelineRuntimeName,
 		ProductName:       req.ProductName,
 		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
 	}
-	err := s.projectPipelineRuntime.DeleteProjectPipelineRuntime(ctx, options)
+	result, err := s.projectPipelineRuntime.DeleteProjectPipelineRuntime(ctx, options)
 	if err != nil {
 		return nil, err
 	}
 
 	return &projectpipelineruntimev1.DeleteReply{
-		Msg: fmt.Sprintf("Successfully deleted %s configuration", req.ProjectPipelineRuntimeName),
+		Msg:         changeMessage(result, "deleted", req.ProjectPipelineRuntimeName),
+		DryRun:      result.DryRun,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
+	}, nil
+}
+
+func (s *ProjectPipelineRuntimeService) GetProjectPipelineRuntimeHistory(ctx context.Context, req *projectpipelineruntimev1.HistoryRequest) (*projectpipelineruntimev1.HistoryReply, error) {
+	commits, err := s.projectPipelineRuntime.GetProjectPipelineRuntimeHistory(ctx, req.ProjectPipelineRuntimeName, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*projectpipelineruntimev1.Commit
+	for _, commit := range commits {
+		items = append(items, &projectpipelineruntimev1.Commit{
+			Sha:         commit.SHA,
+			Author:      commit.Author,
+			AuthorEmail: commit.AuthorEmail,
+			Date:        commit.Date.Format(time.RFC3339),
+			Message:     commit.Message,
+		})
+	}
+
+	return &projectpipelineruntimev1.HistoryReply{Items: items}, nil
+}
+
+func (s *ProjectPipelineRuntimeService) RollbackProjectPipelineRuntime(ctx context.Context, req *projectpipelineruntimev1.RollbackRequest) (*projectpipelineruntimev1.SaveReply, error) {
+	options := &biz.BizOptions{
+		ResouceName:       req.ProjectPipelineRuntimeName,
+		ProductName:       req.ProductName,
+		InsecureSkipCheck: req.InsecureSkipCheck,
+		ResourceVersion:   getResourceVersion(ctx, req.ResourceVersion),
+		DryRun:            req.DryRun,
+		Proposal:          req.Proposal,
+		CommitMessage:     req.CommitMessage,
+	}
+	result, err := s.projectPipelineRuntime.RollbackProjectPipelineRuntime(ctx, options, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectpipelineruntimev1.SaveReply{
+		Msg:         changeMessage(result, "rolled back", req.ProjectPipelineRuntimeName),
+		DryRun:      result.DryRun,
+		Yaml:        result.Yaml,
+		Diff:        result.Diff,
+		ProposalId:  proposalID(result),
+		ProposalUrl: proposalURL(result),
+		CommitSha:   result.Commit,
+		Branch:      result.Branch,
+		CommitUrl:   result.CommitURL,
+		AutoMerged:  result.AutoMerged,
 	}, nil
 }
@@ -0,0 +1,100 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	proposalv1 "github.com/nautes-labs/api-server/api/proposal/v1"
+	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/internal/conf"
+)
+
+type ProposalService struct {
+	proposalv1.UnimplementedProposalServer
+	proposal *biz.ProposalUsecase
+}
+
+func NewProposalService(proposal *biz.ProposalUsecase) *ProposalService {
+	return &ProposalService{proposal: proposal}
+}
+
+// NewProposalPolicy reads the products requiring proposals from the configuration.
+func NewProposalPolicy(data *conf.Data) *biz.ProposalPolicy {
+	return &biz.ProposalPolicy{
+		Required: data.GetProposal().GetRequired(),
+		Products: data.GetProposal().GetProducts(),
+	}
+}
+
+func (s *ProposalService) CovertProposalValueToReply(proposal *biz.MergeRequest) *proposalv1.GetReply {
+	reply := &proposalv1.GetReply{
+		Id:           int32(proposal.ID),
+		Title:        proposal.Title,
+		State:        proposal.State,
+		MergeStatus:  proposal.MergeStatus,
+		SourceBranch: proposal.SourceBranch,
+		TargetBranch: proposal.TargetBranch,
+		Author:       proposal.Author,
+		WebUrl:       proposal.WebUrl,
+	}
+	if proposal.CreatedAt != nil {
+		reply.CreatedAt = proposal.CreatedAt.Format(time.RFC3339)
+	}
+
+	return reply
+}
+
+func (s *ProposalService) GetProposal(ctx context.Context, req *proposalv1.GetRequest) (*proposalv1.GetReply, error) {
+	proposal, err := s.proposal.GetProposal(ctx, req.ProductName, int(req.ProposalId))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CovertProposalValueToReply(proposal), nil
+}
+
+func (s *ProposalService) ListProposals(ctx context.Context, req *proposalv1.ListsRequest) (*proposalv1.ListsReply, error) {
+	proposals, err := s.proposal.ListProposals(ctx, req.ProductName)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*proposalv1.GetReply
+	for _, proposal := range proposals {
+		items = append(items, s.CovertProposalValueToReply(proposal))
+	}
+
+	return &proposalv1.ListsReply{Items: items}, nil
+}
+
+func (s *ProposalService) ApproveProposal(ctx context.Context, req *proposalv1.ApproveRequest) (*proposalv1.GetReply, error) {
+	proposal, err := s.proposal.ApproveProposal(ctx, req.ProductName, int(req.ProposalId))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CovertProposalValueToReply(proposal), nil
+}
+
+func (s *ProposalService) MergeProposal(ctx context.Context, req *proposalv1.MergeRequest) (*proposalv1.GetReply, error) {
+	proposal, err := s.proposal.MergeProposal(ctx, req.ProductName, int(req.ProposalId))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CovertProposalValueToReply(proposal), nil
+}
@@ -15,8 +15,83 @@
 package service
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/google/wire"
+	"github.com/nautes-labs/api-server/internal/biz"
 )
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewProjectPipelineRuntimeService, NewDeploymentruntimeService, NewCodeRepoService, NewProductService, NewProjectService, NewEnvironmentService, NewClusterService)
+var ProviderSet = wire.NewSet(NewProjectPipelineRuntimeService, NewDeploymentruntimeService, NewCodeRepoService, NewProductService, NewProjectService, NewEnvironmentService, NewClusterService, NewArtifactRepoService, NewProposalService, NewProposalPolicy, NewApplyService, NewWatchService)
+
+// getResourceVersion returns the resource version of the request field, or the If-Match header when the field is empty.
+func getResourceVersion(ctx context.Context, version string) string {
+	if version != "" {
+		return version
+	}
+
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	version = strings.TrimSpace(tr.RequestHeader().Get("If-Match"))
+	version = strings.TrimPrefix(version, "W/")
+
+	return strings.Trim(version, `"`)
+}
+
+// setETag returns the resource version as the ETag header of the reply.
+func setETag(ctx context.Context, version string) {
+	if version == "" {
+		return
+	}
+
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return
+	}
+
+	tr.ReplyHeader().Set("ETag", fmt.Sprintf("%q", version))
+}
+
+// listedStatus returns the status of the resource in the listed statuses, the resources that are not listed are not synced to the cluster.
+func listedStatus(statuses map[string]*biz.ResourceStatus, name string) *biz.ResourceStatus {
+	if status, ok := statuses[name]; ok {
+		return status
+	}
+
+	return &biz.ResourceStatus{}
+}
+
+// changeMessage returns the reply message of a save or delete, the operation is "saved" or "deleted".
+func changeMessage(result *biz.ChangeResult, operation, name string) string {
+	if result.DryRun {
+		return fmt.Sprintf("Dry run: %v configuration has been checked but not %s", name, operation)
+	}
+
+	if result.Proposal != nil {
+		return fmt.Sprintf("Successfully proposed the changes of %v configuration, see %s", name, result.Proposal.WebUrl)
+	}
+
+	return fmt.Sprintf("Successfully %s %v configuration", operation, name)
+}
+
+func proposalID(result *biz.ChangeResult) int32 {
+	if result.Proposal == nil {
+		return 0
+	}
+
+	return int32(result.Proposal.ID)
+}
+
+func proposalURL(result *biz.ChangeResult) string {
+	if result.Proposal == nil {
+		return ""
+	}
+
+	return result.Proposal.WebUrl
+}
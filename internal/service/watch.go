@@ -0,0 +1,147 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"path"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	commonv1 "github.com/nautes-labs/api-server/api/common/v1"
+	"github.com/nautes-labs/api-server/internal/biz"
+	"github.com/nautes-labs/api-server/internal/conf"
+)
+
+const (
+	_GitlabTokenHeader = "X-Gitlab-Token"
+	_GitlabEventHeader = "X-Gitlab-Event"
+	_GitlabPushHook    = "Push Hook"
+	// _WatchHeartbeat is the interval of the comments sent to watchers, a watcher that has gone away is noticed when they fail.
+	_WatchHeartbeat = 15 * time.Second
+)
+
+// WatchService receives the GitLab push webhooks of the default projects and streams the resource events to watchers.
+// It is not generated from a proto, because neither the webhook payload nor server-sent events fit the API conventions.
+type WatchService struct {
+	watch       *biz.WatchUsecase
+	secretToken string
+	accessToken string
+}
+
+func NewWatchService(watch *biz.WatchUsecase, data *conf.Data) *WatchService {
+	return &WatchService{
+		watch:       watch,
+		secretToken: data.GetWebhook().GetSecretToken(),
+		accessToken: data.GetWebhook().GetAccessToken(),
+	}
+}
+
+// gitlabPushEvent is the part of the payload of a GitLab push webhook that is used.
+type gitlabPushEvent struct {
+	Ref     string `json:"ref"`
+	Before  string `json:"before"`
+	After   string `json:"after"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+}
+
+// GitlabWebhook accepts a push webhook, the changes are published after the webhook is answered.
+func (s *WatchService) GitlabWebhook(ctx http.Context) error {
+	req := ctx.Request()
+	token := req.Header.Get(_GitlabTokenHeader)
+	if s.secretToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.secretToken)) != 1 {
+		return commonv1.ErrorNoAuthorization("the webhook token is invalid")
+	}
+
+	if req.Header.Get(_GitlabEventHeader) != _GitlabPushHook {
+		return ctx.JSON(nethttp.StatusOK, map[string]string{"message": "Only push events are handled"})
+	}
+
+	event := &gitlabPushEvent{}
+	err := json.NewDecoder(req.Body).Decode(event)
+	if err != nil {
+		return errors.BadRequest("INVALID_WEBHOOK", fmt.Sprintf("failed to decode the push event, err: %v", err))
+	}
+
+	push := &biz.PushEvent{
+		ProductName: path.Dir(event.Project.PathWithNamespace),
+		ProjectPath: path.Base(event.Project.PathWithNamespace),
+		Ref:         event.Ref,
+		Before:      event.Before,
+		After:       event.After,
+	}
+	s.watch.ReceivePush(context.WithValue(context.Background(), "token", s.accessToken), push)
+
+	return ctx.JSON(nethttp.StatusAccepted, map[string]string{"message": "Accepted"})
+}
+
+// Watch streams the resource events of the product as server-sent events, the event name is the type of the change.
+func (s *WatchService) Watch(ctx http.Context) error {
+	productName := ctx.Vars().Get("productName")
+
+	check := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, s.watch.CheckWatch(ctx, productName)
+	})
+	_, err := check(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	w := ctx.Response()
+	flusher, ok := w.(nethttp.Flusher)
+	if !ok {
+		return errors.InternalServer("STREAMING_UNSUPPORTED", "the response cannot be streamed")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(nethttp.StatusOK)
+	flusher.Flush()
+
+	events, stop := s.watch.Watch(productName)
+	defer stop()
+
+	// The request context ends with the server timeout, so the stream ends when a write fails instead.
+	heartbeat := time.NewTicker(_WatchHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case event := <-events:
+			data, err := json.Marshal(event)
+			if err != nil {
+				return nil
+			}
+			_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+			if err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			_, err := fmt.Fprint(w, ": heartbeat\n\n")
+			if err != nil {
+				return nil
+			}
+		}
+
+		flusher.Flush()
+	}
+}
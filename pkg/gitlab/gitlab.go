@@ -206,3 +206,48 @@ func (g *GitlabClient) DeleteDeployKey(pid interface{}, deployKey int, options .
 
 	return
 }
+
+func (g *GitlabClient) CreateMergeRequest(pid interface{}, opt *gitlab.CreateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (mergeRequest *gitlab.MergeRequest, res *gitlab.Response, err error) {
+	mergeRequest, res, err = g.client.MergeRequests.CreateMergeRequest(pid, opt, options...)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (g *GitlabClient) GetMergeRequest(pid interface{}, mergeRequestIID int, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (mergeRequest *gitlab.MergeRequest, res *gitlab.Response, err error) {
+	mergeRequest, res, err = g.client.MergeRequests.GetMergeRequest(pid, mergeRequestIID, opt, options...)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (g *GitlabClient) ListProjectMergeRequests(pid interface{}, opt *gitlab.ListProjectMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (mergeRequests []*gitlab.MergeRequest, res *gitlab.Response, err error) {
+	mergeRequests, res, err = g.client.MergeRequests.ListProjectMergeRequests(pid, opt, options...)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (g *GitlabClient) ApproveMergeRequest(pid interface{}, mergeRequestIID int, opt *gitlab.ApproveMergeRequestOptions, options ...gitlab.RequestOptionFunc) (approvals *gitlab.MergeRequestApprovals, res *gitlab.Response, err error) {
+	approvals, res, err = g.client.MergeRequestApprovals.ApproveMergeRequest(pid, mergeRequestIID, opt, options...)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (g *GitlabClient) AcceptMergeRequest(pid interface{}, mergeRequestIID int, opt *gitlab.AcceptMergeRequestOptions, options ...gitlab.RequestOptionFunc) (mergeRequest *gitlab.MergeRequest, res *gitlab.Response, err error) {
+	mergeRequest, res, err = g.client.MergeRequests.AcceptMergeRequest(pid, mergeRequestIID, opt, options...)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -34,6 +34,27 @@ func (m *MockGitlabOperator) EXPECT() *MockGitlabOperatorMockRecorder {
 	return m.recorder
 }
 
+// AcceptMergeRequest mocks base method.
+func (m *MockGitlabOperator) AcceptMergeRequest(pid interface{}, mergeRequestIID int, opt *go_gitlab.AcceptMergeRequestOptions, options ...go_gitlab.RequestOptionFunc) (*go_gitlab.MergeRequest, *go_gitlab.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{pid, mergeRequestIID, opt}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptMergeRequest", varargs...)
+	ret0, _ := ret[0].(*go_gitlab.MergeRequest)
+	ret1, _ := ret[1].(*go_gitlab.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AcceptMergeRequest indicates an expected call of AcceptMergeRequest.
+func (mr *MockGitlabOperatorMockRecorder) AcceptMergeRequest(pid, mergeRequestIID, opt interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{pid, mergeRequestIID, opt}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptMergeRequest", reflect.TypeOf((*MockGitlabOperator)(nil).AcceptMergeRequest), varargs...)
+}
+
 // AddDeployKey mocks base method.
 func (m *MockGitlabOperator) AddDeployKey(pid interface{}, opt *go_gitlab.AddDeployKeyOptions, options ...go_gitlab.RequestOptionFunc) (*go_gitlab.ProjectDeployKey, *go_gitlab.Response, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +76,27 @@ func (mr *MockGitlabOperatorMockRecorder) AddDeployKey(pid, opt interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeployKey", reflect.TypeOf((*MockGitlabOperator)(nil).AddDeployKey), varargs...)
 }
 
+// ApproveMergeRequest mocks base method.
+func (m *MockGitlabOperator) ApproveMergeRequest(pid interface{}, mergeRequestIID int, opt *go_gitlab.ApproveMergeRequestOptions, options ...go_gitlab.RequestOptionFunc) (*go_gitlab.MergeRequestApprovals, *go_gitlab.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{pid, mergeRequestIID, opt}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApproveMergeRequest", varargs...)
+	ret0, _ := ret[0].(*go_gitlab.MergeRequestApprovals)
+	ret1, _ := ret[1].(*go_gitlab.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ApproveMergeRequest indicates an expected call of ApproveMergeRequest.
+func (mr *MockGitlabOperatorMockRecorder) ApproveMergeRequest(pid, mergeRequestIID, opt interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{pid, mergeRequestIID, opt}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveMergeRequest", reflect.TypeOf((*MockGitlabOperator)(nil).ApproveMergeRequest), varargs...)
+}
+
 // CreateGroup mocks base method.
 func (m *MockGitlabOperator) CreateGroup(opt *go_gitlab.CreateGroupOptions, options ...go_gitlab.RequestOptionFunc) (*go_gitlab.Group, *go_gitlab.Response, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +118,27 @@ func (mr *MockGitlabOperatorMockRecorder) CreateGroup(opt interface{}, options .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockGitlabOperator)(nil).CreateGroup), varargs...)
 }
 
+// CreateMergeRequest mocks base method.
+func (m *MockGitlabOperator) CreateMergeRequest(pid interface{}, opt *go_gitlab.CreateMergeRequestOptions, options ...go_gitlab.RequestOptionFunc) (*go_gitlab.MergeRequest, *go_gitlab.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{pid, opt}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateMergeRequest", varargs...)
+	ret0, _ := ret[0].(*go_gitlab.MergeRequest)
+	ret1, _ := ret[1].(*go_gitlab.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateMergeRequest indicates an expected call of CreateMergeRequest.
+func (mr *MockGitlabOperatorMockRecorder) CreateMergeRequest(pid, opt interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{pid, opt}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMergeRequest", reflect.TypeOf((*MockGitlabOperator)(nil).CreateMergeRequest), varargs...)
+}
+
 // CreateProject mocks base method.
 func (m *MockGitlabOperator) CreateProject(opt *go_gitlab.CreateProjectOptions, options ...go_gitlab.RequestOptionFunc) (*go_gitlab.Project, *go_gitlab.Response, error) {
 	m.ctrl.T.Helper()
@@ -210,6 +273,27 @@ func (mr *MockGitlabOperatorMockRecorder) GetGroup(gid, opt interface{}, options
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockGitlabOperator)(nil).GetGroup), varargs...)
 }
 
+// GetMergeRequest mocks base method.
+func (m *MockGitlabOperator) GetMergeRequest(pid interface{}, mergeRequestIID int, opt *go_gitlab.GetMergeRequestsOptions, options ...go_gitlab.RequestOptionFunc) (*go_gitlab.MergeRequest, *go_gitlab.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{pid, mergeRequestIID, opt}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMergeRequest", varargs...)
+	ret0, _ := ret[0].(*go_gitlab.MergeRequest)
+	ret1, _ := ret[1].(*go_gitlab.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMergeRequest indicates an expected call of GetMergeRequest.
+func (mr *MockGitlabOperatorMockRecorder) GetMergeRequest(pid, mergeRequestIID, opt interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{pid, mergeRequestIID, opt}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergeRequest", reflect.TypeOf((*MockGitlabOperator)(nil).GetMergeRequest), varargs...)
+}
+
 // GetProject mocks base method.
 func (m *MockGitlabOperator) GetProject(pid interface{}, opt *go_gitlab.GetProjectOptions, options ...go_gitlab.RequestOptionFunc) (*go_gitlab.Project, *go_gitlab.Response, error) {
 	m.ctrl.T.Helper()
@@ -294,6 +378,27 @@ func (mr *MockGitlabOperatorMockRecorder) ListGroups(opt interface{}, options ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockGitlabOperator)(nil).ListGroups), varargs...)
 }
 
+// ListProjectMergeRequests mocks base method.
+func (m *MockGitlabOperator) ListProjectMergeRequests(pid interface{}, opt *go_gitlab.ListProjectMergeRequestsOptions, options ...go_gitlab.RequestOptionFunc) ([]*go_gitlab.MergeRequest, *go_gitlab.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{pid, opt}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectMergeRequests", varargs...)
+	ret0, _ := ret[0].([]*go_gitlab.MergeRequest)
+	ret1, _ := ret[1].(*go_gitlab.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListProjectMergeRequests indicates an expected call of ListProjectMergeRequests.
+func (mr *MockGitlabOperatorMockRecorder) ListProjectMergeRequests(pid, opt interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{pid, opt}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectMergeRequests", reflect.TypeOf((*MockGitlabOperator)(nil).ListProjectMergeRequests), varargs...)
+}
+
 // NewGitlabClient mocks base method.
 func (m *MockGitlabOperator) NewGitlabClient(url, token string) (GitlabOperator, error) {
 	m.ctrl.T.Helper()
@@ -19,7 +19,9 @@ import "sigs.k8s.io/controller-runtime/pkg/client"
 type NodesTree interface {
 	Load(path string) (root Node, err error)
 	AppendOperators(operator NodesOperator)
+	LoadWithViolations(path string) (root Node, violations []Violation, err error)
 	Compare(options CompareOptions) error
+	Validate(options CompareOptions) ([]Violation, error)
 	InsertNodes(nodes, resource *Node) (*Node, error)
 	GetNode(nodes *Node, kind, name string) (node *Node)
 	RemoveNode(nodes *Node, node *Node) (*Node, error)
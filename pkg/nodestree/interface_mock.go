@@ -104,6 +104,22 @@ func (mr *MockNodesTreeMockRecorder) Load(path interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockNodesTree)(nil).Load), path)
 }
 
+// LoadWithViolations mocks base method.
+func (m *MockNodesTree) LoadWithViolations(path string) (Node, []Violation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadWithViolations", path)
+	ret0, _ := ret[0].(Node)
+	ret1, _ := ret[1].([]Violation)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// LoadWithViolations indicates an expected call of LoadWithViolations.
+func (mr *MockNodesTreeMockRecorder) LoadWithViolations(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadWithViolations", reflect.TypeOf((*MockNodesTree)(nil).LoadWithViolations), path)
+}
+
 // RemoveNode mocks base method.
 func (m *MockNodesTree) RemoveNode(nodes, node *Node) (*Node, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +135,21 @@ func (mr *MockNodesTreeMockRecorder) RemoveNode(nodes, node interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNode", reflect.TypeOf((*MockNodesTree)(nil).RemoveNode), nodes, node)
 }
 
+// Validate mocks base method.
+func (m *MockNodesTree) Validate(options CompareOptions) ([]Violation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", options)
+	ret0, _ := ret[0].([]Violation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockNodesTreeMockRecorder) Validate(options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockNodesTree)(nil).Validate), options)
+}
+
 // MockNodesOperator is a mock of NodesOperator interface.
 type MockNodesOperator struct {
 	ctrl     *gomock.Controller
@@ -194,6 +194,26 @@ func (i *nodesTree) AppendOperators(operator NodesOperator) {
 }
 
 func (i *nodesTree) Load(path string) (root Node, err error) {
+	return i.load(path, nil)
+}
+
+// LoadWithViolations is like Load, but the files that cannot be parsed are left out and returned as violations.
+func (i *nodesTree) LoadWithViolations(path string) (root Node, violations []Violation, err error) {
+	violations = []Violation{}
+	root, err = i.load(path, &violations)
+	if err != nil {
+		return
+	}
+
+	for j := range violations {
+		violations[j].Path = relativePath(path, violations[j].Path)
+	}
+
+	return
+}
+
+// load reads the file tree at path, the files that cannot be parsed are added to violations if it is not nil.
+func (i *nodesTree) load(path string, violations *[]Violation) (root Node, err error) {
 	if strings.TrimSpace(path) == "" {
 		err = fmt.Errorf("file or directory cannot be empty")
 		return
@@ -211,7 +231,7 @@ func (i *nodesTree) Load(path string) (root Node, err error) {
 	root.IsDir = file.IsDir()
 
 	if root.IsDir {
-		err = explorerRecursive(&root, i.fileOptions, i.operators)
+		err = explorerRecursive(&root, i.fileOptions, i.operators, violations)
 		if err != nil {
 			return
 		}
@@ -220,8 +240,9 @@ func (i *nodesTree) Load(path string) (root Node, err error) {
 	return
 }
 
-// explorerRecursive traverse of the file tree
-func explorerRecursive(node *Node, fileOptions *FileOptions, operators []NodesOperator) error {
+// explorerRecursive traverse of the file tree,
+// the files that cannot be parsed are added to violations if it is not nil, otherwise they fail the traverse.
+func explorerRecursive(node *Node, fileOptions *FileOptions, operators []NodesOperator, violations *[]Violation) error {
 	sub, err := ioutil.ReadDir(node.Path)
 	if err != nil {
 		return fmt.Errorf("directory does not exist or cannot be opened, %w", err)
@@ -242,7 +263,7 @@ func explorerRecursive(node *Node, fileOptions *FileOptions, operators []NodesOp
 
 		if f.IsDir() {
 			node.Children = append(node.Children, child)
-			err = explorerRecursive(child, fileOptions, operators)
+			err = explorerRecursive(child, fileOptions, operators, violations)
 			if err != nil {
 				return err
 			}
@@ -258,6 +279,10 @@ func explorerRecursive(node *Node, fileOptions *FileOptions, operators []NodesOp
 				child.Content = string(buffer)
 			} else {
 				cr, err := convertResource(child, operators)
+				if err != nil && violations != nil {
+					*violations = append(*violations, Violation{Path: child.Path, Rule: RuleParse, Message: err.Error()})
+					continue
+				}
 				if err != nil {
 					return err
 				}
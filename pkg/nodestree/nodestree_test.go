@@ -0,0 +1,184 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodestree
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resourceOperator parses every kind as a plain resource, it has no references to check.
+type resourceOperator struct{}
+
+func (o *resourceOperator) CheckReference(options CompareOptions, node *Node, k8sClient client.Client) (bool, error) {
+	return false, nil
+}
+
+func (o *resourceOperator) CreateResource(kind string) interface{} {
+	return &Resource{}
+}
+
+func (o *resourceOperator) CreateNode(path string, data interface{}) (*Node, error) {
+	return nil, nil
+}
+
+func (o *resourceOperator) UpdateNode(node *Node, data interface{}) (*Node, error) {
+	return node, nil
+}
+
+var _ = Describe("Load and validate the sample layout", func() {
+	var (
+		root      string
+		nodesTree NodesTree
+	)
+
+	writeResource := func(path, kind, name string) {
+		writeFile(filepath.Join(root, path), fmt.Sprintf("apiVersion: nautes.resource.nautes.io/v1alpha1\nkind: %s\nmetadata:\n  name: %s\n", kind, name))
+	}
+
+	BeforeEach(func() {
+		root = filepath.Join(GinkgoT().TempDir(), "default.project")
+		writeResource("projects/api/api.yaml", "Project", "api")
+		writeResource("envs/dev.yaml", "Environment", "dev")
+		writeResource("code-repos/repo-1/repo-1.yaml", "CodeRepo", "repo-1")
+		writeResource("artifact-repos/api/maven.yaml", "ArtifactRepo", "maven")
+		writeResource("runtimes/dr.yaml", "DeploymentRuntime", "dr")
+
+		layout, err := filepath.Abs("sample/resources.yaml")
+		Expect(err).ShouldNot(HaveOccurred())
+		GinkgoT().Setenv("RESOURCES_LAYOUT", layout)
+		config, err := NewConfig()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		nodesTree = NewNodestree(&FileOptions{ExclusionsSuffix: []string{".txt", ".md"}}, config, nil)
+		nodesTree.AppendOperators(&resourceOperator{})
+	})
+
+	It("finds no violations in a valid product", func() {
+		nodes, violations, err := nodesTree.LoadWithViolations(root)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(violations).Should(BeEmpty())
+
+		violations, err = nodesTree.Validate(CompareOptions{Nodes: nodes})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(violations).Should(BeEmpty())
+		Expect(nodesTree.Compare(CompareOptions{Nodes: nodes})).Should(Succeed())
+	})
+
+	It("fails to load a file that cannot be parsed", func() {
+		writeFile(filepath.Join(root, "envs/broken.yaml"), "kind: [")
+
+		_, err := nodesTree.Load(root)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("leaves out the files that cannot be parsed and returns them as violations", func() {
+		writeFile(filepath.Join(root, "envs/broken.yaml"), "kind: [")
+
+		nodes, violations, err := nodesTree.LoadWithViolations(root)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(violations).Should(HaveLen(1))
+		Expect(violations[0].Path).Should(Equal("envs/broken.yaml"))
+		Expect(violations[0].Rule).Should(Equal(RuleParse))
+		Expect(nodesTree.GetNode(&nodes, "Environment", "dev")).ShouldNot(BeNil())
+		Expect(nodesTree.Compare(CompareOptions{Nodes: nodes})).Should(Succeed())
+	})
+
+	It("loads the same nodes with or without violations", func() {
+		nodes, err := nodesTree.Load(root)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		nodesWithViolations, _, err := nodesTree.LoadWithViolations(root)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(nodesWithViolations).Should(Equal(nodes))
+	})
+
+	It("reports both files of a duplicate name", func() {
+		writeResource("envs/dev-copy.yaml", "Environment", "dev")
+
+		nodes, err := nodesTree.Load(root)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		violations, err := nodesTree.Validate(CompareOptions{Nodes: nodes})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(violations).Should(ConsistOf(
+			HaveField("Path", "envs/dev-copy.yaml"),
+			HaveField("Path", "envs/dev.yaml"),
+		))
+		Expect(violations[0].Rule).Should(Equal(RuleDuplicateName))
+		Expect(nodesTree.Compare(CompareOptions{Nodes: nodes})).ShouldNot(Succeed())
+	})
+
+	It("reports a resource outside of its directory in the layout", func() {
+		writeResource("projects/api/test.yaml", "Environment", "test")
+
+		nodes, err := nodesTree.Load(root)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		violations, err := nodesTree.Validate(CompareOptions{Nodes: nodes})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(violations).Should(HaveLen(1))
+		Expect(violations[0].Path).Should(Equal("projects/api/test.yaml"))
+		Expect(violations[0].Rule).Should(Equal(RuleLayout))
+	})
+
+	It("reports too many resources of a kind without a file", func() {
+		config, err := NewConfig()
+		Expect(err).ShouldNot(HaveOccurred())
+		for i := range config.Sub {
+			if config.Sub[i].Name == "runtimes" {
+				config.Sub[i].Sub[0].Count = 2
+			}
+		}
+		nodesTree = NewNodestree(&FileOptions{}, config, nil)
+		nodesTree.AppendOperators(&resourceOperator{})
+		writeResource("runtimes/dr-2.yaml", "DeploymentRuntime", "dr-2")
+		writeResource("runtimes/dr-3.yaml", "DeploymentRuntime", "dr-3")
+
+		nodes, err := nodesTree.Load(root)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		violations, err := nodesTree.Validate(CompareOptions{Nodes: nodes})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(violations).Should(HaveLen(1))
+		Expect(violations[0].Path).Should(BeEmpty())
+		Expect(violations[0].Rule).Should(Equal(RuleCount))
+	})
+
+	It("excludes the files of the violations from the nodes", func() {
+		writeResource("projects/api/test.yaml", "Environment", "test")
+
+		nodes, err := nodesTree.Load(root)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		violations, err := nodesTree.Validate(CompareOptions{Nodes: nodes})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		ExcludeViolations(&nodes, violations)
+		Expect(nodesTree.GetNode(&nodes, "Environment", "test")).Should(BeNil())
+		Expect(nodesTree.GetNode(&nodes, "Project", "api")).ShouldNot(BeNil())
+		Expect(nodesTree.Compare(CompareOptions{Nodes: nodes})).Should(Succeed())
+	})
+})
+
+func writeFile(path, content string) {
+	Expect(os.MkdirAll(filepath.Dir(path), 0o755)).Should(Succeed())
+	Expect(os.WriteFile(path, []byte(content), 0o644)).Should(Succeed())
+}
@@ -113,8 +113,18 @@ func CheckGitHooks(gitType string, events []string) error {
 		}
 
 	} else if gitType == github {
-		// TODO
-		// add github webhook validate
+		webhooks := []string{"*", "branch_protection_rule", "check_run", "check_suite", "code_scanning_alert", "commit_comment", "create", "delete", "deploy_key", "deployment", "deployment_status", "discussion", "discussion_comment", "fork", "gollum", "issue_comment", "issues", "label", "member", "meta", "milestone", "package", "page_build", "ping", "project", "project_card", "project_column", "public", "pull_request", "pull_request_review", "pull_request_review_comment", "pull_request_review_thread", "push", "registry_package", "release", "repository", "repository_dispatch", "repository_import", "repository_vulnerability_alert", "secret_scanning_alert", "security_and_analysis", "star", "status", "team_add", "watch", "workflow_dispatch", "workflow_job", "workflow_run"}
+		isValidHook := true
+
+		for _, event := range events {
+			if ok := utilstring.ContainsString(webhooks, event); !ok {
+				isValidHook = false
+			}
+		}
+
+		if !isValidHook {
+			return fmt.Errorf("invalid webhook please refer to github api documentation: https://docs.github.com/en/webhooks-and-events/webhooks/webhook-events-and-payloads")
+		}
 	}
 
 	return nil
@@ -0,0 +1,165 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodestree
+
+import (
+	"path/filepath"
+)
+
+const (
+	RuleParse         = "parse"
+	RuleDuplicateName = "duplicate-name"
+	RuleLayout        = "layout"
+	RuleReference     = "reference"
+	RuleCount         = "count"
+)
+
+// Violation is a file that cannot be parsed or breaks a rule of the layout.
+// Path is relative to the root of the nodes, it is empty if the violation is not caused by a single file.
+type Violation struct {
+	Path    string
+	Rule    string
+	Message string
+}
+
+// Validate runs the same checks as Compare, but returns all the violations instead of the first error.
+func (in *nodesTree) Validate(options CompareOptions) ([]Violation, error) {
+	config, err := NewConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	violations := []Violation{}
+	if len(config.Sub) == 0 || len(options.Nodes.Children) == 0 {
+		return violations, nil
+	}
+
+	violations = append(violations, duplicateNameViolations(&options.Nodes)...)
+	violations = append(violations, layoutViolations(options, in)...)
+	violations = append(violations, referenceViolations(options, in)...)
+	violations = append(violations, countViolations(options, in)...)
+
+	for i := range violations {
+		violations[i].Path = relativePath(options.Nodes.Path, violations[i].Path)
+	}
+
+	return violations, nil
+}
+
+func duplicateNameViolations(node *Node) []Violation {
+	var violations []Violation
+	for i, node1 := range node.Children {
+		if node1.IsDir {
+			violations = append(violations, duplicateNameViolations(node1)...)
+			continue
+		}
+
+		for _, node2 := range node.Children[i+1:] {
+			err := compareNodeName(node1, node2)
+			if err == nil {
+				continue
+			}
+
+			violations = append(violations,
+				Violation{Path: node1.Path, Rule: RuleDuplicateName, Message: err.Error()},
+				Violation{Path: node2.Path, Rule: RuleDuplicateName, Message: err.Error()},
+			)
+		}
+	}
+
+	return violations
+}
+
+func layoutViolations(options CompareOptions, in *nodesTree) []Violation {
+	var violations []Violation
+	configInfos := listConfigInfos([]Config{*in.config})
+	for _, node := range listNodeInfos([]Node{options.Nodes}, configInfos) {
+		err := resourceEffectiveness([]NodeInfo{node}, configInfos)
+		if err != nil {
+			violations = append(violations, Violation{Path: node.Path, Rule: RuleLayout, Message: err.Error()})
+		}
+	}
+
+	return violations
+}
+
+func referenceViolations(options CompareOptions, in *nodesTree) []Violation {
+	var violations []Violation
+	mapping := make(map[string]*Node)
+	NodesToMapping(&options.Nodes, mapping)
+
+	for _, node := range mapping {
+		if node.IsDir {
+			continue
+		}
+
+		for _, o := range in.operators {
+			ok, err := o.CheckReference(options, node, in.client)
+			if ok && err != nil {
+				violations = append(violations, Violation{Path: node.Path, Rule: RuleReference, Message: err.Error()})
+			}
+		}
+	}
+
+	return violations
+}
+
+func countViolations(options CompareOptions, in *nodesTree) []Violation {
+	err := checkCountResource(options, listConfigInfos([]Config{*in.config}))
+	if err != nil {
+		return []Violation{{Rule: RuleCount, Message: err.Error()}}
+	}
+
+	return nil
+}
+
+// ExcludeViolations removes the files of the violations from the nodes.
+func ExcludeViolations(nodes *Node, violations []Violation) {
+	paths := make(map[string]bool, len(violations))
+	for _, violation := range violations {
+		if violation.Path != "" {
+			paths[violation.Path] = true
+		}
+	}
+
+	excludePaths(nodes, nodes.Path, paths)
+}
+
+func excludePaths(node *Node, root string, paths map[string]bool) {
+	children := node.Children[:0]
+	for _, child := range node.Children {
+		if child.IsDir {
+			excludePaths(child, root, paths)
+		} else if paths[relativePath(root, child.Path)] {
+			continue
+		}
+
+		children = append(children, child)
+	}
+	node.Children = children
+}
+
+func relativePath(root, path string) string {
+	if path == "" {
+		return ""
+	}
+
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return path
+	}
+
+	return rel
+}